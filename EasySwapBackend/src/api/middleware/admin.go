@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+)
+
+// AdminMiddleWare 管理员鉴权中间件, 需挂在 AuthMiddleWare 之后
+// 角色每次从数据库读取, 撤销管理员角色后立即生效, 无需等待 token 过期
+func AdminMiddleWare(d *dao.Dao) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			c.Abort()
+			return
+		}
+
+		role, err := d.GetUserRole(c.Request.Context(), userAddr)
+		if err != nil {
+			xzap.WithContext(c.Request.Context()).Error("failed on get user role", zap.Error(err), zap.String("address", userAddr))
+			xhttp.Error(c, errcode.ErrUnexpected)
+			c.Abort()
+			return
+		}
+		if role != base.UserRoleAdmin {
+			xhttp.Error(c, errcode.ErrPermissionDenied)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
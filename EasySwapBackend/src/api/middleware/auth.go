@@ -1,135 +1,101 @@
 package middleware
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
-	"encoding/hex"
-	"fmt"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
 	"github.com/ProjectsTask/EasySwapBase/errcode"
-	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
 	"github.com/ProjectsTask/EasySwapBase/xhttp"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/common/session"
 )
 
 const CR_LOGIN_MSG_KEY string = "cache:es:login:msg"
-const CR_LOGIN_KEY string = "cache:es:login:address:data"
-const CR_LOGIN_SALT string = "es_login_salt&$%"
 
-// 设置路由cookie
-// AuthMiddleWare 是一个认证中间件函数,用于验证请求中的会话令牌
+// authClaimsCtxKey 鉴权通过后 token 载荷在 gin.Context 中的 key
+const authClaimsCtxKey = "auth_claims"
+
+// AuthMiddleWare 是一个认证中间件函数,用于验证请求中的 access token
 // 主要功能包括:
-// 1. 从请求头获取session_id,如果为空则跳过验证
-// 2. 支持多个session_id,用逗号分隔
-// 3. 对每个session_id进行以下验证:
-// 4. 如果验证失败则返回相应错误:
-//   - 令牌格式错误返回ErrTokenVerify
-//   - 令牌过期返回ErrTokenExpire
-//
-// 5. 验证通过则继续处理请求
-func AuthMiddleWare(ctx *xkv.Store) gin.HandlerFunc {
+// 1. 从请求头 Authorization: Bearer <token> 获取 access token, 缺失则返回 ErrTokenVerify
+// 2. 校验 token 签名、有效期及所属会话未被撤销, 失败返回 ErrTokenExpire; 用户被封禁时返回 ErrPermissionDenied
+// 3. 验证通过后将 token 载荷写入上下文, 供 GetAuthUserAddress/GetAuthSessionID 读取
+func AuthMiddleWare(sessions *session.Manager) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// cache:es:login:address:data:<用户地址>
-		values := c.Request.Header.Get("session_id")
-		if values == "" {
-			c.Next()
+		token := bearerToken(c)
+		if token == "" {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			c.Abort()
 			return
 		}
 
-		sessionIDs := strings.Split(values, ",")
-		for _, sessionID := range sessionIDs {
-			encryptCode, err := hex.DecodeString(sessionID)
-			if err != nil {
-				xhttp.Error(c, errcode.ErrTokenVerify)
-				c.Abort()
-				return
-			}
-
-			//解密
-			decrptCode, err := AesDecryptOFB(encryptCode, []byte(CR_LOGIN_SALT))
-			if err != nil {
-				xhttp.Error(c, errcode.ErrTokenExpire)
-				c.Abort()
-				return
-			}
-			//从redis里取数据
-			result, err := ctx.Get(string(decrptCode))
-			if result == "" || err != nil {
-				xhttp.Error(c, errcode.ErrTokenExpire)
-				c.Abort()
-				return
-			}
+		claims, err := sessions.Verify(token)
+		if errors.Is(err, session.ErrUserBlocked) {
+			xhttp.Error(c, errcode.ErrPermissionDenied)
+			c.Abort()
+			return
+		}
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenExpire)
+			c.Abort()
+			return
 		}
 
+		c.Set(authClaimsCtxKey, claims)
 		c.Next()
 	}
 }
 
-// GetAuthUserAddress 从请求头 Session ID 获取认证用户地址
-// 支持批量解析，返回地址列表
-func GetAuthUserAddress(c *gin.Context, ctx *xkv.Store) ([]string, error) {
-	values := c.Request.Header.Get("session_id")
-	if values == "" {
-		return nil, errors.New("failed on get token")
-	}
-
-	sessionIDs := strings.Split(values, ",")
-	var addrs []string
-	for _, sessionID := range sessionIDs {
-		// 1. Hex 解码
-		encryptCode, err := hex.DecodeString(sessionID)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed on decode cookie")
-		}
-
-		// 2. AES 解密
-		decrptCode, err := AesDecryptOFB(encryptCode, []byte(CR_LOGIN_SALT))
-		if err != nil {
-			return nil, errors.Wrap(err, "invalid cookie")
-		}
-		// 3. 验证 Redis 缓存存在性
-		// 从redis里取数据
-		result, err := ctx.Get(string(decrptCode))
-		if result == "" || err != nil {
-			return nil, errors.Wrap(err, "failed on read cookie from cache")
-		}
-		// 4. 解析缓存 Key 获取地址 [KeyPrefix]:[Address]
-		arr := strings.Split(string(decrptCode), CR_LOGIN_KEY+":")
-		if len(arr) != 2 {
-			return nil, errors.New("user cache info format err")
-		}
-
-		if arr[1] == "" {
-			return nil, errors.New("invalid user address")
+// OptionalAuthMiddleWare 可选认证中间件, 用于登录与未登录均可访问的接口
+// 携带有效 access token 时写入 token 载荷, 供 GetAuthUserAddress 读取; token 缺失或无效时按未登录继续处理
+func OptionalAuthMiddleWare(sessions *session.Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if token := bearerToken(c); token != "" {
+			if claims, err := sessions.Verify(token); err == nil {
+				c.Set(authClaimsCtxKey, claims)
+			}
 		}
-		addrs = append(addrs, arr[1])
+		c.Next()
 	}
-
-	return addrs, nil
 }
 
-func AesDecryptOFB(data []byte, key []byte) ([]byte, error) {
-	block, _ := aes.NewCipher([]byte(key))
-	iv := data[:aes.BlockSize]
-	data = data[aes.BlockSize:]
-	if len(data)%aes.BlockSize != 0 {
-		return nil, fmt.Errorf("data is not a multiple of the block size")
+// GetAuthUserAddress 获取认证用户地址 (小写), 需在 AuthMiddleWare 之后调用
+func GetAuthUserAddress(c *gin.Context) (string, error) {
+	claims, err := getAuthClaims(c)
+	if err != nil {
+		return "", err
 	}
+	return claims.Subject, nil
+}
 
-	out := make([]byte, len(data))
-	mode := cipher.NewOFB(block, iv)
-	mode.XORKeyStream(out, data)
+// GetAuthSessionID 获取当前请求所属的会话 ID, 需在 AuthMiddleWare 之后调用
+func GetAuthSessionID(c *gin.Context) (string, error) {
+	claims, err := getAuthClaims(c)
+	if err != nil {
+		return "", err
+	}
+	return claims.SessionID, nil
+}
 
-	out = PKCS7UnPadding(out)
-	return out, nil
+func getAuthClaims(c *gin.Context) (*session.Claims, error) {
+	value, ok := c.Get(authClaimsCtxKey)
+	if !ok {
+		return nil, errors.New("failed on get token")
+	}
+	claims, ok := value.(*session.Claims)
+	if !ok {
+		return nil, errors.New("invalid token claims")
+	}
+	return claims, nil
 }
 
-// 去码
-func PKCS7UnPadding(origData []byte) []byte {
-	length := len(origData)
-	unpadding := int(origData[length-1])
-	return origData[:(length - unpadding)]
+// bearerToken 解析 Authorization: Bearer <token>
+func bearerToken(c *gin.Context) string {
+	header := c.Request.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(header[7:])
 }
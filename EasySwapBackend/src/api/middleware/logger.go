@@ -75,7 +75,7 @@ func RLog() gin.HandlerFunc {
 				zap.String("query", query),
 				zap.String("ip", c.ClientIP()),
 				zap.String("user-agent", c.Request.UserAgent()),
-				zap.String("token", c.Request.Header.Get("session_id")),
+				zap.String("session_id", authSessionID(c)),
 				zap.String("content-type", c.Request.Header.Get("Content-Type")),
 				zap.Float64("latency", latency),
 				zap.String("request", string(requestBody)),
@@ -85,3 +85,9 @@ func RLog() gin.HandlerFunc {
 		}
 	}
 }
+
+// authSessionID 已鉴权请求的会话 ID, 避免在日志中记录 token
+func authSessionID(c *gin.Context) string {
+	sessionID, _ := GetAuthSessionID(c)
+	return sessionID
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+// unmatchedRoute 未匹配到路由的请求统一使用的 route 标签, 避免任意路径造成标签基数膨胀
+const unmatchedRoute = "unmatched"
+
+// Metrics 记录每个接口的请求耗时与响应状态码, route 取 gin 路由模板
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.HTTPRequestDuration.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/common/ratelimit"
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+)
+
+const (
+	// ApiKeyHeader 合作方 API key 请求头
+	ApiKeyHeader = "X-API-Key"
+
+	defaultRateLimitBucket = "default"
+	defaultRateLimitWindow = 60
+)
+
+// apiKeyCtxKey 请求携带的有效 API key 在 gin.Context 中的 key
+const apiKeyCtxKey = "api_key"
+
+// RateLimitMiddleWare 接口限流中间件 (滑动窗口)
+// 主要功能包括:
+// 1. 识别请求方: 携带 X-API-Key 按 key 计数 (无效 key 返回 ErrInvalidApiKey), 携带有效 access token 按用户地址计数, 否则按 IP 计数;
+// 查询 API key 失败时按未携带 key 处理, 不能绕过限流. IP 为连接远端地址, 仅经可信代理 (api.trusted_proxies) 转发时读取转发头
+// 2. 按路由查找配额: 配置了独立配额的接口使用单独的计数桶, 其余接口共用默认计数桶
+// 3. 写入 X-RateLimit-Limit/X-RateLimit-Remaining/X-RateLimit-Reset 响应头, 超出配额返回 429 及 Retry-After
+// 4. 累计 API key 的按天用量
+// Redis 不可用时放行请求, 避免限流组件故障导致接口整体不可用
+func RateLimitMiddleWare(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	cfg := svcCtx.C.RateLimit
+	if cfg == nil || !cfg.Enable {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	window := cfg.Window
+	if window <= 0 {
+		window = defaultRateLimitWindow
+	}
+	limiter := ratelimit.New(svcCtx.KvStore, time.Duration(window)*time.Second)
+
+	routes := make(map[string]*config.RouteRateLimit, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		routes[routeID(route.Method, route.Path)] = route
+	}
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		route := routeID(c.Request.Method, c.FullPath())
+
+		var apiKey *base.ApiKey
+		if key := c.GetHeader(ApiKeyHeader); key != "" {
+			var err error
+			apiKey, err = svcCtx.Dao.GetActiveApiKey(ctx, key)
+			switch {
+			case err != nil:
+				// 查询失败时按未携带 key 计数
+				xzap.WithContext(ctx).Error("failed on get api key", zap.Error(err))
+			case apiKey == nil:
+				xhttp.Error(c, errcode.ErrInvalidApiKey)
+				c.Abort()
+				return
+			default:
+				c.Set(apiKeyCtxKey, apiKey)
+			}
+		}
+
+		identity, limit := rateLimitIdentity(c, svcCtx, cfg, routes[route], apiKey)
+		bucket := defaultRateLimitBucket
+		if _, ok := routes[route]; ok {
+			bucket = route
+		}
+
+		res, err := limiter.Allow(fmt.Sprintf("%s:%s", bucket, identity), limit)
+		if err != nil {
+			xzap.WithContext(ctx).Error("failed on check rate limit", zap.Error(err), zap.String("identity", identity))
+			c.Next()
+			return
+		}
+
+		if apiKey != nil {
+			if err := svcCtx.Dao.IncrApiKeyUsage(apiKey.Id, route, !res.Allowed); err != nil {
+				xzap.WithContext(ctx).Error("failed on incr api key usage", zap.Error(err), zap.Int64("api_key_id", apiKey.Id))
+			}
+		}
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(res.Limit))
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Header("X-RateLimit-Reset", strconv.FormatInt(res.ResetAt.Unix(), 10))
+		if !res.Allowed {
+			retryAfter := int64(res.RetryAfter.Seconds()) + 1
+			c.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+			xhttp.Error(c, errcode.ErrTooManyRequests)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// GetApiKey 获取请求携带的有效 API key, 未携带时返回 nil
+func GetApiKey(c *gin.Context) *base.ApiKey {
+	value, ok := c.Get(apiKeyCtxKey)
+	if !ok {
+		return nil
+	}
+	apiKey, _ := value.(*base.ApiKey)
+	return apiKey
+}
+
+// rateLimitIdentity 确定计数身份及对应配额
+// 限流只需识别身份, access token 仅校验签名和有效期, 不查询会话状态
+func rateLimitIdentity(c *gin.Context, svcCtx *svc.ServerCtx, cfg *config.RateLimit, route *config.RouteRateLimit, apiKey *base.ApiKey) (string, int) {
+	if apiKey != nil {
+		limit := cfg.ApiKeyLimit
+		if apiKey.RateLimit > 0 {
+			limit = apiKey.RateLimit
+		}
+		if route != nil {
+			// 按 key 自身配额相对默认配额的比例缩放接口配额
+			limit = route.ApiKeyLimit
+			if apiKey.RateLimit > 0 && cfg.ApiKeyLimit > 0 {
+				limit = route.ApiKeyLimit * apiKey.RateLimit / cfg.ApiKeyLimit
+			}
+		}
+		return fmt.Sprintf("key:%d", apiKey.Id), limit
+	}
+
+	if token := bearerToken(c); token != "" {
+		if claims, err := svcCtx.Sessions.Parse(token); err == nil {
+			limit := cfg.UserLimit
+			if route != nil {
+				limit = route.UserLimit
+			}
+			return "user:" + claims.Subject, limit
+		}
+	}
+
+	limit := cfg.IPLimit
+	if route != nil {
+		limit = route.IPLimit
+	}
+	return "ip:" + c.ClientIP(), limit
+}
+
+func routeID(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
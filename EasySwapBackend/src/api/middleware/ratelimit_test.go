@@ -0,0 +1,117 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+)
+
+func TestMain(m *testing.M) {
+	if _, err := xzap.SetUp(logging.LogConf{ServiceName: "middleware_test", Mode: "console", Path: os.TempDir(), Level: "error"}); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func newTestKvStore(mr *miniredis.Miniredis) *xkv.Store {
+	return xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+}
+
+func newRateLimitRouter(t *testing.T, svcCtx *svc.ServerCtx, trustedProxies []string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	require.NoError(t, r.SetTrustedProxies(trustedProxies))
+	r.Use(RateLimitMiddleWare(svcCtx))
+	r.GET("/ping", func(c *gin.Context) {
+		c.String(http.StatusOK, "pong")
+	})
+	return r
+}
+
+func newRateLimitCtx(t *testing.T) *svc.ServerCtx {
+	return &svc.ServerCtx{
+		C: &config.Config{RateLimit: &config.RateLimit{
+			Enable:      true,
+			Window:      60,
+			IPLimit:     2,
+			UserLimit:   5,
+			ApiKeyLimit: 10,
+		}},
+		KvStore: newTestKvStore(miniredis.RunT(t)),
+	}
+}
+
+func doRequest(r *gin.Engine, remoteAddr string, header map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.RemoteAddr = remoteAddr
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestRateLimitIgnoresSpoofedForwardedFor(t *testing.T) {
+	r := newRateLimitRouter(t, newRateLimitCtx(t), nil)
+
+	for i, ip := range []string{"1.1.1.1", "2.2.2.2"} {
+		w := doRequest(r, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": ip})
+		assert.Equal(t, http.StatusOK, w.Code, i)
+	}
+	// 未配置可信代理时按连接远端地址计数, 伪造的 X-Forwarded-For 不产生新的计数桶
+	w := doRequest(r, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "3.3.3.3"})
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+}
+
+func TestRateLimitTrustedProxy(t *testing.T) {
+	r := newRateLimitRouter(t, newRateLimitCtx(t), []string{"10.0.0.0/8"})
+
+	for i := 0; i < 2; i++ {
+		w := doRequest(r, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "1.1.1.1"})
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+	w := doRequest(r, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "1.1.1.1"})
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+
+	// 可信代理转发的不同客户端分别计数
+	w = doRequest(r, "10.0.0.1:1234", map[string]string{"X-Forwarded-For": "2.2.2.2"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1", w.Header().Get("X-RateLimit-Remaining"))
+}
+
+func TestRateLimitApiKeyLookupFailureFallsBackToIP(t *testing.T) {
+	svcCtx := newRateLimitCtx(t)
+	// API key 缓存不可用, 查询失败
+	broken := miniredis.RunT(t)
+	svcCtx.Dao = &dao.Dao{KvStore: newTestKvStore(broken)}
+	broken.Close()
+	r := newRateLimitRouter(t, svcCtx, nil)
+
+	header := map[string]string{ApiKeyHeader: "any-key"}
+	for i := 0; i < 2; i++ {
+		w := doRequest(r, "10.0.0.1:1234", header)
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+	w := doRequest(r, "10.0.0.1:1234", header)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+}
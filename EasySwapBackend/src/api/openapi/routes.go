@@ -0,0 +1,208 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// FiltersParam 列表类接口使用 JSON 编码的 filters 查询参数传递过滤条件
+const FiltersParam = "filters"
+
+// Auth 接口鉴权方式
+type Auth int
+
+const (
+	AuthNone  Auth = iota // 无需登录 (可携带 API key)
+	AuthUser              // 需登录, 请求头 Authorization: Bearer <access_token>
+	AuthAdmin             // 需登录且为管理员
+)
+
+// QueryParam 普通查询参数, Type 为参数的 Go 类型零值
+type QueryParam struct {
+	Name        string
+	Type        interface{}
+	Required    bool
+	Description string
+}
+
+// Route 接口定义
+// Path 为 gin 路由模板 (相对 /api/v1), Filters/Body/Result 为对应类型的零值, nil 表示没有
+type Route struct {
+	Method  string
+	Path    string
+	Name    string // operationId, 同时作为客户端方法名
+	Tag     string
+	Summary string
+	Auth    Auth
+	Query   []QueryParam
+	Filters interface{}
+	Body    interface{}
+	Result  interface{}
+
+	FiltersOptional bool // filters 参数可省略
+}
+
+var chainIDParam = QueryParam{Name: "chain_id", Type: int(0), Required: true, Description: "链 ID"}
+
+// Routes 对外接口路由表, 需与 router.loadV1 保持一致, 由 router 包的 TestRoutesMatchOpenAPI 校验
+var Routes = []*Route{
+	// 服务状态
+	{Method: http.MethodGet, Path: "/status", Name: "GetSyncStatus", Tag: "status", Summary: "各链事件索引进度",
+		Result: types.SyncStatusResp{}},
+
+	// 用户
+	{Method: http.MethodGet, Path: "/user/:address/login-message", Name: "GetLoginMessage", Tag: "user", Summary: "生成 SIWE 登录签名消息",
+		Query: []QueryParam{chainIDParam}, Result: types.UserLoginMsgResp{}},
+	{Method: http.MethodPost, Path: "/user/login", Name: "UserLogin", Tag: "user", Summary: "登录验证",
+		Body: types.LoginReq{}, Result: types.UserLoginResp{}},
+	{Method: http.MethodGet, Path: "/user/:address/sig-status", Name: "GetSigStatus", Tag: "user", Summary: "获取用户签名状态",
+		Result: types.UserSignStatusResp{}},
+	{Method: http.MethodPost, Path: "/user/token/refresh", Name: "RefreshToken", Tag: "user", Summary: "使用 refresh token 换取新 token",
+		Body: types.RefreshTokenReq{}, Result: types.UserTokens{}},
+	{Method: http.MethodPost, Path: "/user/logout", Name: "UserLogout", Tag: "user", Summary: "退出当前会话", Auth: AuthUser},
+	{Method: http.MethodPost, Path: "/user/logout-all", Name: "UserLogoutAll", Tag: "user", Summary: "退出所有会话", Auth: AuthUser},
+	{Method: http.MethodGet, Path: "/user/sessions", Name: "GetUserSessions", Tag: "user", Summary: "当前用户的会话列表", Auth: AuthUser,
+		Result: types.UserSessionsResp{}},
+	{Method: http.MethodDelete, Path: "/user/sessions/:session_id", Name: "RevokeUserSession", Tag: "user", Summary: "撤销指定会话", Auth: AuthUser},
+
+	// 集合
+	{Method: http.MethodGet, Path: "/collections/:address", Name: "GetCollectionDetail", Tag: "collection", Summary: "集合详情",
+		Query: []QueryParam{chainIDParam}, Result: types.CollectionDetailResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/bids", Name: "GetCollectionBids", Tag: "collection", Summary: "集合出价信息",
+		Filters: types.CollectionBidFilterParams{}, Result: types.CollectionBidsResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/:token_id/bids", Name: "GetItemBids", Tag: "collection", Summary: "Item 出价信息",
+		Filters: types.CollectionBidFilterParams{}, Result: types.ItemBidsResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/items", Name: "GetCollectionItems", Tag: "collection", Summary: "集合 Item 列表",
+		Filters: types.CollectionItemFilterParams{}, Result: types.NFTListingInfoResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/:token_id", Name: "GetItemDetail", Tag: "collection", Summary: "Item 详情",
+		Query: []QueryParam{chainIDParam}, Result: types.ItemDetailInfoResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/:token_id/traits", Name: "GetItemTraits", Tag: "collection", Summary: "Item 属性",
+		Query: []QueryParam{chainIDParam}, Result: types.ItemTraitsResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/top-trait", Name: "GetItemTopTraitPrice", Tag: "collection", Summary: "Item 属性的最高价格",
+		Filters: types.TopTraitFilterParams{}, Result: types.ItemTopTraitResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/:token_id/image", Name: "GetItemImage", Tag: "collection", Summary: "Item 图片",
+		Query: []QueryParam{chainIDParam}, Result: types.ItemImageResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/history-sales", Name: "GetHistorySales", Tag: "collection", Summary: "历史成交价格",
+		Query: []QueryParam{chainIDParam, {Name: "duration", Type: "", Description: "时间范围: 24h, 7d, 30d, 默认 7d"}}, Result: types.HistorySalesResp{}},
+	{Method: http.MethodGet, Path: "/collections/:address/:token_id/owner", Name: "GetItemOwner", Tag: "collection", Summary: "Item 所有者",
+		Query: []QueryParam{chainIDParam}, Result: types.ItemOwnerResp{}},
+	{Method: http.MethodPost, Path: "/collections/:address/:token_id/metadata", Name: "RefreshItemMetadata", Tag: "collection", Summary: "刷新 Item 元数据",
+		Query: []QueryParam{chainIDParam}, Result: types.CommonResp{}},
+	{Method: http.MethodGet, Path: "/collections/ranking", Name: "GetTopRanking", Tag: "collection", Summary: "集合交易量排行",
+		Query: []QueryParam{
+			{Name: "limit", Type: int64(0), Required: true, Description: "返回数量"},
+			{Name: "range", Type: "", Description: "时间范围: 15m, 1h, 6h, 1d, 7d, 30d, 默认 1d"},
+		}, Result: types.CollectionRankingResp{}},
+
+	// 活动
+	{Method: http.MethodGet, Path: "/activities", Name: "GetActivities", Tag: "activity", Summary: "多链活动列表",
+		Filters: types.ActivityMultiChainFilterParams{}, Result: types.ActivityResp{}},
+
+	// 个人资产
+	{Method: http.MethodGet, Path: "/portfolio/collections", Name: "GetUserCollections", Tag: "portfolio", Summary: "用户持有的集合",
+		Filters: types.UserCollectionsParams{}, Result: types.UserCollectionsResp{}},
+	{Method: http.MethodGet, Path: "/portfolio/items", Name: "GetUserItems", Tag: "portfolio", Summary: "用户持有的 Item",
+		Filters: types.PortfolioMultiChainItemFilterParams{}, Result: types.UserItemsResp{}},
+	{Method: http.MethodGet, Path: "/portfolio/listings", Name: "GetUserListings", Tag: "portfolio", Summary: "用户挂单",
+		Filters: types.PortfolioMultiChainListingFilterParams{}, Result: types.UserListingsResp{}},
+	{Method: http.MethodGet, Path: "/portfolio/bids", Name: "GetUserBids", Tag: "portfolio", Summary: "用户出价",
+		Filters: types.PortfolioMultiChainBidFilterParams{}, Result: types.UserBidsResp{}},
+	{Method: http.MethodGet, Path: "/portfolio/escrow", Name: "GetUserEscrow", Tag: "portfolio", Summary: "用户 Vault 托管资产",
+		Filters: types.PortfolioEscrowFilterParams{}, Result: types.UserEscrowResp{}},
+	{Method: http.MethodGet, Path: "/portfolio/valuation", Name: "GetUserValuation", Tag: "portfolio", Summary: "用户持仓估值及盈亏",
+		Filters: types.PortfolioValuationFilterParams{}, Result: types.UserValuationResp{}},
+	{Method: http.MethodGet, Path: "/portfolio/value-history", Name: "GetUserValueHistory", Tag: "portfolio", Summary: "用户持仓价值历史",
+		Filters: types.PortfolioValueHistoryFilterParams{}, Result: types.UserValueHistoryResp{}},
+
+	// 导出任务, 同步流式导出 (/activities/export) 及文件下载 (/export-files/:token) 返回文件内容, 不在此定义
+	{Method: http.MethodPost, Path: "/exports", Name: "CreateExportJob", Tag: "export", Summary: "创建活动导出任务", Auth: AuthUser,
+		Body: types.ActivityExportFilterParams{}, Result: types.ExportJobResp{}},
+	{Method: http.MethodGet, Path: "/exports", Name: "GetExportJobs", Tag: "export", Summary: "导出任务列表", Auth: AuthUser,
+		Filters: types.ExportJobFilterParams{}, FiltersOptional: true, Result: types.ExportJobsResp{}},
+	{Method: http.MethodGet, Path: "/exports/:id", Name: "GetExportJob", Tag: "export", Summary: "导出任务详情及下载链接", Auth: AuthUser,
+		Result: types.ExportJobResp{}},
+
+	// 收藏与关注
+	{Method: http.MethodGet, Path: "/favorites", Name: "GetFavoriteItems", Tag: "watchlist", Summary: "收藏的 Item 列表", Auth: AuthUser,
+		Filters: types.FavoriteFilterParams{}, Result: types.FavoriteItemsResp{}},
+	{Method: http.MethodPost, Path: "/favorites", Name: "AddFavoriteItem", Tag: "watchlist", Summary: "收藏 Item", Auth: AuthUser,
+		Body: types.FavoriteItemReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodDelete, Path: "/favorites/:address/:token_id", Name: "RemoveFavoriteItem", Tag: "watchlist", Summary: "取消收藏 Item", Auth: AuthUser,
+		Query: []QueryParam{chainIDParam}},
+	{Method: http.MethodGet, Path: "/watchlist", Name: "GetWatchlist", Tag: "watchlist", Summary: "关注的集合及实时行情", Auth: AuthUser,
+		Result: types.WatchlistResp{}},
+	{Method: http.MethodPost, Path: "/watchlist", Name: "WatchCollection", Tag: "watchlist", Summary: "关注集合", Auth: AuthUser,
+		Body: types.WatchCollectionReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodDelete, Path: "/watchlist/:address", Name: "UnwatchCollection", Tag: "watchlist", Summary: "取消关注集合", Auth: AuthUser,
+		Query: []QueryParam{chainIDParam}},
+
+	// 用户通知
+	{Method: http.MethodGet, Path: "/notifications", Name: "GetNotifications", Tag: "notification", Summary: "站内通知列表", Auth: AuthUser,
+		Filters: types.NotificationFilterParams{}, Result: types.NotificationsResp{}},
+	{Method: http.MethodPost, Path: "/notifications/read", Name: "ReadNotifications", Tag: "notification", Summary: "标记通知已读", Auth: AuthUser,
+		Body: types.NotificationReadReq{}, Result: types.NotificationReadResp{}},
+	{Method: http.MethodGet, Path: "/notifications/preferences", Name: "GetNotificationPreference", Tag: "notification", Summary: "查询通知偏好", Auth: AuthUser,
+		Result: types.NotificationPreferenceResp{}},
+	{Method: http.MethodPut, Path: "/notifications/preferences", Name: "UpdateNotificationPreference", Tag: "notification", Summary: "更新通知偏好", Auth: AuthUser,
+		Body: types.NotificationPreferenceReq{}, Result: types.NotificationPreferenceResp{}},
+	{Method: http.MethodGet, Path: "/notifications/floor-alerts", Name: "GetFloorAlerts", Tag: "notification", Summary: "查询地板价提醒", Auth: AuthUser,
+		Result: types.FloorAlertsResp{}},
+	{Method: http.MethodPost, Path: "/notifications/floor-alerts", Name: "SetFloorAlert", Tag: "notification", Summary: "创建/更新地板价提醒", Auth: AuthUser,
+		Body: types.FloorAlertReq{}, Result: types.FloorAlertResp{}},
+	{Method: http.MethodDelete, Path: "/notifications/floor-alerts/:id", Name: "DeleteFloorAlert", Tag: "notification", Summary: "删除地板价提醒", Auth: AuthUser},
+	{Method: http.MethodGet, Path: "/notifications/deliveries", Name: "GetNotificationDeliveries", Tag: "notification", Summary: "webhook / 邮件投递记录", Auth: AuthUser,
+		Filters: types.NotificationDeliveryFilterParams{}, Result: types.NotificationDeliveriesResp{}},
+
+	// 链下签名订单
+	{Method: http.MethodPost, Path: "/signed-orders", Name: "CreateSignedOrder", Tag: "order", Summary: "提交链下签名订单 (上链前为 Pending)",
+		Body: types.SignedOrderReq{}, Result: types.SignedOrderResp{}},
+	{Method: http.MethodPost, Path: "/signed-orders/:order_id/cancel", Name: "CancelSignedOrder", Tag: "order", Summary: "取消链下签名订单", Auth: AuthUser,
+		Body: types.CancelSignedOrderReq{}, Result: types.CommonResp{}},
+
+	// 订单交易构造
+	{Method: http.MethodPost, Path: "/order-txs/make", Name: "BuildMakeOrdersTx", Tag: "order", Summary: "构造 makeOrders 交易",
+		Body: types.MakeOrdersTxReq{}, Result: types.UnsignedTxResp{}},
+	{Method: http.MethodPost, Path: "/order-txs/cancel", Name: "BuildCancelOrdersTx", Tag: "order", Summary: "构造 cancelOrders 交易",
+		Body: types.CancelOrdersTxReq{}, Result: types.UnsignedTxResp{}},
+	{Method: http.MethodPost, Path: "/order-txs/edit", Name: "BuildEditOrdersTx", Tag: "order", Summary: "构造 editOrders 交易",
+		Body: types.EditOrdersTxReq{}, Result: types.UnsignedTxResp{}},
+	{Method: http.MethodPost, Path: "/order-txs/match", Name: "BuildMatchOrdersTx", Tag: "order", Summary: "构造 matchOrder(s) 交易",
+		Body: types.MatchOrdersTxReq{}, Result: types.UnsignedTxResp{}},
+
+	// 聚合出价
+	{Method: http.MethodGet, Path: "/bid-orders", Name: "GetOrderInfos", Tag: "order", Summary: "批量查询 Item 最佳出价",
+		Filters: types.OrderInfosParam{}, Result: types.OrderInfosResp{}},
+	{Method: http.MethodPost, Path: "/bid-orders/trait", Name: "CreateTraitBid", Tag: "order", Summary: "创建属性出价 (链下签名, 由后端撮合)", Auth: AuthUser,
+		Body: types.TraitBidReq{}, Result: types.TraitBidResp{}},
+	{Method: http.MethodPost, Path: "/bid-orders/trait/:order_id/fill", Name: "BuildTraitBidFillTx", Tag: "order", Summary: "撮合属性出价, 构造购买 Item 挂单的 matchOrder 交易", Auth: AuthUser,
+		Body: types.TraitBidFillTxReq{}, Result: types.UnsignedTxResp{}},
+	{Method: http.MethodPost, Path: "/bid-orders/trait/:order_id/cancel", Name: "CancelTraitBid", Tag: "order", Summary: "取消属性出价", Auth: AuthUser,
+		Body: types.CancelTraitBidReq{}, Result: types.CommonResp{}},
+
+	// 后台管理
+	{Method: http.MethodPost, Path: "/admin/collections/:address/auth", Name: "AdminSetCollectionAuth", Tag: "admin", Summary: "认证/取消认证集合", Auth: AuthAdmin,
+		Body: types.AdminCollectionAuthReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodPost, Path: "/admin/collections/:address/status", Name: "AdminSetCollectionStatus", Tag: "admin", Summary: "隐藏/封禁集合", Auth: AuthAdmin,
+		Body: types.AdminStatusReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodPost, Path: "/admin/collections/:address/socials", Name: "AdminUpdateCollectionSocials", Tag: "admin", Summary: "编辑集合社交信息", Auth: AuthAdmin,
+		Body: types.AdminCollectionSocialsReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodPost, Path: "/admin/collections/:address/import", Name: "AdminImportCollection", Tag: "admin", Summary: "触发集合导入", Auth: AuthAdmin,
+		Body: types.AdminCollectionImportReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodPost, Path: "/admin/collections/:address/metadata", Name: "AdminRefreshCollectionMetadata", Tag: "admin", Summary: "全量刷新集合元数据", Auth: AuthAdmin,
+		Body: types.AdminChainReq{}, Result: types.AdminRefreshMetadataResp{}},
+	{Method: http.MethodPost, Path: "/admin/collections/:address/:token_id/status", Name: "AdminSetItemStatus", Tag: "admin", Summary: "隐藏/封禁 Item", Auth: AuthAdmin,
+		Body: types.AdminStatusReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodPost, Path: "/admin/users/:address/allowed", Name: "AdminSetUserAllowed", Tag: "admin", Summary: "允许/封禁用户", Auth: AuthAdmin,
+		Body: types.AdminUserAllowedReq{}, Result: types.CommonResp{}},
+	{Method: http.MethodGet, Path: "/admin/audit-logs", Name: "AdminGetAuditLogs", Tag: "admin", Summary: "查询审计日志", Auth: AuthAdmin,
+		Filters: types.AdminAuditLogFilterParams{}, FiltersOptional: true, Result: types.AdminAuditLogsResp{}},
+	{Method: http.MethodPost, Path: "/admin/api-keys", Name: "AdminCreateApiKey", Tag: "admin", Summary: "签发合作方 API key", Auth: AuthAdmin,
+		Body: types.AdminApiKeyCreateReq{}, Result: types.AdminApiKeyCreateResp{}},
+	{Method: http.MethodGet, Path: "/admin/api-keys", Name: "AdminGetApiKeys", Tag: "admin", Summary: "查询 API key 列表", Auth: AuthAdmin,
+		Result: types.AdminApiKeysResp{}},
+	{Method: http.MethodPost, Path: "/admin/api-keys/:id/revoke", Name: "AdminRevokeApiKey", Tag: "admin", Summary: "撤销 API key", Auth: AuthAdmin,
+		Result: types.AdminApiKeyRevokeResp{}},
+	{Method: http.MethodGet, Path: "/admin/api-keys/:id/usage", Name: "AdminGetApiKeyUsage", Tag: "admin", Summary: "查询 API key 用量", Auth: AuthAdmin,
+		Query: []QueryParam{{Name: "days", Type: int(0), Description: "查询最近天数, 默认 7"}}, Result: types.AdminApiKeyUsageResp{}},
+}
@@ -0,0 +1,152 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// typesPkgPath 接口类型所在包, 该包下的类型直接以类型名作为 schema 名称
+const typesPkgPath = "github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+
+// Schema JSON Schema (OpenAPI 3.0 子集)
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Example              interface{}        `json:"example,omitempty"`
+}
+
+var (
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+	timeType    = reflect.TypeOf(time.Time{})
+	rawJSONType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaRegistry 记录已生成的结构体 schema, 结构体统一放入 components 并通过 $ref 引用
+type schemaRegistry struct {
+	schemas map[string]*Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{schemas: make(map[string]*Schema)}
+}
+
+// schemaOf 根据 Go 类型生成 schema, 字段名取 json tag
+func (r *schemaRegistry) schemaOf(t reflect.Type) *Schema {
+	switch t {
+	case decimalType:
+		// decimal 序列化为字符串, 避免精度丢失
+		return &Schema{Type: "string", Format: "decimal", Example: "0.01"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawJSONType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := r.schemaOf(t.Elem())
+		if s.Ref != "" {
+			return s
+		}
+		s.Nullable = true
+		return s
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return r.structRef(t)
+	default:
+		// interface{} 等任意类型
+		return &Schema{}
+	}
+}
+
+// structRef 生成结构体 schema 并注册到 components, 返回引用
+func (r *schemaRegistry) structRef(t reflect.Type) *Schema {
+	name := SchemaName(t)
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+	if _, ok := r.schemas[name]; ok {
+		return ref
+	}
+
+	s := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	// 先占位, 支持自引用结构体
+	r.schemas[name] = s
+	r.addFields(s, t)
+	return ref
+}
+
+// addFields 添加结构体字段, 匿名嵌入且无 json tag 的结构体字段展开到外层
+func (r *schemaRegistry) addFields(s *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				r.addFields(s, ft)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		fs := r.schemaOf(field.Type)
+		if strings.Contains(opts, "string") && fs.Type != "" {
+			fs = &Schema{Type: "string", Format: fs.Format}
+		}
+		s.Properties[name] = fs
+	}
+}
+
+// SchemaName 结构体在 components 中的名称
+// 接口类型包下直接使用类型名, 其他包加包名前缀, 如 multi.Order
+func SchemaName(t reflect.Type) string {
+	if t.PkgPath() == typesPkgPath {
+		return t.Name()
+	}
+	pkg := t.PkgPath()
+	if idx := strings.LastIndex(pkg, "/"); idx >= 0 {
+		pkg = pkg[idx+1:]
+	}
+	return pkg + "." + t.Name()
+}
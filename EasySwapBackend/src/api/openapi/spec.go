@@ -0,0 +1,253 @@
+// Package openapi 根据接口路由表及请求/响应类型生成 OpenAPI 3 文档
+// 路由表 Routes 同时用于生成 Go 客户端 (src/client), 新增或修改接口时需同步更新.
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+)
+
+const (
+	Version  = "3.0.3"
+	BasePath = "/api/v1"
+)
+
+// Document OpenAPI 文档 (仅包含本项目用到的字段)
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema,omitempty"`
+	// Content filters 参数为 JSON 字符串, 使用 content 描述其结构
+	Content map[string]*MediaType `json:"content,omitempty"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+const (
+	securityBearer = "bearerAuth"
+	securityApiKey = "apiKeyAuth"
+	jsonMediaType  = "application/json"
+)
+
+var (
+	specOnce sync.Once
+	specRaw  []byte
+	specErr  error
+
+	pathParamRe = regexp.MustCompile(`:(\w+)`)
+)
+
+// Spec 返回序列化后的 OpenAPI 文档, 仅在首次调用时生成
+func Spec() ([]byte, error) {
+	specOnce.Do(func() {
+		specRaw, specErr = json.Marshal(Build())
+	})
+	return specRaw, specErr
+}
+
+// Build 根据路由表生成 OpenAPI 文档
+func Build() *Document {
+	schemas := newSchemaRegistry()
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:       "EasySwap Backend API",
+			Description: "所有接口返回统一响应结构 {trace_id, code, msg, data}, code 为 200 表示成功, 其余为业务错误码.",
+			Version:     "v1",
+		},
+		Servers: []Server{{URL: BasePath}},
+		Paths:   make(map[string]*PathItem),
+		Components: Components{
+			SecuritySchemes: map[string]*SecurityScheme{
+				securityBearer: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				securityApiKey: {Type: "apiKey", In: "header", Name: "X-API-Key"},
+			},
+		},
+	}
+
+	tags := make(map[string]bool)
+	for _, route := range Routes {
+		path := OpenAPIPath(route.Path)
+		item, ok := doc.Paths[path]
+		if !ok {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		(*item)[strings.ToLower(route.Method)] = buildOperation(route, schemas)
+
+		if !tags[route.Tag] {
+			tags[route.Tag] = true
+			doc.Tags = append(doc.Tags, Tag{Name: route.Tag})
+		}
+	}
+
+	doc.Components.Schemas = schemas.schemas
+	return doc
+}
+
+// OpenAPIPath 将 gin 路由模板转换为 OpenAPI 路径, 如 /collections/:address -> /collections/{address}
+func OpenAPIPath(path string) string {
+	return pathParamRe.ReplaceAllString(path, "{$1}")
+}
+
+// PathParams 返回路由模板中的路径参数名
+func PathParams(path string) []string {
+	var params []string
+	for _, match := range pathParamRe.FindAllStringSubmatch(path, -1) {
+		params = append(params, match[1])
+	}
+	return params
+}
+
+func buildOperation(route *Route, schemas *schemaRegistry) *Operation {
+	op := &Operation{
+		OperationID: route.Name,
+		Summary:     route.Summary,
+		Tags:        []string{route.Tag},
+		Responses: map[string]*Response{
+			"200": {
+				Description: "成功",
+				Content:     map[string]*MediaType{jsonMediaType: {Schema: envelopeSchema(schemas, route.Result)}},
+			},
+			"default": {
+				Description: "业务错误, code 为错误码, msg 为错误信息",
+				Content:     map[string]*MediaType{jsonMediaType: {Schema: envelopeSchema(schemas, nil)}},
+			},
+		},
+	}
+
+	for _, name := range PathParams(route.Path) {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+	for _, param := range route.Query {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        param.Name,
+			In:          "query",
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      schemas.schemaOf(reflect.TypeOf(param.Type)),
+		})
+	}
+	if route.Filters != nil {
+		op.Parameters = append(op.Parameters, &Parameter{
+			Name:        FiltersParam,
+			In:          "query",
+			Description: "JSON 编码的过滤参数",
+			Required:    !route.FiltersOptional,
+			Content:     map[string]*MediaType{jsonMediaType: {Schema: schemas.schemaOf(reflect.TypeOf(route.Filters))}},
+		})
+	}
+	if route.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{jsonMediaType: {Schema: schemas.schemaOf(reflect.TypeOf(route.Body))}},
+		}
+	}
+
+	switch route.Auth {
+	case AuthUser, AuthAdmin:
+		op.Security = []map[string][]string{{securityBearer: {}}}
+	default:
+		// 匿名接口可携带 API key 获得更高的限流配额
+		op.Security = []map[string][]string{{}, {securityApiKey: {}}}
+	}
+	if route.Auth == AuthAdmin {
+		op.Responses["403"] = &Response{Description: "非管理员"}
+	}
+	if route.Auth != AuthNone {
+		op.Responses["401"] = &Response{Description: "未登录或 token 已失效"}
+	}
+	op.Responses["429"] = &Response{Description: "请求过于频繁, Retry-After 头为需等待的秒数"}
+	return op
+}
+
+// envelopeSchema 统一响应结构, data 为接口结果
+func envelopeSchema(schemas *schemaRegistry, result interface{}) *Schema {
+	data := &Schema{Nullable: true}
+	if result != nil {
+		data = schemas.schemaOf(reflect.TypeOf(result))
+	}
+	return &Schema{
+		Type: "object",
+		Properties: map[string]*Schema{
+			"trace_id": {Type: "string"},
+			"code":     {Type: "integer", Format: "int64", Example: errcode.CodeOK},
+			"msg":      {Type: "string"},
+			"data":     data,
+		},
+		Required: []string{"code", "msg", "data"},
+	}
+}
@@ -0,0 +1,4 @@
+// Package marketpb 市场只读 gRPC 接口定义及生成代码
+package marketpb
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative --go-grpc_out=.. --go-grpc_opt=paths=source_relative marketpb/market.proto
@@ -0,0 +1,4590 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: marketpb/market.proto
+
+// EasySwap 市场只读接口 (gRPC)
+// 与 REST 接口 /api/v1 共用 service 层, 字段名与 REST 响应的 JSON 字段保持一致,
+// 金额类字段 (decimal) 统一使用字符串表示, 避免精度丢失.
+
+package marketpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetCollectionDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId           int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CollectionAddress string `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+}
+
+func (x *GetCollectionDetailRequest) Reset() {
+	*x = GetCollectionDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionDetailRequest) ProtoMessage() {}
+
+func (x *GetCollectionDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionDetailRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionDetailRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCollectionDetailRequest) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *GetCollectionDetailRequest) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+type CollectionDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageUri       string `protobuf:"bytes,1,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address        string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	ChainId        int32  `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	FloorPrice     string `protobuf:"bytes,5,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	SellPrice      string `protobuf:"bytes,6,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	VolumeTotal    string `protobuf:"bytes,7,opt,name=volume_total,json=volumeTotal,proto3" json:"volume_total,omitempty"`
+	Volume_24H     string `protobuf:"bytes,8,opt,name=volume_24h,json=volume24h,proto3" json:"volume_24h,omitempty"`
+	Sold_24H       int64  `protobuf:"varint,9,opt,name=sold_24h,json=sold24h,proto3" json:"sold_24h,omitempty"`
+	ListAmount     int64  `protobuf:"varint,10,opt,name=list_amount,json=listAmount,proto3" json:"list_amount,omitempty"`
+	TotalSupply    int64  `protobuf:"varint,11,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	OwnerAmount    int64  `protobuf:"varint,12,opt,name=owner_amount,json=ownerAmount,proto3" json:"owner_amount,omitempty"`
+	RoyaltyFeeRate string `protobuf:"bytes,13,opt,name=royalty_fee_rate,json=royaltyFeeRate,proto3" json:"royalty_fee_rate,omitempty"`
+}
+
+func (x *CollectionDetail) Reset() {
+	*x = CollectionDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionDetail) ProtoMessage() {}
+
+func (x *CollectionDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionDetail.ProtoReflect.Descriptor instead.
+func (*CollectionDetail) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CollectionDetail) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *CollectionDetail) GetFloorPrice() string {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetSellPrice() string {
+	if x != nil {
+		return x.SellPrice
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetVolumeTotal() string {
+	if x != nil {
+		return x.VolumeTotal
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetVolume_24H() string {
+	if x != nil {
+		return x.Volume_24H
+	}
+	return ""
+}
+
+func (x *CollectionDetail) GetSold_24H() int64 {
+	if x != nil {
+		return x.Sold_24H
+	}
+	return 0
+}
+
+func (x *CollectionDetail) GetListAmount() int64 {
+	if x != nil {
+		return x.ListAmount
+	}
+	return 0
+}
+
+func (x *CollectionDetail) GetTotalSupply() int64 {
+	if x != nil {
+		return x.TotalSupply
+	}
+	return 0
+}
+
+func (x *CollectionDetail) GetOwnerAmount() int64 {
+	if x != nil {
+		return x.OwnerAmount
+	}
+	return 0
+}
+
+func (x *CollectionDetail) GetRoyaltyFeeRate() string {
+	if x != nil {
+		return x.RoyaltyFeeRate
+	}
+	return ""
+}
+
+type GetCollectionDetailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *CollectionDetail `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetCollectionDetailResponse) Reset() {
+	*x = GetCollectionDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionDetailResponse) ProtoMessage() {}
+
+func (x *GetCollectionDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionDetailResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionDetailResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetCollectionDetailResponse) GetResult() *CollectionDetail {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type TraitFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trait  string   `protobuf:"bytes,1,opt,name=trait,proto3" json:"trait,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *TraitFilter) Reset() {
+	*x = TraitFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraitFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraitFilter) ProtoMessage() {}
+
+func (x *TraitFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraitFilter.ProtoReflect.Descriptor instead.
+func (*TraitFilter) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TraitFilter) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *TraitFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetCollectionItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId           int32          `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CollectionAddress string         `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	Sort              int32          `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`            // 1-价格升序 2-挂单时间降序 3-成交价降序 5-稀有度升序 6-稀有度降序
+	Status            []int32        `protobuf:"varint,4,rep,packed,name=status,proto3" json:"status,omitempty"` // 1-一口价 2-有出价
+	Markets           []int32        `protobuf:"varint,5,rep,packed,name=markets,proto3" json:"markets,omitempty"`
+	TokenId           string         `protobuf:"bytes,6,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	UserAddress       string         `protobuf:"bytes,7,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	Traits            []*TraitFilter `protobuf:"bytes,8,rep,name=traits,proto3" json:"traits,omitempty"`
+	MinPrice          string         `protobuf:"bytes,9,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`  // 为空表示不限制
+	MaxPrice          string         `protobuf:"bytes,10,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // 为空表示不限制
+	Page              int32          `protobuf:"varint,11,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32          `protobuf:"varint,12,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetCollectionItemsRequest) Reset() {
+	*x = GetCollectionItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionItemsRequest) ProtoMessage() {}
+
+func (x *GetCollectionItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionItemsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCollectionItemsRequest) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *GetCollectionItemsRequest) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *GetCollectionItemsRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *GetCollectionItemsRequest) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetCollectionItemsRequest) GetMarkets() []int32 {
+	if x != nil {
+		return x.Markets
+	}
+	return nil
+}
+
+func (x *GetCollectionItemsRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetCollectionItemsRequest) GetUserAddress() string {
+	if x != nil {
+		return x.UserAddress
+	}
+	return ""
+}
+
+func (x *GetCollectionItemsRequest) GetTraits() []*TraitFilter {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *GetCollectionItemsRequest) GetMinPrice() string {
+	if x != nil {
+		return x.MinPrice
+	}
+	return ""
+}
+
+func (x *GetCollectionItemsRequest) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+func (x *GetCollectionItemsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCollectionItemsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ItemTrait struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ItemTrait) Reset() {
+	*x = ItemTrait{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemTrait) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemTrait) ProtoMessage() {}
+
+func (x *ItemTrait) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemTrait.ProtoReflect.Descriptor instead.
+func (*ItemTrait) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ItemTrait) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ItemTrait) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type NFTListingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUri          string       `protobuf:"bytes,2,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	VideoType         string       `protobuf:"bytes,3,opt,name=video_type,json=videoType,proto3" json:"video_type,omitempty"`
+	VideoUri          string       `protobuf:"bytes,4,opt,name=video_uri,json=videoUri,proto3" json:"video_uri,omitempty"`
+	CollectionAddress string       `protobuf:"bytes,5,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	TokenId           string       `protobuf:"bytes,6,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	OwnerAddress      string       `protobuf:"bytes,7,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	Traits            []*ItemTrait `protobuf:"bytes,8,rep,name=traits,proto3" json:"traits,omitempty"`
+	ListOrderId       string       `protobuf:"bytes,9,opt,name=list_order_id,json=listOrderId,proto3" json:"list_order_id,omitempty"`
+	ListTime          int64        `protobuf:"varint,10,opt,name=list_time,json=listTime,proto3" json:"list_time,omitempty"`
+	ListPrice         string       `protobuf:"bytes,11,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	ListExpireTime    int64        `protobuf:"varint,12,opt,name=list_expire_time,json=listExpireTime,proto3" json:"list_expire_time,omitempty"`
+	ListSalt          int64        `protobuf:"varint,13,opt,name=list_salt,json=listSalt,proto3" json:"list_salt,omitempty"`
+	ListMaker         string       `protobuf:"bytes,14,opt,name=list_maker,json=listMaker,proto3" json:"list_maker,omitempty"`
+	BidOrderId        string       `protobuf:"bytes,15,opt,name=bid_order_id,json=bidOrderId,proto3" json:"bid_order_id,omitempty"`
+	BidTime           int64        `protobuf:"varint,16,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	BidExpireTime     int64        `protobuf:"varint,17,opt,name=bid_expire_time,json=bidExpireTime,proto3" json:"bid_expire_time,omitempty"`
+	BidPrice          string       `protobuf:"bytes,18,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	BidSalt           int64        `protobuf:"varint,19,opt,name=bid_salt,json=bidSalt,proto3" json:"bid_salt,omitempty"`
+	BidMaker          string       `protobuf:"bytes,20,opt,name=bid_maker,json=bidMaker,proto3" json:"bid_maker,omitempty"`
+	BidType           int64        `protobuf:"varint,21,opt,name=bid_type,json=bidType,proto3" json:"bid_type,omitempty"`
+	BidSize           int64        `protobuf:"varint,22,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	BidUnfilled       int64        `protobuf:"varint,23,opt,name=bid_unfilled,json=bidUnfilled,proto3" json:"bid_unfilled,omitempty"`
+	MarketId          int32        `protobuf:"varint,24,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	LastSellPrice     string       `protobuf:"bytes,25,opt,name=last_sell_price,json=lastSellPrice,proto3" json:"last_sell_price,omitempty"`
+	OwnerOwnedAmount  int64        `protobuf:"varint,26,opt,name=owner_owned_amount,json=ownerOwnedAmount,proto3" json:"owner_owned_amount,omitempty"`
+	RarityScore       string       `protobuf:"bytes,27,opt,name=rarity_score,json=rarityScore,proto3" json:"rarity_score,omitempty"`
+	RarityRank        int64        `protobuf:"varint,28,opt,name=rarity_rank,json=rarityRank,proto3" json:"rarity_rank,omitempty"`
+	TraitCountRank    int64        `protobuf:"varint,29,opt,name=trait_count_rank,json=traitCountRank,proto3" json:"trait_count_rank,omitempty"`
+}
+
+func (x *NFTListingInfo) Reset() {
+	*x = NFTListingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NFTListingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NFTListingInfo) ProtoMessage() {}
+
+func (x *NFTListingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NFTListingInfo.ProtoReflect.Descriptor instead.
+func (*NFTListingInfo) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *NFTListingInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetVideoType() string {
+	if x != nil {
+		return x.VideoType
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetVideoUri() string {
+	if x != nil {
+		return x.VideoUri
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetOwnerAddress() string {
+	if x != nil {
+		return x.OwnerAddress
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetTraits() []*ItemTrait {
+	if x != nil {
+		return x.Traits
+	}
+	return nil
+}
+
+func (x *NFTListingInfo) GetListOrderId() string {
+	if x != nil {
+		return x.ListOrderId
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetListTime() int64 {
+	if x != nil {
+		return x.ListTime
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetListPrice() string {
+	if x != nil {
+		return x.ListPrice
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetListExpireTime() int64 {
+	if x != nil {
+		return x.ListExpireTime
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetListSalt() int64 {
+	if x != nil {
+		return x.ListSalt
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetListMaker() string {
+	if x != nil {
+		return x.ListMaker
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetBidOrderId() string {
+	if x != nil {
+		return x.BidOrderId
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetBidTime() int64 {
+	if x != nil {
+		return x.BidTime
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetBidExpireTime() int64 {
+	if x != nil {
+		return x.BidExpireTime
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetBidPrice() string {
+	if x != nil {
+		return x.BidPrice
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetBidSalt() int64 {
+	if x != nil {
+		return x.BidSalt
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetBidMaker() string {
+	if x != nil {
+		return x.BidMaker
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetBidType() int64 {
+	if x != nil {
+		return x.BidType
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetBidUnfilled() int64 {
+	if x != nil {
+		return x.BidUnfilled
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetMarketId() int32 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetLastSellPrice() string {
+	if x != nil {
+		return x.LastSellPrice
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetOwnerOwnedAmount() int64 {
+	if x != nil {
+		return x.OwnerOwnedAmount
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetRarityScore() string {
+	if x != nil {
+		return x.RarityScore
+	}
+	return ""
+}
+
+func (x *NFTListingInfo) GetRarityRank() int64 {
+	if x != nil {
+		return x.RarityRank
+	}
+	return 0
+}
+
+func (x *NFTListingInfo) GetTraitCountRank() int64 {
+	if x != nil {
+		return x.TraitCountRank
+	}
+	return 0
+}
+
+type GetCollectionItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*NFTListingInfo `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64             `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetCollectionItemsResponse) Reset() {
+	*x = GetCollectionItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionItemsResponse) ProtoMessage() {}
+
+func (x *GetCollectionItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionItemsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCollectionItemsResponse) GetResult() []*NFTListingInfo {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetCollectionItemsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCollectionBidsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId           int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CollectionAddress string `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	Page              int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetCollectionBidsRequest) Reset() {
+	*x = GetCollectionBidsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionBidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionBidsRequest) ProtoMessage() {}
+
+func (x *GetCollectionBidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionBidsRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionBidsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetCollectionBidsRequest) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *GetCollectionBidsRequest) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *GetCollectionBidsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCollectionBidsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CollectionBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Price   string `protobuf:"bytes,1,opt,name=price,proto3" json:"price,omitempty"`
+	Size    int32  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Total   string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Bidders int32  `protobuf:"varint,4,opt,name=bidders,proto3" json:"bidders,omitempty"`
+}
+
+func (x *CollectionBid) Reset() {
+	*x = CollectionBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionBid) ProtoMessage() {}
+
+func (x *CollectionBid) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionBid.ProtoReflect.Descriptor instead.
+func (*CollectionBid) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CollectionBid) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *CollectionBid) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CollectionBid) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
+
+func (x *CollectionBid) GetBidders() int32 {
+	if x != nil {
+		return x.Bidders
+	}
+	return 0
+}
+
+type GetCollectionBidsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*CollectionBid `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetCollectionBidsResponse) Reset() {
+	*x = GetCollectionBidsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCollectionBidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionBidsResponse) ProtoMessage() {}
+
+func (x *GetCollectionBidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionBidsResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionBidsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetCollectionBidsResponse) GetResult() []*CollectionBid {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetCollectionBidsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetItemBidsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId           int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CollectionAddress string `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	TokenId           string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	Page              int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize          int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetItemBidsRequest) Reset() {
+	*x = GetItemBidsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemBidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemBidsRequest) ProtoMessage() {}
+
+func (x *GetItemBidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemBidsRequest.ProtoReflect.Descriptor instead.
+func (*GetItemBidsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetItemBidsRequest) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *GetItemBidsRequest) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *GetItemBidsRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *GetItemBidsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetItemBidsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ItemBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarketplaceId     int32  `protobuf:"varint,1,opt,name=marketplace_id,json=marketplaceId,proto3" json:"marketplace_id,omitempty"`
+	CollectionAddress string `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	TokenId           string `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	OrderId           string `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	EventTime         int64  `protobuf:"varint,5,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	ExpireTime        int64  `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	Price             string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	Salt              int64  `protobuf:"varint,8,opt,name=salt,proto3" json:"salt,omitempty"`
+	BidSize           int64  `protobuf:"varint,9,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	BidUnfilled       int64  `protobuf:"varint,10,opt,name=bid_unfilled,json=bidUnfilled,proto3" json:"bid_unfilled,omitempty"`
+	Bidder            string `protobuf:"bytes,11,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	OrderType         int64  `protobuf:"varint,12,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Trait             string `protobuf:"bytes,13,opt,name=trait,proto3" json:"trait,omitempty"`
+	TraitValue        string `protobuf:"bytes,14,opt,name=trait_value,json=traitValue,proto3" json:"trait_value,omitempty"`
+}
+
+func (x *ItemBid) Reset() {
+	*x = ItemBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemBid) ProtoMessage() {}
+
+func (x *ItemBid) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemBid.ProtoReflect.Descriptor instead.
+func (*ItemBid) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ItemBid) GetMarketplaceId() int32 {
+	if x != nil {
+		return x.MarketplaceId
+	}
+	return 0
+}
+
+func (x *ItemBid) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *ItemBid) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *ItemBid) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ItemBid) GetEventTime() int64 {
+	if x != nil {
+		return x.EventTime
+	}
+	return 0
+}
+
+func (x *ItemBid) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *ItemBid) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *ItemBid) GetSalt() int64 {
+	if x != nil {
+		return x.Salt
+	}
+	return 0
+}
+
+func (x *ItemBid) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *ItemBid) GetBidUnfilled() int64 {
+	if x != nil {
+		return x.BidUnfilled
+	}
+	return 0
+}
+
+func (x *ItemBid) GetBidder() string {
+	if x != nil {
+		return x.Bidder
+	}
+	return ""
+}
+
+func (x *ItemBid) GetOrderType() int64 {
+	if x != nil {
+		return x.OrderType
+	}
+	return 0
+}
+
+func (x *ItemBid) GetTrait() string {
+	if x != nil {
+		return x.Trait
+	}
+	return ""
+}
+
+func (x *ItemBid) GetTraitValue() string {
+	if x != nil {
+		return x.TraitValue
+	}
+	return ""
+}
+
+type GetItemBidsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*ItemBid `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetItemBidsResponse) Reset() {
+	*x = GetItemBidsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemBidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemBidsResponse) ProtoMessage() {}
+
+func (x *GetItemBidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemBidsResponse.ProtoReflect.Descriptor instead.
+func (*GetItemBidsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetItemBidsResponse) GetResult() []*ItemBid {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetItemBidsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ActivityFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainIds            []int32  `protobuf:"varint,1,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"` // 为空表示所有支持的链
+	CollectionAddresses []string `protobuf:"bytes,2,rep,name=collection_addresses,json=collectionAddresses,proto3" json:"collection_addresses,omitempty"`
+	TokenId             string   `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	UserAddresses       []string `protobuf:"bytes,4,rep,name=user_addresses,json=userAddresses,proto3" json:"user_addresses,omitempty"`
+	EventTypes          []string `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // Sale, List, Offer, Transfer, Mint, Cancel
+}
+
+func (x *ActivityFilter) Reset() {
+	*x = ActivityFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFilter) ProtoMessage() {}
+
+func (x *ActivityFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFilter.ProtoReflect.Descriptor instead.
+func (*ActivityFilter) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ActivityFilter) GetChainIds() []int32 {
+	if x != nil {
+		return x.ChainIds
+	}
+	return nil
+}
+
+func (x *ActivityFilter) GetCollectionAddresses() []string {
+	if x != nil {
+		return x.CollectionAddresses
+	}
+	return nil
+}
+
+func (x *ActivityFilter) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *ActivityFilter) GetUserAddresses() []string {
+	if x != nil {
+		return x.UserAddresses
+	}
+	return nil
+}
+
+func (x *ActivityFilter) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type GetActivitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter   *ActivityFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Page     int32           `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32           `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *GetActivitiesRequest) Reset() {
+	*x = GetActivitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitiesRequest) ProtoMessage() {}
+
+func (x *GetActivitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetActivitiesRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetActivitiesRequest) GetFilter() *ActivityFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *GetActivitiesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetActivitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type Activity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventType          string `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	EventTime          int64  `protobuf:"varint,2,opt,name=event_time,json=eventTime,proto3" json:"event_time,omitempty"`
+	ImageUri           string `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	CollectionAddress  string `protobuf:"bytes,4,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	CollectionName     string `protobuf:"bytes,5,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionImageUri string `protobuf:"bytes,6,opt,name=collection_image_uri,json=collectionImageUri,proto3" json:"collection_image_uri,omitempty"`
+	TokenId            string `protobuf:"bytes,7,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	ItemName           string `protobuf:"bytes,8,opt,name=item_name,json=itemName,proto3" json:"item_name,omitempty"`
+	Currency           string `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Price              string `protobuf:"bytes,10,opt,name=price,proto3" json:"price,omitempty"`
+	Maker              string `protobuf:"bytes,11,opt,name=maker,proto3" json:"maker,omitempty"`
+	Taker              string `protobuf:"bytes,12,opt,name=taker,proto3" json:"taker,omitempty"`
+	TxHash             string `protobuf:"bytes,13,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	MarketplaceId      int32  `protobuf:"varint,14,opt,name=marketplace_id,json=marketplaceId,proto3" json:"marketplace_id,omitempty"`
+	ChainId            int32  `protobuf:"varint,15,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *Activity) Reset() {
+	*x = Activity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Activity) ProtoMessage() {}
+
+func (x *Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Activity.ProtoReflect.Descriptor instead.
+func (*Activity) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Activity) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *Activity) GetEventTime() int64 {
+	if x != nil {
+		return x.EventTime
+	}
+	return 0
+}
+
+func (x *Activity) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *Activity) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *Activity) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *Activity) GetCollectionImageUri() string {
+	if x != nil {
+		return x.CollectionImageUri
+	}
+	return ""
+}
+
+func (x *Activity) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *Activity) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
+}
+
+func (x *Activity) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Activity) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Activity) GetMaker() string {
+	if x != nil {
+		return x.Maker
+	}
+	return ""
+}
+
+func (x *Activity) GetTaker() string {
+	if x != nil {
+		return x.Taker
+	}
+	return ""
+}
+
+func (x *Activity) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *Activity) GetMarketplaceId() int32 {
+	if x != nil {
+		return x.MarketplaceId
+	}
+	return 0
+}
+
+func (x *Activity) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type GetActivitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*Activity `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetActivitiesResponse) Reset() {
+	*x = GetActivitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActivitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActivitiesResponse) ProtoMessage() {}
+
+func (x *GetActivitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActivitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetActivitiesResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetActivitiesResponse) GetResult() []*Activity {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetActivitiesResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StreamActivitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *ActivityFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *StreamActivitiesRequest) Reset() {
+	*x = StreamActivitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamActivitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamActivitiesRequest) ProtoMessage() {}
+
+func (x *StreamActivitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamActivitiesRequest.ProtoReflect.Descriptor instead.
+func (*StreamActivitiesRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamActivitiesRequest) GetFilter() *ActivityFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetUserCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserAddresses []string `protobuf:"bytes,1,rep,name=user_addresses,json=userAddresses,proto3" json:"user_addresses,omitempty"`
+}
+
+func (x *GetUserCollectionsRequest) Reset() {
+	*x = GetUserCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCollectionsRequest) ProtoMessage() {}
+
+func (x *GetUserCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserCollectionsRequest) GetUserAddresses() []string {
+	if x != nil {
+		return x.UserAddresses
+	}
+	return nil
+}
+
+type UserCollectionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId    int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address    string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Symbol     string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	ImageUri   string `protobuf:"bytes,5,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	ListAmount int32  `protobuf:"varint,6,opt,name=list_amount,json=listAmount,proto3" json:"list_amount,omitempty"`
+	ItemAmount int64  `protobuf:"varint,7,opt,name=item_amount,json=itemAmount,proto3" json:"item_amount,omitempty"`
+	FloorPrice string `protobuf:"bytes,8,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+}
+
+func (x *UserCollectionInfo) Reset() {
+	*x = UserCollectionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCollectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCollectionInfo) ProtoMessage() {}
+
+func (x *UserCollectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCollectionInfo.ProtoReflect.Descriptor instead.
+func (*UserCollectionInfo) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserCollectionInfo) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *UserCollectionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserCollectionInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UserCollectionInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *UserCollectionInfo) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *UserCollectionInfo) GetListAmount() int32 {
+	if x != nil {
+		return x.ListAmount
+	}
+	return 0
+}
+
+func (x *UserCollectionInfo) GetItemAmount() int64 {
+	if x != nil {
+		return x.ItemAmount
+	}
+	return 0
+}
+
+func (x *UserCollectionInfo) GetFloorPrice() string {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return ""
+}
+
+type UserChainInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId   int32  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ItemOwned int64  `protobuf:"varint,2,opt,name=item_owned,json=itemOwned,proto3" json:"item_owned,omitempty"`
+	ItemValue string `protobuf:"bytes,3,opt,name=item_value,json=itemValue,proto3" json:"item_value,omitempty"`
+}
+
+func (x *UserChainInfo) Reset() {
+	*x = UserChainInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserChainInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChainInfo) ProtoMessage() {}
+
+func (x *UserChainInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChainInfo.ProtoReflect.Descriptor instead.
+func (*UserChainInfo) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UserChainInfo) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *UserChainInfo) GetItemOwned() int64 {
+	if x != nil {
+		return x.ItemOwned
+	}
+	return 0
+}
+
+func (x *UserChainInfo) GetItemValue() string {
+	if x != nil {
+		return x.ItemValue
+	}
+	return ""
+}
+
+type UserCollectionsData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionInfo []*UserCollectionInfo `protobuf:"bytes,1,rep,name=collection_info,json=collectionInfo,proto3" json:"collection_info,omitempty"`
+	ChainInfo      []*UserChainInfo      `protobuf:"bytes,2,rep,name=chain_info,json=chainInfo,proto3" json:"chain_info,omitempty"`
+}
+
+func (x *UserCollectionsData) Reset() {
+	*x = UserCollectionsData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserCollectionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserCollectionsData) ProtoMessage() {}
+
+func (x *UserCollectionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserCollectionsData.ProtoReflect.Descriptor instead.
+func (*UserCollectionsData) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserCollectionsData) GetCollectionInfo() []*UserCollectionInfo {
+	if x != nil {
+		return x.CollectionInfo
+	}
+	return nil
+}
+
+func (x *UserCollectionsData) GetChainInfo() []*UserChainInfo {
+	if x != nil {
+		return x.ChainInfo
+	}
+	return nil
+}
+
+type GetUserCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result *UserCollectionsData `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetUserCollectionsResponse) Reset() {
+	*x = GetUserCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCollectionsResponse) ProtoMessage() {}
+
+func (x *GetUserCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserCollectionsResponse) GetResult() *UserCollectionsData {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type PortfolioFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainIds            []int32  `protobuf:"varint,1,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"` // 为空表示所有支持的链
+	CollectionAddresses []string `protobuf:"bytes,2,rep,name=collection_addresses,json=collectionAddresses,proto3" json:"collection_addresses,omitempty"`
+	UserAddresses       []string `protobuf:"bytes,3,rep,name=user_addresses,json=userAddresses,proto3" json:"user_addresses,omitempty"`
+	Page                int32    `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize            int32    `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+}
+
+func (x *PortfolioFilter) Reset() {
+	*x = PortfolioFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioFilter) ProtoMessage() {}
+
+func (x *PortfolioFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioFilter.ProtoReflect.Descriptor instead.
+func (*PortfolioFilter) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PortfolioFilter) GetChainIds() []int32 {
+	if x != nil {
+		return x.ChainIds
+	}
+	return nil
+}
+
+func (x *PortfolioFilter) GetCollectionAddresses() []string {
+	if x != nil {
+		return x.CollectionAddresses
+	}
+	return nil
+}
+
+func (x *PortfolioFilter) GetUserAddresses() []string {
+	if x != nil {
+		return x.UserAddresses
+	}
+	return nil
+}
+
+func (x *PortfolioFilter) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *PortfolioFilter) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetUserItemsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *PortfolioFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetUserItemsRequest) Reset() {
+	*x = GetUserItemsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserItemsRequest) ProtoMessage() {}
+
+func (x *GetUserItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserItemsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetUserItemsRequest) GetFilter() *PortfolioFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type PortfolioItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId            int32   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CollectionAddress  string  `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	CollectionName     string  `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionImageUri string  `protobuf:"bytes,4,opt,name=collection_image_uri,json=collectionImageUri,proto3" json:"collection_image_uri,omitempty"`
+	TokenId            string  `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	ImageUri           string  `protobuf:"bytes,6,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	LastCostPrice      float64 `protobuf:"fixed64,7,opt,name=last_cost_price,json=lastCostPrice,proto3" json:"last_cost_price,omitempty"`
+	OwnedTime          int64   `protobuf:"varint,8,opt,name=owned_time,json=ownedTime,proto3" json:"owned_time,omitempty"`
+	Owner              string  `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty"`
+	Listing            bool    `protobuf:"varint,10,opt,name=listing,proto3" json:"listing,omitempty"`
+	MarketplaceId      int32   `protobuf:"varint,11,opt,name=marketplace_id,json=marketplaceId,proto3" json:"marketplace_id,omitempty"`
+	Name               string  `protobuf:"bytes,12,opt,name=name,proto3" json:"name,omitempty"`
+	FloorPrice         string  `protobuf:"bytes,13,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	ListOrderId        string  `protobuf:"bytes,14,opt,name=list_order_id,json=listOrderId,proto3" json:"list_order_id,omitempty"`
+	ListTime           int64   `protobuf:"varint,15,opt,name=list_time,json=listTime,proto3" json:"list_time,omitempty"`
+	ListPrice          string  `protobuf:"bytes,16,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	ListExpireTime     int64   `protobuf:"varint,17,opt,name=list_expire_time,json=listExpireTime,proto3" json:"list_expire_time,omitempty"`
+	ListSalt           int64   `protobuf:"varint,18,opt,name=list_salt,json=listSalt,proto3" json:"list_salt,omitempty"`
+	ListMaker          string  `protobuf:"bytes,19,opt,name=list_maker,json=listMaker,proto3" json:"list_maker,omitempty"`
+	BidOrderId         string  `protobuf:"bytes,20,opt,name=bid_order_id,json=bidOrderId,proto3" json:"bid_order_id,omitempty"`
+	BidTime            int64   `protobuf:"varint,21,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	BidExpireTime      int64   `protobuf:"varint,22,opt,name=bid_expire_time,json=bidExpireTime,proto3" json:"bid_expire_time,omitempty"`
+	BidPrice           string  `protobuf:"bytes,23,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	BidSalt            int64   `protobuf:"varint,24,opt,name=bid_salt,json=bidSalt,proto3" json:"bid_salt,omitempty"`
+	BidMaker           string  `protobuf:"bytes,25,opt,name=bid_maker,json=bidMaker,proto3" json:"bid_maker,omitempty"`
+	BidType            int64   `protobuf:"varint,26,opt,name=bid_type,json=bidType,proto3" json:"bid_type,omitempty"`
+	BidSize            int64   `protobuf:"varint,27,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	BidUnfilled        int64   `protobuf:"varint,28,opt,name=bid_unfilled,json=bidUnfilled,proto3" json:"bid_unfilled,omitempty"`
+}
+
+func (x *PortfolioItem) Reset() {
+	*x = PortfolioItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortfolioItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioItem) ProtoMessage() {}
+
+func (x *PortfolioItem) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioItem.ProtoReflect.Descriptor instead.
+func (*PortfolioItem) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PortfolioItem) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetCollectionImageUri() string {
+	if x != nil {
+		return x.CollectionImageUri
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetLastCostPrice() float64 {
+	if x != nil {
+		return x.LastCostPrice
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetOwnedTime() int64 {
+	if x != nil {
+		return x.OwnedTime
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetListing() bool {
+	if x != nil {
+		return x.Listing
+	}
+	return false
+}
+
+func (x *PortfolioItem) GetMarketplaceId() int32 {
+	if x != nil {
+		return x.MarketplaceId
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetFloorPrice() string {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetListOrderId() string {
+	if x != nil {
+		return x.ListOrderId
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetListTime() int64 {
+	if x != nil {
+		return x.ListTime
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetListPrice() string {
+	if x != nil {
+		return x.ListPrice
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetListExpireTime() int64 {
+	if x != nil {
+		return x.ListExpireTime
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetListSalt() int64 {
+	if x != nil {
+		return x.ListSalt
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetListMaker() string {
+	if x != nil {
+		return x.ListMaker
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetBidOrderId() string {
+	if x != nil {
+		return x.BidOrderId
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetBidTime() int64 {
+	if x != nil {
+		return x.BidTime
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetBidExpireTime() int64 {
+	if x != nil {
+		return x.BidExpireTime
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetBidPrice() string {
+	if x != nil {
+		return x.BidPrice
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetBidSalt() int64 {
+	if x != nil {
+		return x.BidSalt
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetBidMaker() string {
+	if x != nil {
+		return x.BidMaker
+	}
+	return ""
+}
+
+func (x *PortfolioItem) GetBidType() int64 {
+	if x != nil {
+		return x.BidType
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *PortfolioItem) GetBidUnfilled() int64 {
+	if x != nil {
+		return x.BidUnfilled
+	}
+	return 0
+}
+
+type GetUserItemsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*PortfolioItem `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetUserItemsResponse) Reset() {
+	*x = GetUserItemsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserItemsResponse) ProtoMessage() {}
+
+func (x *GetUserItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserItemsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUserItemsResponse) GetResult() []*PortfolioItem {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetUserItemsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUserListingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *PortfolioFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetUserListingsRequest) Reset() {
+	*x = GetUserListingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserListingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserListingsRequest) ProtoMessage() {}
+
+func (x *GetUserListingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserListingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserListingsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUserListingsRequest) GetFilter() *PortfolioFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type Listing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionAddress string `protobuf:"bytes,1,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	CollectionName    string `protobuf:"bytes,2,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	ImageUri          string `protobuf:"bytes,3,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Name              string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	TokenId           string `protobuf:"bytes,5,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	LastCostPrice     string `protobuf:"bytes,6,opt,name=last_cost_price,json=lastCostPrice,proto3" json:"last_cost_price,omitempty"`
+	MarketplaceId     int32  `protobuf:"varint,7,opt,name=marketplace_id,json=marketplaceId,proto3" json:"marketplace_id,omitempty"`
+	ChainId           int32  `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ListOrderId       string `protobuf:"bytes,9,opt,name=list_order_id,json=listOrderId,proto3" json:"list_order_id,omitempty"`
+	ListTime          int64  `protobuf:"varint,10,opt,name=list_time,json=listTime,proto3" json:"list_time,omitempty"`
+	ListPrice         string `protobuf:"bytes,11,opt,name=list_price,json=listPrice,proto3" json:"list_price,omitempty"`
+	ListExpireTime    int64  `protobuf:"varint,12,opt,name=list_expire_time,json=listExpireTime,proto3" json:"list_expire_time,omitempty"`
+	ListSalt          int64  `protobuf:"varint,13,opt,name=list_salt,json=listSalt,proto3" json:"list_salt,omitempty"`
+	ListMaker         string `protobuf:"bytes,14,opt,name=list_maker,json=listMaker,proto3" json:"list_maker,omitempty"`
+	BidOrderId        string `protobuf:"bytes,15,opt,name=bid_order_id,json=bidOrderId,proto3" json:"bid_order_id,omitempty"`
+	BidTime           int64  `protobuf:"varint,16,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	BidExpireTime     int64  `protobuf:"varint,17,opt,name=bid_expire_time,json=bidExpireTime,proto3" json:"bid_expire_time,omitempty"`
+	BidPrice          string `protobuf:"bytes,18,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	BidSalt           int64  `protobuf:"varint,19,opt,name=bid_salt,json=bidSalt,proto3" json:"bid_salt,omitempty"`
+	BidMaker          string `protobuf:"bytes,20,opt,name=bid_maker,json=bidMaker,proto3" json:"bid_maker,omitempty"`
+	BidType           int64  `protobuf:"varint,21,opt,name=bid_type,json=bidType,proto3" json:"bid_type,omitempty"`
+	BidSize           int64  `protobuf:"varint,22,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	BidUnfilled       int64  `protobuf:"varint,23,opt,name=bid_unfilled,json=bidUnfilled,proto3" json:"bid_unfilled,omitempty"`
+	FloorPrice        string `protobuf:"bytes,24,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+}
+
+func (x *Listing) Reset() {
+	*x = Listing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listing) ProtoMessage() {}
+
+func (x *Listing) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listing.ProtoReflect.Descriptor instead.
+func (*Listing) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Listing) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *Listing) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *Listing) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *Listing) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Listing) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *Listing) GetLastCostPrice() string {
+	if x != nil {
+		return x.LastCostPrice
+	}
+	return ""
+}
+
+func (x *Listing) GetMarketplaceId() int32 {
+	if x != nil {
+		return x.MarketplaceId
+	}
+	return 0
+}
+
+func (x *Listing) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *Listing) GetListOrderId() string {
+	if x != nil {
+		return x.ListOrderId
+	}
+	return ""
+}
+
+func (x *Listing) GetListTime() int64 {
+	if x != nil {
+		return x.ListTime
+	}
+	return 0
+}
+
+func (x *Listing) GetListPrice() string {
+	if x != nil {
+		return x.ListPrice
+	}
+	return ""
+}
+
+func (x *Listing) GetListExpireTime() int64 {
+	if x != nil {
+		return x.ListExpireTime
+	}
+	return 0
+}
+
+func (x *Listing) GetListSalt() int64 {
+	if x != nil {
+		return x.ListSalt
+	}
+	return 0
+}
+
+func (x *Listing) GetListMaker() string {
+	if x != nil {
+		return x.ListMaker
+	}
+	return ""
+}
+
+func (x *Listing) GetBidOrderId() string {
+	if x != nil {
+		return x.BidOrderId
+	}
+	return ""
+}
+
+func (x *Listing) GetBidTime() int64 {
+	if x != nil {
+		return x.BidTime
+	}
+	return 0
+}
+
+func (x *Listing) GetBidExpireTime() int64 {
+	if x != nil {
+		return x.BidExpireTime
+	}
+	return 0
+}
+
+func (x *Listing) GetBidPrice() string {
+	if x != nil {
+		return x.BidPrice
+	}
+	return ""
+}
+
+func (x *Listing) GetBidSalt() int64 {
+	if x != nil {
+		return x.BidSalt
+	}
+	return 0
+}
+
+func (x *Listing) GetBidMaker() string {
+	if x != nil {
+		return x.BidMaker
+	}
+	return ""
+}
+
+func (x *Listing) GetBidType() int64 {
+	if x != nil {
+		return x.BidType
+	}
+	return 0
+}
+
+func (x *Listing) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *Listing) GetBidUnfilled() int64 {
+	if x != nil {
+		return x.BidUnfilled
+	}
+	return 0
+}
+
+func (x *Listing) GetFloorPrice() string {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return ""
+}
+
+type GetUserListingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*Listing `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetUserListingsResponse) Reset() {
+	*x = GetUserListingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserListingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserListingsResponse) ProtoMessage() {}
+
+func (x *GetUserListingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserListingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserListingsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetUserListingsResponse) GetResult() []*Listing {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetUserListingsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUserBidsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *PortfolioFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *GetUserBidsRequest) Reset() {
+	*x = GetUserBidsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserBidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBidsRequest) ProtoMessage() {}
+
+func (x *GetUserBidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBidsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserBidsRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetUserBidsRequest) GetFilter() *PortfolioFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type BidInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BidOrderId    string `protobuf:"bytes,1,opt,name=bid_order_id,json=bidOrderId,proto3" json:"bid_order_id,omitempty"`
+	BidTime       int64  `protobuf:"varint,2,opt,name=bid_time,json=bidTime,proto3" json:"bid_time,omitempty"`
+	BidExpireTime int64  `protobuf:"varint,3,opt,name=bid_expire_time,json=bidExpireTime,proto3" json:"bid_expire_time,omitempty"`
+	BidPrice      string `protobuf:"bytes,4,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	BidSalt       int64  `protobuf:"varint,5,opt,name=bid_salt,json=bidSalt,proto3" json:"bid_salt,omitempty"`
+	BidSize       int64  `protobuf:"varint,6,opt,name=bid_size,json=bidSize,proto3" json:"bid_size,omitempty"`
+	BidUnfilled   int64  `protobuf:"varint,7,opt,name=bid_unfilled,json=bidUnfilled,proto3" json:"bid_unfilled,omitempty"`
+}
+
+func (x *BidInfo) Reset() {
+	*x = BidInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BidInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BidInfo) ProtoMessage() {}
+
+func (x *BidInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BidInfo.ProtoReflect.Descriptor instead.
+func (*BidInfo) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BidInfo) GetBidOrderId() string {
+	if x != nil {
+		return x.BidOrderId
+	}
+	return ""
+}
+
+func (x *BidInfo) GetBidTime() int64 {
+	if x != nil {
+		return x.BidTime
+	}
+	return 0
+}
+
+func (x *BidInfo) GetBidExpireTime() int64 {
+	if x != nil {
+		return x.BidExpireTime
+	}
+	return 0
+}
+
+func (x *BidInfo) GetBidPrice() string {
+	if x != nil {
+		return x.BidPrice
+	}
+	return ""
+}
+
+func (x *BidInfo) GetBidSalt() int64 {
+	if x != nil {
+		return x.BidSalt
+	}
+	return 0
+}
+
+func (x *BidInfo) GetBidSize() int64 {
+	if x != nil {
+		return x.BidSize
+	}
+	return 0
+}
+
+func (x *BidInfo) GetBidUnfilled() int64 {
+	if x != nil {
+		return x.BidUnfilled
+	}
+	return 0
+}
+
+type UserBid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId           int32      `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	CollectionAddress string     `protobuf:"bytes,2,opt,name=collection_address,json=collectionAddress,proto3" json:"collection_address,omitempty"`
+	TokenId           string     `protobuf:"bytes,3,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	BidPrice          string     `protobuf:"bytes,4,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	MarketplaceId     int32      `protobuf:"varint,5,opt,name=marketplace_id,json=marketplaceId,proto3" json:"marketplace_id,omitempty"`
+	ExpireTime        int64      `protobuf:"varint,6,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	BidType           int64      `protobuf:"varint,7,opt,name=bid_type,json=bidType,proto3" json:"bid_type,omitempty"`
+	CollectionName    string     `protobuf:"bytes,8,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	ImageUri          string     `protobuf:"bytes,9,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	OrderSize         int64      `protobuf:"varint,10,opt,name=order_size,json=orderSize,proto3" json:"order_size,omitempty"`
+	BidInfos          []*BidInfo `protobuf:"bytes,11,rep,name=bid_infos,json=bidInfos,proto3" json:"bid_infos,omitempty"`
+}
+
+func (x *UserBid) Reset() {
+	*x = UserBid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBid) ProtoMessage() {}
+
+func (x *UserBid) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBid.ProtoReflect.Descriptor instead.
+func (*UserBid) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UserBid) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *UserBid) GetCollectionAddress() string {
+	if x != nil {
+		return x.CollectionAddress
+	}
+	return ""
+}
+
+func (x *UserBid) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+func (x *UserBid) GetBidPrice() string {
+	if x != nil {
+		return x.BidPrice
+	}
+	return ""
+}
+
+func (x *UserBid) GetMarketplaceId() int32 {
+	if x != nil {
+		return x.MarketplaceId
+	}
+	return 0
+}
+
+func (x *UserBid) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *UserBid) GetBidType() int64 {
+	if x != nil {
+		return x.BidType
+	}
+	return 0
+}
+
+func (x *UserBid) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *UserBid) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *UserBid) GetOrderSize() int64 {
+	if x != nil {
+		return x.OrderSize
+	}
+	return 0
+}
+
+func (x *UserBid) GetBidInfos() []*BidInfo {
+	if x != nil {
+		return x.BidInfos
+	}
+	return nil
+}
+
+type GetUserBidsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*UserBid `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+	Count  int64      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetUserBidsResponse) Reset() {
+	*x = GetUserBidsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserBidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserBidsResponse) ProtoMessage() {}
+
+func (x *GetUserBidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserBidsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserBidsResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetUserBidsResponse) GetResult() []*UserBid {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GetUserBidsResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetTopRankingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range string `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"` // 15m, 1h, 6h, 1d, 7d, 30d, 为空默认 1d
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetTopRankingRequest) Reset() {
+	*x = GetTopRankingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopRankingRequest) ProtoMessage() {}
+
+func (x *GetTopRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetTopRankingRequest) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetTopRankingRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *GetTopRankingRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CollectionRanking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImageUri         string `protobuf:"bytes,1,opt,name=image_uri,json=imageUri,proto3" json:"image_uri,omitempty"`
+	Name             string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address          string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	FloorPrice       string `protobuf:"bytes,4,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	FloorPriceChange string `protobuf:"bytes,5,opt,name=floor_price_change,json=floorPriceChange,proto3" json:"floor_price_change,omitempty"`
+	SellPrice        string `protobuf:"bytes,6,opt,name=sell_price,json=sellPrice,proto3" json:"sell_price,omitempty"`
+	Volume           string `protobuf:"bytes,7,opt,name=volume,proto3" json:"volume,omitempty"`
+	ItemNum          int64  `protobuf:"varint,8,opt,name=item_num,json=itemNum,proto3" json:"item_num,omitempty"`
+	ItemOwner        int64  `protobuf:"varint,9,opt,name=item_owner,json=itemOwner,proto3" json:"item_owner,omitempty"`
+	ItemSold         int64  `protobuf:"varint,10,opt,name=item_sold,json=itemSold,proto3" json:"item_sold,omitempty"`
+	ListAmount       int32  `protobuf:"varint,11,opt,name=list_amount,json=listAmount,proto3" json:"list_amount,omitempty"`
+	ChainId          int32  `protobuf:"varint,12,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *CollectionRanking) Reset() {
+	*x = CollectionRanking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionRanking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionRanking) ProtoMessage() {}
+
+func (x *CollectionRanking) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionRanking.ProtoReflect.Descriptor instead.
+func (*CollectionRanking) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CollectionRanking) GetImageUri() string {
+	if x != nil {
+		return x.ImageUri
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetFloorPrice() string {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetFloorPriceChange() string {
+	if x != nil {
+		return x.FloorPriceChange
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetSellPrice() string {
+	if x != nil {
+		return x.SellPrice
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetVolume() string {
+	if x != nil {
+		return x.Volume
+	}
+	return ""
+}
+
+func (x *CollectionRanking) GetItemNum() int64 {
+	if x != nil {
+		return x.ItemNum
+	}
+	return 0
+}
+
+func (x *CollectionRanking) GetItemOwner() int64 {
+	if x != nil {
+		return x.ItemOwner
+	}
+	return 0
+}
+
+func (x *CollectionRanking) GetItemSold() int64 {
+	if x != nil {
+		return x.ItemSold
+	}
+	return 0
+}
+
+func (x *CollectionRanking) GetListAmount() int32 {
+	if x != nil {
+		return x.ListAmount
+	}
+	return 0
+}
+
+func (x *CollectionRanking) GetChainId() int32 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+type GetTopRankingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result []*CollectionRanking `protobuf:"bytes,1,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *GetTopRankingResponse) Reset() {
+	*x = GetTopRankingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_marketpb_market_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTopRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTopRankingResponse) ProtoMessage() {}
+
+func (x *GetTopRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_marketpb_market_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTopRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetTopRankingResponse) Descriptor() ([]byte, []int) {
+	return file_marketpb_market_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetTopRankingResponse) GetResult() []*CollectionRanking {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+var File_marketpb_market_proto protoreflect.FileDescriptor
+
+var file_marketpb_market_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x62, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x22, 0x66, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0xa6, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x32, 0x34, 0x68, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x32, 0x34, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x64, 0x5f, 0x32, 0x34, 0x68, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x6f, 0x6c, 0x64, 0x32, 0x34, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x61,
+	0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x3b, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x69, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x8d, 0x03, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x74, 0x72, 0x61, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x33, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x54, 0x72,
+	0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xdd, 0x07, 0x0a, 0x0e,
+	0x4e, 0x46, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x55, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x06, 0x74, 0x72,
+	0x61, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x61, 0x73,
+	0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x54, 0x72, 0x61, 0x69, 0x74, 0x52, 0x06, 0x74, 0x72, 0x61, 0x69, 0x74,
+	0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x69, 0x73,
+	0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62,
+	0x69, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64,
+	0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64,
+	0x53, 0x61, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x6b, 0x65,
+	0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x64, 0x4d, 0x61, 0x6b, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x62, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x62, 0x69, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x75,
+	0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62,
+	0x69, 0x64, 0x55, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x2c, 0x0a, 0x12, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x61, 0x6e,
+	0x6b, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x72, 0x61,
+	0x69, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x6e, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x61, 0x73, 0x79,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x46, 0x54, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x22, 0x69, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x73, 0x22, 0x6c,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x61,
+	0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xab, 0x03, 0x0a, 0x07, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62,
+	0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62,
+	0x69, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x75, 0x6e,
+	0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x69,
+	0x64, 0x55, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64,
+	0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x72, 0x61, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x69, 0x74, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x72, 0x61,
+	0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x69, 0x64, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x0e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22,
+	0x83, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xe0, 0x03, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x2d, 0x0a,
+	0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a,
+	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x22, 0x68, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x13, 0x55,
+	0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x4f, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x61,
+	0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x5d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x52, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x22, 0x98, 0x07, 0x0a, 0x0d, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
+	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x75, 0x72, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x55, 0x72, 0x69, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x43, 0x6f, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6f,
+	0x77, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f,
+	0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73,
+	0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c,
+	0x62, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x64,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x62, 0x69, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64,
+	0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69,
+	0x64, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x69, 0x64, 0x55, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x22,
+	0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x55, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0x91, 0x06, 0x0a, 0x07, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x12, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x61, 0x6c, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x6c, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12,
+	0x20, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f,
+	0x62, 0x69, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x69, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x69, 0x64, 0x5f, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x69, 0x64, 0x4d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x69, 0x64, 0x55, 0x6e, 0x66, 0x69, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0x64, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x51, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xe4, 0x01, 0x0a,
+	0x07, 0x42, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x69, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x69, 0x64, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x62, 0x69, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69,
+	0x64, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69,
+	0x64, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x69, 0x64, 0x5f, 0x75, 0x6e, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x69, 0x64, 0x55, 0x6e, 0x66, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x22, 0x8d, 0x03, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72, 0x42, 0x69, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x69, 0x64,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x62, 0x69, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x62, 0x69, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x73, 0x22, 0x60, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x69,
+	0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x61, 0x73,
+	0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x69, 0x64, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xf7, 0x02, 0x0a, 0x11, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66,
+	0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c,
+	0x6c, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x6c, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6e, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69,
+	0x74, 0x65, 0x6d, 0x53, 0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6c, 0x69,
+	0x73, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x22, 0x56, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65,
+	0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x6e, 0x6b,
+	0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x32, 0x98, 0x09, 0x0a, 0x06,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x76, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x2e,
+	0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x2d, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x73, 0x12, 0x2c, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x42, 0x69, 0x64, 0x73, 0x12, 0x26, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65,
+	0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x10, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x2b, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65,
+	0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x30, 0x01, 0x12, 0x73, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x2d, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x27, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x65, 0x61, 0x73,
+	0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5e, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x69, 0x64, 0x73, 0x12,
+	0x26, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x69, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x64, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x28, 0x2e, 0x65, 0x61, 0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x61, 0x6e,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x65, 0x61,
+	0x73, 0x79, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x54, 0x61, 0x73,
+	0x6b, 0x2f, 0x45, 0x61, 0x73, 0x79, 0x53, 0x77, 0x61, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2f, 0x73, 0x72, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_marketpb_market_proto_rawDescOnce sync.Once
+	file_marketpb_market_proto_rawDescData = file_marketpb_market_proto_rawDesc
+)
+
+func file_marketpb_market_proto_rawDescGZIP() []byte {
+	file_marketpb_market_proto_rawDescOnce.Do(func() {
+		file_marketpb_market_proto_rawDescData = protoimpl.X.CompressGZIP(file_marketpb_market_proto_rawDescData)
+	})
+	return file_marketpb_market_proto_rawDescData
+}
+
+var file_marketpb_market_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_marketpb_market_proto_goTypes = []interface{}{
+	(*GetCollectionDetailRequest)(nil),  // 0: easyswap.market.v1.GetCollectionDetailRequest
+	(*CollectionDetail)(nil),            // 1: easyswap.market.v1.CollectionDetail
+	(*GetCollectionDetailResponse)(nil), // 2: easyswap.market.v1.GetCollectionDetailResponse
+	(*TraitFilter)(nil),                 // 3: easyswap.market.v1.TraitFilter
+	(*GetCollectionItemsRequest)(nil),   // 4: easyswap.market.v1.GetCollectionItemsRequest
+	(*ItemTrait)(nil),                   // 5: easyswap.market.v1.ItemTrait
+	(*NFTListingInfo)(nil),              // 6: easyswap.market.v1.NFTListingInfo
+	(*GetCollectionItemsResponse)(nil),  // 7: easyswap.market.v1.GetCollectionItemsResponse
+	(*GetCollectionBidsRequest)(nil),    // 8: easyswap.market.v1.GetCollectionBidsRequest
+	(*CollectionBid)(nil),               // 9: easyswap.market.v1.CollectionBid
+	(*GetCollectionBidsResponse)(nil),   // 10: easyswap.market.v1.GetCollectionBidsResponse
+	(*GetItemBidsRequest)(nil),          // 11: easyswap.market.v1.GetItemBidsRequest
+	(*ItemBid)(nil),                     // 12: easyswap.market.v1.ItemBid
+	(*GetItemBidsResponse)(nil),         // 13: easyswap.market.v1.GetItemBidsResponse
+	(*ActivityFilter)(nil),              // 14: easyswap.market.v1.ActivityFilter
+	(*GetActivitiesRequest)(nil),        // 15: easyswap.market.v1.GetActivitiesRequest
+	(*Activity)(nil),                    // 16: easyswap.market.v1.Activity
+	(*GetActivitiesResponse)(nil),       // 17: easyswap.market.v1.GetActivitiesResponse
+	(*StreamActivitiesRequest)(nil),     // 18: easyswap.market.v1.StreamActivitiesRequest
+	(*GetUserCollectionsRequest)(nil),   // 19: easyswap.market.v1.GetUserCollectionsRequest
+	(*UserCollectionInfo)(nil),          // 20: easyswap.market.v1.UserCollectionInfo
+	(*UserChainInfo)(nil),               // 21: easyswap.market.v1.UserChainInfo
+	(*UserCollectionsData)(nil),         // 22: easyswap.market.v1.UserCollectionsData
+	(*GetUserCollectionsResponse)(nil),  // 23: easyswap.market.v1.GetUserCollectionsResponse
+	(*PortfolioFilter)(nil),             // 24: easyswap.market.v1.PortfolioFilter
+	(*GetUserItemsRequest)(nil),         // 25: easyswap.market.v1.GetUserItemsRequest
+	(*PortfolioItem)(nil),               // 26: easyswap.market.v1.PortfolioItem
+	(*GetUserItemsResponse)(nil),        // 27: easyswap.market.v1.GetUserItemsResponse
+	(*GetUserListingsRequest)(nil),      // 28: easyswap.market.v1.GetUserListingsRequest
+	(*Listing)(nil),                     // 29: easyswap.market.v1.Listing
+	(*GetUserListingsResponse)(nil),     // 30: easyswap.market.v1.GetUserListingsResponse
+	(*GetUserBidsRequest)(nil),          // 31: easyswap.market.v1.GetUserBidsRequest
+	(*BidInfo)(nil),                     // 32: easyswap.market.v1.BidInfo
+	(*UserBid)(nil),                     // 33: easyswap.market.v1.UserBid
+	(*GetUserBidsResponse)(nil),         // 34: easyswap.market.v1.GetUserBidsResponse
+	(*GetTopRankingRequest)(nil),        // 35: easyswap.market.v1.GetTopRankingRequest
+	(*CollectionRanking)(nil),           // 36: easyswap.market.v1.CollectionRanking
+	(*GetTopRankingResponse)(nil),       // 37: easyswap.market.v1.GetTopRankingResponse
+}
+var file_marketpb_market_proto_depIdxs = []int32{
+	1,  // 0: easyswap.market.v1.GetCollectionDetailResponse.result:type_name -> easyswap.market.v1.CollectionDetail
+	3,  // 1: easyswap.market.v1.GetCollectionItemsRequest.traits:type_name -> easyswap.market.v1.TraitFilter
+	5,  // 2: easyswap.market.v1.NFTListingInfo.traits:type_name -> easyswap.market.v1.ItemTrait
+	6,  // 3: easyswap.market.v1.GetCollectionItemsResponse.result:type_name -> easyswap.market.v1.NFTListingInfo
+	9,  // 4: easyswap.market.v1.GetCollectionBidsResponse.result:type_name -> easyswap.market.v1.CollectionBid
+	12, // 5: easyswap.market.v1.GetItemBidsResponse.result:type_name -> easyswap.market.v1.ItemBid
+	14, // 6: easyswap.market.v1.GetActivitiesRequest.filter:type_name -> easyswap.market.v1.ActivityFilter
+	16, // 7: easyswap.market.v1.GetActivitiesResponse.result:type_name -> easyswap.market.v1.Activity
+	14, // 8: easyswap.market.v1.StreamActivitiesRequest.filter:type_name -> easyswap.market.v1.ActivityFilter
+	20, // 9: easyswap.market.v1.UserCollectionsData.collection_info:type_name -> easyswap.market.v1.UserCollectionInfo
+	21, // 10: easyswap.market.v1.UserCollectionsData.chain_info:type_name -> easyswap.market.v1.UserChainInfo
+	22, // 11: easyswap.market.v1.GetUserCollectionsResponse.result:type_name -> easyswap.market.v1.UserCollectionsData
+	24, // 12: easyswap.market.v1.GetUserItemsRequest.filter:type_name -> easyswap.market.v1.PortfolioFilter
+	26, // 13: easyswap.market.v1.GetUserItemsResponse.result:type_name -> easyswap.market.v1.PortfolioItem
+	24, // 14: easyswap.market.v1.GetUserListingsRequest.filter:type_name -> easyswap.market.v1.PortfolioFilter
+	29, // 15: easyswap.market.v1.GetUserListingsResponse.result:type_name -> easyswap.market.v1.Listing
+	24, // 16: easyswap.market.v1.GetUserBidsRequest.filter:type_name -> easyswap.market.v1.PortfolioFilter
+	32, // 17: easyswap.market.v1.UserBid.bid_infos:type_name -> easyswap.market.v1.BidInfo
+	33, // 18: easyswap.market.v1.GetUserBidsResponse.result:type_name -> easyswap.market.v1.UserBid
+	36, // 19: easyswap.market.v1.GetTopRankingResponse.result:type_name -> easyswap.market.v1.CollectionRanking
+	0,  // 20: easyswap.market.v1.Market.GetCollectionDetail:input_type -> easyswap.market.v1.GetCollectionDetailRequest
+	4,  // 21: easyswap.market.v1.Market.GetCollectionItems:input_type -> easyswap.market.v1.GetCollectionItemsRequest
+	8,  // 22: easyswap.market.v1.Market.GetCollectionBids:input_type -> easyswap.market.v1.GetCollectionBidsRequest
+	11, // 23: easyswap.market.v1.Market.GetItemBids:input_type -> easyswap.market.v1.GetItemBidsRequest
+	15, // 24: easyswap.market.v1.Market.GetActivities:input_type -> easyswap.market.v1.GetActivitiesRequest
+	18, // 25: easyswap.market.v1.Market.StreamActivities:input_type -> easyswap.market.v1.StreamActivitiesRequest
+	19, // 26: easyswap.market.v1.Market.GetUserCollections:input_type -> easyswap.market.v1.GetUserCollectionsRequest
+	25, // 27: easyswap.market.v1.Market.GetUserItems:input_type -> easyswap.market.v1.GetUserItemsRequest
+	28, // 28: easyswap.market.v1.Market.GetUserListings:input_type -> easyswap.market.v1.GetUserListingsRequest
+	31, // 29: easyswap.market.v1.Market.GetUserBids:input_type -> easyswap.market.v1.GetUserBidsRequest
+	35, // 30: easyswap.market.v1.Market.GetTopRanking:input_type -> easyswap.market.v1.GetTopRankingRequest
+	2,  // 31: easyswap.market.v1.Market.GetCollectionDetail:output_type -> easyswap.market.v1.GetCollectionDetailResponse
+	7,  // 32: easyswap.market.v1.Market.GetCollectionItems:output_type -> easyswap.market.v1.GetCollectionItemsResponse
+	10, // 33: easyswap.market.v1.Market.GetCollectionBids:output_type -> easyswap.market.v1.GetCollectionBidsResponse
+	13, // 34: easyswap.market.v1.Market.GetItemBids:output_type -> easyswap.market.v1.GetItemBidsResponse
+	17, // 35: easyswap.market.v1.Market.GetActivities:output_type -> easyswap.market.v1.GetActivitiesResponse
+	16, // 36: easyswap.market.v1.Market.StreamActivities:output_type -> easyswap.market.v1.Activity
+	23, // 37: easyswap.market.v1.Market.GetUserCollections:output_type -> easyswap.market.v1.GetUserCollectionsResponse
+	27, // 38: easyswap.market.v1.Market.GetUserItems:output_type -> easyswap.market.v1.GetUserItemsResponse
+	30, // 39: easyswap.market.v1.Market.GetUserListings:output_type -> easyswap.market.v1.GetUserListingsResponse
+	34, // 40: easyswap.market.v1.Market.GetUserBids:output_type -> easyswap.market.v1.GetUserBidsResponse
+	37, // 41: easyswap.market.v1.Market.GetTopRanking:output_type -> easyswap.market.v1.GetTopRankingResponse
+	31, // [31:42] is the sub-list for method output_type
+	20, // [20:31] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_marketpb_market_proto_init() }
+func file_marketpb_market_proto_init() {
+	if File_marketpb_market_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_marketpb_market_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionDetailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraitFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemTrait); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NFTListingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionBidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCollectionBidsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemBidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemBidsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivityFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActivitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Activity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetActivitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamActivitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserCollectionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCollectionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserChainInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserCollectionsData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserCollectionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortfolioFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserItemsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortfolioItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserItemsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserListingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserListingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserBidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BidInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserBid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserBidsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopRankingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionRanking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_marketpb_market_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTopRankingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_marketpb_market_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   38,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_marketpb_market_proto_goTypes,
+		DependencyIndexes: file_marketpb_market_proto_depIdxs,
+		MessageInfos:      file_marketpb_market_proto_msgTypes,
+	}.Build()
+	File_marketpb_market_proto = out.File
+	file_marketpb_market_proto_rawDesc = nil
+	file_marketpb_market_proto_goTypes = nil
+	file_marketpb_market_proto_depIdxs = nil
+}
@@ -0,0 +1,533 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: marketpb/market.proto
+
+// EasySwap 市场只读接口 (gRPC)
+// 与 REST 接口 /api/v1 共用 service 层, 字段名与 REST 响应的 JSON 字段保持一致,
+// 金额类字段 (decimal) 统一使用字符串表示, 避免精度丢失.
+
+package marketpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Market_GetCollectionDetail_FullMethodName = "/easyswap.market.v1.Market/GetCollectionDetail"
+	Market_GetCollectionItems_FullMethodName  = "/easyswap.market.v1.Market/GetCollectionItems"
+	Market_GetCollectionBids_FullMethodName   = "/easyswap.market.v1.Market/GetCollectionBids"
+	Market_GetItemBids_FullMethodName         = "/easyswap.market.v1.Market/GetItemBids"
+	Market_GetActivities_FullMethodName       = "/easyswap.market.v1.Market/GetActivities"
+	Market_StreamActivities_FullMethodName    = "/easyswap.market.v1.Market/StreamActivities"
+	Market_GetUserCollections_FullMethodName  = "/easyswap.market.v1.Market/GetUserCollections"
+	Market_GetUserItems_FullMethodName        = "/easyswap.market.v1.Market/GetUserItems"
+	Market_GetUserListings_FullMethodName     = "/easyswap.market.v1.Market/GetUserListings"
+	Market_GetUserBids_FullMethodName         = "/easyswap.market.v1.Market/GetUserBids"
+	Market_GetTopRanking_FullMethodName       = "/easyswap.market.v1.Market/GetTopRanking"
+)
+
+// MarketClient is the client API for Market service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MarketClient interface {
+	// 集合详情 (基本信息、地板价、交易量等)
+	GetCollectionDetail(ctx context.Context, in *GetCollectionDetailRequest, opts ...grpc.CallOption) (*GetCollectionDetailResponse, error)
+	// 集合内 Item 列表
+	GetCollectionItems(ctx context.Context, in *GetCollectionItemsRequest, opts ...grpc.CallOption) (*GetCollectionItemsResponse, error)
+	// 集合出价 (按价格聚合)
+	GetCollectionBids(ctx context.Context, in *GetCollectionBidsRequest, opts ...grpc.CallOption) (*GetCollectionBidsResponse, error)
+	// 单个 Item 的出价
+	GetItemBids(ctx context.Context, in *GetItemBidsRequest, opts ...grpc.CallOption) (*GetItemBidsResponse, error)
+	// 多链活动列表
+	GetActivities(ctx context.Context, in *GetActivitiesRequest, opts ...grpc.CallOption) (*GetActivitiesResponse, error)
+	// 活动推送: 按过滤条件持续推送新产生的活动, 直到客户端取消
+	StreamActivities(ctx context.Context, in *StreamActivitiesRequest, opts ...grpc.CallOption) (Market_StreamActivitiesClient, error)
+	// 用户持有的集合及各链资产统计
+	GetUserCollections(ctx context.Context, in *GetUserCollectionsRequest, opts ...grpc.CallOption) (*GetUserCollectionsResponse, error)
+	// 用户持有的 Item
+	GetUserItems(ctx context.Context, in *GetUserItemsRequest, opts ...grpc.CallOption) (*GetUserItemsResponse, error)
+	// 用户挂单
+	GetUserListings(ctx context.Context, in *GetUserListingsRequest, opts ...grpc.CallOption) (*GetUserListingsResponse, error)
+	// 用户出价
+	GetUserBids(ctx context.Context, in *GetUserBidsRequest, opts ...grpc.CallOption) (*GetUserBidsResponse, error)
+	// 集合交易量排行 (跨链聚合)
+	GetTopRanking(ctx context.Context, in *GetTopRankingRequest, opts ...grpc.CallOption) (*GetTopRankingResponse, error)
+}
+
+type marketClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMarketClient(cc grpc.ClientConnInterface) MarketClient {
+	return &marketClient{cc}
+}
+
+func (c *marketClient) GetCollectionDetail(ctx context.Context, in *GetCollectionDetailRequest, opts ...grpc.CallOption) (*GetCollectionDetailResponse, error) {
+	out := new(GetCollectionDetailResponse)
+	err := c.cc.Invoke(ctx, Market_GetCollectionDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetCollectionItems(ctx context.Context, in *GetCollectionItemsRequest, opts ...grpc.CallOption) (*GetCollectionItemsResponse, error) {
+	out := new(GetCollectionItemsResponse)
+	err := c.cc.Invoke(ctx, Market_GetCollectionItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetCollectionBids(ctx context.Context, in *GetCollectionBidsRequest, opts ...grpc.CallOption) (*GetCollectionBidsResponse, error) {
+	out := new(GetCollectionBidsResponse)
+	err := c.cc.Invoke(ctx, Market_GetCollectionBids_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetItemBids(ctx context.Context, in *GetItemBidsRequest, opts ...grpc.CallOption) (*GetItemBidsResponse, error) {
+	out := new(GetItemBidsResponse)
+	err := c.cc.Invoke(ctx, Market_GetItemBids_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetActivities(ctx context.Context, in *GetActivitiesRequest, opts ...grpc.CallOption) (*GetActivitiesResponse, error) {
+	out := new(GetActivitiesResponse)
+	err := c.cc.Invoke(ctx, Market_GetActivities_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) StreamActivities(ctx context.Context, in *StreamActivitiesRequest, opts ...grpc.CallOption) (Market_StreamActivitiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Market_ServiceDesc.Streams[0], Market_StreamActivities_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &marketStreamActivitiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Market_StreamActivitiesClient interface {
+	Recv() (*Activity, error)
+	grpc.ClientStream
+}
+
+type marketStreamActivitiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *marketStreamActivitiesClient) Recv() (*Activity, error) {
+	m := new(Activity)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *marketClient) GetUserCollections(ctx context.Context, in *GetUserCollectionsRequest, opts ...grpc.CallOption) (*GetUserCollectionsResponse, error) {
+	out := new(GetUserCollectionsResponse)
+	err := c.cc.Invoke(ctx, Market_GetUserCollections_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetUserItems(ctx context.Context, in *GetUserItemsRequest, opts ...grpc.CallOption) (*GetUserItemsResponse, error) {
+	out := new(GetUserItemsResponse)
+	err := c.cc.Invoke(ctx, Market_GetUserItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetUserListings(ctx context.Context, in *GetUserListingsRequest, opts ...grpc.CallOption) (*GetUserListingsResponse, error) {
+	out := new(GetUserListingsResponse)
+	err := c.cc.Invoke(ctx, Market_GetUserListings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetUserBids(ctx context.Context, in *GetUserBidsRequest, opts ...grpc.CallOption) (*GetUserBidsResponse, error) {
+	out := new(GetUserBidsResponse)
+	err := c.cc.Invoke(ctx, Market_GetUserBids_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *marketClient) GetTopRanking(ctx context.Context, in *GetTopRankingRequest, opts ...grpc.CallOption) (*GetTopRankingResponse, error) {
+	out := new(GetTopRankingResponse)
+	err := c.cc.Invoke(ctx, Market_GetTopRanking_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarketServer is the server API for Market service.
+// All implementations must embed UnimplementedMarketServer
+// for forward compatibility
+type MarketServer interface {
+	// 集合详情 (基本信息、地板价、交易量等)
+	GetCollectionDetail(context.Context, *GetCollectionDetailRequest) (*GetCollectionDetailResponse, error)
+	// 集合内 Item 列表
+	GetCollectionItems(context.Context, *GetCollectionItemsRequest) (*GetCollectionItemsResponse, error)
+	// 集合出价 (按价格聚合)
+	GetCollectionBids(context.Context, *GetCollectionBidsRequest) (*GetCollectionBidsResponse, error)
+	// 单个 Item 的出价
+	GetItemBids(context.Context, *GetItemBidsRequest) (*GetItemBidsResponse, error)
+	// 多链活动列表
+	GetActivities(context.Context, *GetActivitiesRequest) (*GetActivitiesResponse, error)
+	// 活动推送: 按过滤条件持续推送新产生的活动, 直到客户端取消
+	StreamActivities(*StreamActivitiesRequest, Market_StreamActivitiesServer) error
+	// 用户持有的集合及各链资产统计
+	GetUserCollections(context.Context, *GetUserCollectionsRequest) (*GetUserCollectionsResponse, error)
+	// 用户持有的 Item
+	GetUserItems(context.Context, *GetUserItemsRequest) (*GetUserItemsResponse, error)
+	// 用户挂单
+	GetUserListings(context.Context, *GetUserListingsRequest) (*GetUserListingsResponse, error)
+	// 用户出价
+	GetUserBids(context.Context, *GetUserBidsRequest) (*GetUserBidsResponse, error)
+	// 集合交易量排行 (跨链聚合)
+	GetTopRanking(context.Context, *GetTopRankingRequest) (*GetTopRankingResponse, error)
+	mustEmbedUnimplementedMarketServer()
+}
+
+// UnimplementedMarketServer must be embedded to have forward compatible implementations.
+type UnimplementedMarketServer struct {
+}
+
+func (UnimplementedMarketServer) GetCollectionDetail(context.Context, *GetCollectionDetailRequest) (*GetCollectionDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionDetail not implemented")
+}
+func (UnimplementedMarketServer) GetCollectionItems(context.Context, *GetCollectionItemsRequest) (*GetCollectionItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionItems not implemented")
+}
+func (UnimplementedMarketServer) GetCollectionBids(context.Context, *GetCollectionBidsRequest) (*GetCollectionBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionBids not implemented")
+}
+func (UnimplementedMarketServer) GetItemBids(context.Context, *GetItemBidsRequest) (*GetItemBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemBids not implemented")
+}
+func (UnimplementedMarketServer) GetActivities(context.Context, *GetActivitiesRequest) (*GetActivitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivities not implemented")
+}
+func (UnimplementedMarketServer) StreamActivities(*StreamActivitiesRequest, Market_StreamActivitiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamActivities not implemented")
+}
+func (UnimplementedMarketServer) GetUserCollections(context.Context, *GetUserCollectionsRequest) (*GetUserCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCollections not implemented")
+}
+func (UnimplementedMarketServer) GetUserItems(context.Context, *GetUserItemsRequest) (*GetUserItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserItems not implemented")
+}
+func (UnimplementedMarketServer) GetUserListings(context.Context, *GetUserListingsRequest) (*GetUserListingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserListings not implemented")
+}
+func (UnimplementedMarketServer) GetUserBids(context.Context, *GetUserBidsRequest) (*GetUserBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserBids not implemented")
+}
+func (UnimplementedMarketServer) GetTopRanking(context.Context, *GetTopRankingRequest) (*GetTopRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTopRanking not implemented")
+}
+func (UnimplementedMarketServer) mustEmbedUnimplementedMarketServer() {}
+
+// UnsafeMarketServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MarketServer will
+// result in compilation errors.
+type UnsafeMarketServer interface {
+	mustEmbedUnimplementedMarketServer()
+}
+
+func RegisterMarketServer(s grpc.ServiceRegistrar, srv MarketServer) {
+	s.RegisterService(&Market_ServiceDesc, srv)
+}
+
+func _Market_GetCollectionDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetCollectionDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetCollectionDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetCollectionDetail(ctx, req.(*GetCollectionDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetCollectionItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetCollectionItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetCollectionItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetCollectionItems(ctx, req.(*GetCollectionItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetCollectionBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetCollectionBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetCollectionBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetCollectionBids(ctx, req.(*GetCollectionBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetItemBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetItemBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetItemBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetItemBids(ctx, req.(*GetItemBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetActivities(ctx, req.(*GetActivitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_StreamActivities_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamActivitiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MarketServer).StreamActivities(m, &marketStreamActivitiesServer{stream})
+}
+
+type Market_StreamActivitiesServer interface {
+	Send(*Activity) error
+	grpc.ServerStream
+}
+
+type marketStreamActivitiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *marketStreamActivitiesServer) Send(m *Activity) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Market_GetUserCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetUserCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetUserCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetUserCollections(ctx, req.(*GetUserCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetUserItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetUserItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetUserItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetUserItems(ctx, req.(*GetUserItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetUserListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetUserListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetUserListings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetUserListings(ctx, req.(*GetUserListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetUserBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetUserBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetUserBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetUserBids(ctx, req.(*GetUserBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Market_GetTopRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MarketServer).GetTopRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Market_GetTopRanking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MarketServer).GetTopRanking(ctx, req.(*GetTopRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Market_ServiceDesc is the grpc.ServiceDesc for Market service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Market_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "easyswap.market.v1.Market",
+	HandlerType: (*MarketServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCollectionDetail",
+			Handler:    _Market_GetCollectionDetail_Handler,
+		},
+		{
+			MethodName: "GetCollectionItems",
+			Handler:    _Market_GetCollectionItems_Handler,
+		},
+		{
+			MethodName: "GetCollectionBids",
+			Handler:    _Market_GetCollectionBids_Handler,
+		},
+		{
+			MethodName: "GetItemBids",
+			Handler:    _Market_GetItemBids_Handler,
+		},
+		{
+			MethodName: "GetActivities",
+			Handler:    _Market_GetActivities_Handler,
+		},
+		{
+			MethodName: "GetUserCollections",
+			Handler:    _Market_GetUserCollections_Handler,
+		},
+		{
+			MethodName: "GetUserItems",
+			Handler:    _Market_GetUserItems_Handler,
+		},
+		{
+			MethodName: "GetUserListings",
+			Handler:    _Market_GetUserListings_Handler,
+		},
+		{
+			MethodName: "GetUserBids",
+			Handler:    _Market_GetUserBids_Handler,
+		},
+		{
+			MethodName: "GetTopRanking",
+			Handler:    _Market_GetTopRanking_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamActivities",
+			Handler:       _Market_StreamActivities_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "marketpb/market.proto",
+}
@@ -3,33 +3,46 @@ package router
 import (
 	"time"
 
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 
 	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
+	v1 "github.com/ProjectsTask/EasySwapBackend/src/api/v1"
 
 	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
 )
 
-func NewRouter(svcCtx *svc.ServerCtx) *gin.Engine {
+func NewRouter(svcCtx *svc.ServerCtx) (*gin.Engine, error) {
 	// 强制控制台颜色输出，使日志更易读
 	gin.ForceConsoleColor()
 	// 设置 Gin 为发布模式 (ReleaseMode)
 	gin.SetMode(gin.ReleaseMode)
-	r := gin.New()                        // 新建一个gin引擎实例
+	r := gin.New() // 新建一个gin引擎实例
+	// gin 默认信任所有代理, 客户端可通过 X-Forwarded-For 伪造 IP; 只信任配置的反向代理
+	if err := r.SetTrustedProxies(svcCtx.C.Api.TrustedProxies); err != nil {
+		return nil, errors.Wrap(err, "invalid trusted proxies")
+	}
 	r.Use(middleware.RecoverMiddleware()) // 使用自定义的恢复中间件，处理 Panic
+	r.Use(middleware.Trace())             // 创建请求链路追踪 span，响应头返回 trace id
 	r.Use(middleware.RLog())              // 使用请求日志中间件，记录API访问日志
+	r.Use(middleware.Metrics())           // 记录接口耗时与状态码指标
 
 	r.Use(cors.New(cors.Config{ // 使用cors中间件，配置跨域访问策略
 		AllowAllOrigins:  true,                                                         // 允许所有源
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"}, // 允许的方法
-		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "X-CSRF-Token", "Authorization", "AccessToken", "Token"},
-		ExposeHeaders:    []string{"Content-Length", "Content-Type", "Access-Control-Allow-Origin", "Access-Control-Allow-Headers", "X-GW-Error-Code", "X-GW-Error-Message"},
+		AllowHeaders:     []string{"Origin", "Content-Length", "Content-Type", "X-CSRF-Token", "Authorization", "AccessToken", "Token", middleware.ApiKeyHeader},
+		ExposeHeaders:    []string{"Content-Length", "Content-Type", "Access-Control-Allow-Origin", "Access-Control-Allow-Headers", "X-GW-Error-Code", "X-GW-Error-Message", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset", "Retry-After", xtrace.TraceIDHeader},
 		AllowCredentials: true,
 		MaxAge:           1 * time.Hour,
 	}))
+	// 存活/就绪检查, 不经过限流
+	r.GET("/healthz", v1.LivenessHandler())
+	r.GET("/readyz", v1.ReadinessHandler(svcCtx))
+
 	loadV1(r, svcCtx) // 加载 v1 版本的路由分组
 	// loadV2(r, svcCtx) // 预留 v2 路由入口
 
-	return r
+	return r, nil
 }
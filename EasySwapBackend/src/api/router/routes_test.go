@@ -0,0 +1,58 @@
+package router
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/openapi"
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+)
+
+// undocumentedRoutes 不在 openapi.Routes 中描述的路由
+var undocumentedRoutes = map[string]bool{
+	"GET /healthz":                    true, // 存活检查
+	"GET /readyz":                     true, // 就绪检查
+	"GET /api/v1/openapi.json":        true, // 接口文档本身
+	"GET /api/v1/activities/export":   true, // 返回 CSV/NDJSON 文件
+	"GET /api/v1/export-files/:token": true, // 返回导出文件
+}
+
+// TestRoutesMatchOpenAPI 注册的 gin 路由与 openapi.Routes 必须一致, 新增或修改接口时需同步更新接口文档及客户端
+func TestRoutesMatchOpenAPI(t *testing.T) {
+	mr := miniredis.RunT(t)
+	kv := xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+	r, err := NewRouter(&svc.ServerCtx{C: &config.Config{}, KvStore: kv})
+	require.NoError(t, err)
+
+	var registered []string
+	for _, route := range r.Routes() {
+		id := route.Method + " " + route.Path
+		if !undocumentedRoutes[id] {
+			registered = append(registered, id)
+		}
+	}
+
+	documented := make(map[string]bool, len(openapi.Routes))
+	var documentedIDs []string
+	for _, route := range openapi.Routes {
+		id := route.Method + " " + openapi.BasePath + route.Path
+		assert.False(t, documented[id], "duplicated openapi route %s", id)
+		documented[id] = true
+		documentedIDs = append(documentedIDs, id)
+	}
+
+	sort.Strings(registered)
+	sort.Strings(documentedIDs)
+	assert.Equal(t, documentedIDs, registered)
+}
@@ -9,14 +9,24 @@ import (
 )
 
 func loadV1(r *gin.Engine, svcCtx *svc.ServerCtx) {
-	apiV1 := r.Group("/api/v1")
+	apiV1 := r.Group("/api/v1", middleware.RateLimitMiddleWare(svcCtx)) // 所有 v1 接口按请求方限流
+
+	apiV1.GET("/openapi.json", v1.OpenAPIHandler())                                             // OpenAPI 3 接口文档
+	apiV1.GET("/status", middleware.CacheApi(svcCtx.KvStore, 10), v1.SyncStatusHandler(svcCtx)) // 各链事件索引进度 (带10s缓存)
 
 	// 用户相关接口分组
 	user := apiV1.Group("/user")
 	{
-		user.GET("/:address/login-message", v1.GetLoginMessageHandler(svcCtx)) // 生成login签名信息 (获取 Nonce)
+		user.GET("/:address/login-message", v1.GetLoginMessageHandler(svcCtx)) // 生成 SIWE 登录签名消息 (?chain_id=)
 		user.POST("/login", v1.UserLoginHandler(svcCtx))                       // 登陆验证 (Verify Signature)
 		user.GET("/:address/sig-status", v1.GetSigStatusHandler(svcCtx))       // 获取用户签名状态
+		user.POST("/token/refresh", v1.RefreshTokenHandler(svcCtx))            // 使用 refresh token 换取新 token
+
+		// 会话管理, 需登录
+		user.POST("/logout", middleware.AuthMiddleWare(svcCtx.Sessions), v1.UserLogoutHandler(svcCtx))                        // 退出当前会话
+		user.POST("/logout-all", middleware.AuthMiddleWare(svcCtx.Sessions), v1.UserLogoutAllHandler(svcCtx))                 // 退出所有会话
+		user.GET("/sessions", middleware.AuthMiddleWare(svcCtx.Sessions), v1.UserSessionsHandler(svcCtx))                     // 当前用户的会话 (设备) 列表
+		user.DELETE("/sessions/:session_id", middleware.AuthMiddleWare(svcCtx.Sessions), v1.UserSessionRevokeHandler(svcCtx)) // 撤销指定会话
 	}
 
 	// 集合 (Collection) 相关接口
@@ -28,13 +38,13 @@ func loadV1(r *gin.Engine, svcCtx *svc.ServerCtx) {
 		collections.GET("/:address/:token_id/bids", v1.CollectionItemBidsHandler(svcCtx)) // 指定Item的bid信息 (单品出价)
 		collections.GET("/:address/items", v1.CollectionItemsHandler(svcCtx))             // 指定Collection的items信息 (列表页)
 
-		collections.GET("/:address/:token_id", v1.ItemDetailHandler(svcCtx))                                                  // 获取NFT Item的详细信息
-		collections.GET("/:address/:token_id/traits", v1.ItemTraitsHandler(svcCtx))                                           //获取NFT Item的Attribute信息 (Traits)
-		collections.GET("/:address/top-trait", v1.ItemTopTraitPriceHandler(svcCtx))                                           //获取NFT Item的Trait的最高价格信息
-		collections.GET("/:address/:token_id/image", middleware.CacheApi(svcCtx.KvStore, 60), v1.GetItemImageHandler(svcCtx)) // 获取NFT Item的图片信息 (带60s缓存)
-		collections.GET("/:address/history-sales", v1.HistorySalesHandler(svcCtx))                                            // NFT销售历史价格信息 (用于K线或图表)
-		collections.GET("/:address/:token_id/owner", v1.ItemOwnerHandler(svcCtx))                                             // 获取NFT Item的owner信息
-		collections.POST("/:address/:token_id/metadata", v1.ItemMetadataRefreshHandler(svcCtx))                               // 刷新NFT Item的metadata (手动触发更新)
+		collections.GET("/:address/:token_id", middleware.OptionalAuthMiddleWare(svcCtx.Sessions), v1.ItemDetailHandler(svcCtx)) // 获取NFT Item的详细信息 (可选登录, 仅登录用户计入浏览量)
+		collections.GET("/:address/:token_id/traits", v1.ItemTraitsHandler(svcCtx))                                              //获取NFT Item的Attribute信息 (Traits)
+		collections.GET("/:address/top-trait", v1.ItemTopTraitPriceHandler(svcCtx))                                              //获取NFT Item的Trait的最高价格信息
+		collections.GET("/:address/:token_id/image", middleware.CacheApi(svcCtx.KvStore, 60), v1.GetItemImageHandler(svcCtx))    // 获取NFT Item的图片信息 (带60s缓存)
+		collections.GET("/:address/history-sales", v1.HistorySalesHandler(svcCtx))                                               // NFT销售历史价格信息 (用于K线或图表)
+		collections.GET("/:address/:token_id/owner", v1.ItemOwnerHandler(svcCtx))                                                // 获取NFT Item的owner信息
+		collections.POST("/:address/:token_id/metadata", v1.ItemMetadataRefreshHandler(svcCtx))                                  // 刷新NFT Item的metadata (手动触发更新)
 
 		collections.GET("/ranking", middleware.CacheApi(svcCtx.KvStore, 60), v1.TopRankingHandler(svcCtx)) // 获取NFT集合排名信息 (带60s缓存)
 	}
@@ -42,16 +52,74 @@ func loadV1(r *gin.Engine, svcCtx *svc.ServerCtx) {
 	// 交易活动 (Activities) 接口
 	activities := apiV1.Group("/activities")
 	{
-		activities.GET("", v1.ActivityMultiChainHandler(svcCtx)) // 批量获取activity信息 (跨链支持)
+		activities.GET("", v1.ActivityMultiChainHandler(svcCtx))    // 批量获取activity信息 (跨链支持)
+		activities.GET("/export", v1.ActivityExportHandler(svcCtx)) // 同步流式导出activity (CSV / NDJSON), 行数过多时需创建导出任务
+	}
+
+	// 异步导出任务接口, 需登录
+	exports := apiV1.Group("/exports", middleware.AuthMiddleWare(svcCtx.Sessions))
+	{
+		exports.POST("", v1.ExportJobCreateHandler(svcCtx)) // 创建activity导出任务
+		exports.GET("", v1.ExportJobsHandler(svcCtx))       // 导出任务列表
+		exports.GET("/:id", v1.ExportJobHandler(svcCtx))    // 导出任务详情及下载链接
 	}
+	apiV1.GET("/export-files/:token", v1.ExportFileHandler(svcCtx)) // 按下载凭证下载导出文件
 
 	// 个人资产 (Portfolio) 接口
 	portfolio := apiV1.Group("/portfolio")
 	{
-		portfolio.GET("/collections", v1.UserMultiChainCollectionsHandler(svcCtx)) // 获取用户拥有Collection信息
-		portfolio.GET("/items", v1.UserMultiChainItemsHandler(svcCtx))             // 查询用户拥有nft的Item基本信息
-		portfolio.GET("/listings", v1.UserMultiChainListingsHandler(svcCtx))       // 查询用户挂单的Listing信息 (我的卖单)
-		portfolio.GET("/bids", v1.UserMultiChainBidsHandler(svcCtx))               // 查询用户挂单的Bids信息 (我的买单)
+		portfolio.GET("/collections", v1.UserMultiChainCollectionsHandler(svcCtx))    // 获取用户拥有Collection信息
+		portfolio.GET("/items", v1.UserMultiChainItemsHandler(svcCtx))                // 查询用户拥有nft的Item基本信息
+		portfolio.GET("/listings", v1.UserMultiChainListingsHandler(svcCtx))          // 查询用户挂单的Listing信息 (我的卖单)
+		portfolio.GET("/bids", v1.UserMultiChainBidsHandler(svcCtx))                  // 查询用户挂单的Bids信息 (我的买单)
+		portfolio.GET("/escrow", v1.UserMultiChainEscrowHandler(svcCtx))              // 查询用户在 Vault 中托管的 ETH 及 NFT
+		portfolio.GET("/valuation", v1.UserMultiChainValuationHandler(svcCtx))        // 查询用户持仓估值及盈亏
+		portfolio.GET("/value-history", v1.UserMultiChainValueHistoryHandler(svcCtx)) // 查询用户持仓价值历史
+	}
+
+	// 收藏 Item 接口, 需登录
+	favorites := apiV1.Group("/favorites", middleware.AuthMiddleWare(svcCtx.Sessions))
+	{
+		favorites.GET("", v1.FavoriteItemsHandler(svcCtx))                            // 收藏的 Item 列表
+		favorites.POST("", v1.FavoriteItemAddHandler(svcCtx))                         // 收藏 Item
+		favorites.DELETE("/:address/:token_id", v1.FavoriteItemRemoveHandler(svcCtx)) // 取消收藏 Item (?chain_id=)
+	}
+
+	// 关注集合接口, 需登录
+	watchlist := apiV1.Group("/watchlist", middleware.AuthMiddleWare(svcCtx.Sessions))
+	{
+		watchlist.GET("", v1.WatchlistHandler(svcCtx))                     // 关注的集合及实时行情
+		watchlist.POST("", v1.WatchCollectionHandler(svcCtx))              // 关注集合
+		watchlist.DELETE("/:address", v1.UnwatchCollectionHandler(svcCtx)) // 取消关注集合 (?chain_id=)
+	}
+
+	// 用户通知接口, 需登录
+	notifications := apiV1.Group("/notifications", middleware.AuthMiddleWare(svcCtx.Sessions))
+	{
+		notifications.GET("", v1.NotificationsHandler(svcCtx))                            // 站内通知列表及未读数
+		notifications.POST("/read", v1.NotificationReadHandler(svcCtx))                   // 标记通知已读
+		notifications.GET("/preferences", v1.NotificationPreferenceHandler(svcCtx))       // 查询通知偏好
+		notifications.PUT("/preferences", v1.NotificationPreferenceUpdateHandler(svcCtx)) // 更新通知偏好 (屏蔽类型、webhook、邮件)
+		notifications.GET("/floor-alerts", v1.FloorAlertsHandler(svcCtx))                 // 查询地板价提醒
+		notifications.POST("/floor-alerts", v1.FloorAlertSetHandler(svcCtx))              // 创建/更新地板价提醒
+		notifications.DELETE("/floor-alerts/:id", v1.FloorAlertDeleteHandler(svcCtx))     // 删除地板价提醒
+		notifications.GET("/deliveries", v1.NotificationDeliveriesHandler(svcCtx))        // webhook / 邮件投递记录
+	}
+
+	// 链下签名订单 (EIP-712) 接口
+	signedOrders := apiV1.Group("/signed-orders")
+	{
+		signedOrders.POST("", v1.SignedOrderCreateHandler(svcCtx))                                                              // 提交链下签名订单 (上链前为 Pending, 不参与成交)
+		signedOrders.POST("/:order_id/cancel", middleware.AuthMiddleWare(svcCtx.Sessions), v1.SignedOrderCancelHandler(svcCtx)) // 取消尚未上链的签名订单
+	}
+
+	// 订单交易构造接口, 返回待签名的订单簿合约调用交易
+	orderTxs := apiV1.Group("/order-txs")
+	{
+		orderTxs.POST("/make", v1.MakeOrdersTxHandler(svcCtx))     // makeOrders 挂单/出价
+		orderTxs.POST("/cancel", v1.CancelOrdersTxHandler(svcCtx)) // cancelOrders 取消订单
+		orderTxs.POST("/edit", v1.EditOrdersTxHandler(svcCtx))     // editOrders 改价
+		orderTxs.POST("/match", v1.MatchOrdersTxHandler(svcCtx))   // matchOrder(s) 购买/接受出价
 	}
 
 	// 聚合出价 (Orders) 接口
@@ -59,5 +127,27 @@ func loadV1(r *gin.Engine, svcCtx *svc.ServerCtx) {
 	{
 		// 批量查询出价信息 (混合计算单品出价与集合出价)
 		orders.GET("", v1.OrderInfosHandler(svcCtx))
+
+		// 属性出价 (Trait Bid), 链下签名订单, 由后端撮合, 需登录
+		orders.POST("/trait", middleware.AuthMiddleWare(svcCtx.Sessions), v1.TraitBidCreateHandler(svcCtx))                  // 创建属性出价
+		orders.POST("/trait/:order_id/fill", middleware.AuthMiddleWare(svcCtx.Sessions), v1.TraitBidFillTxHandler(svcCtx))   // 撮合属性出价, 构造购买符合属性的 Item 的交易
+		orders.POST("/trait/:order_id/cancel", middleware.AuthMiddleWare(svcCtx.Sessions), v1.TraitBidCancelHandler(svcCtx)) // 取消属性出价
+	}
+
+	// 后台管理接口, 需登录且用户角色为管理员, 所有操作记录审计日志
+	admin := apiV1.Group("/admin", middleware.AuthMiddleWare(svcCtx.Sessions), middleware.AdminMiddleWare(svcCtx.Dao))
+	{
+		admin.POST("/collections/:address/auth", v1.AdminCollectionAuthHandler(svcCtx))                // 认证/取消认证集合
+		admin.POST("/collections/:address/status", v1.AdminCollectionStatusHandler(svcCtx))            // 隐藏/封禁集合
+		admin.POST("/collections/:address/socials", v1.AdminCollectionSocialsHandler(svcCtx))          // 编辑集合社交信息
+		admin.POST("/collections/:address/import", v1.AdminCollectionImportHandler(svcCtx))            // 触发集合导入
+		admin.POST("/collections/:address/metadata", v1.AdminCollectionMetadataRefreshHandler(svcCtx)) // 全量刷新集合元数据
+		admin.POST("/collections/:address/:token_id/status", v1.AdminItemStatusHandler(svcCtx))        // 隐藏/封禁 Item
+		admin.POST("/users/:address/allowed", v1.AdminUserAllowedHandler(svcCtx))                      // 允许/封禁用户
+		admin.GET("/audit-logs", v1.AdminAuditLogsHandler(svcCtx))                                     // 查询审计日志
+		admin.POST("/api-keys", v1.AdminApiKeyCreateHandler(svcCtx))                                   // 签发合作方 API key
+		admin.GET("/api-keys", v1.AdminApiKeysHandler(svcCtx))                                         // 查询 API key 列表
+		admin.POST("/api-keys/:id/revoke", v1.AdminApiKeyRevokeHandler(svcCtx))                        // 撤销 API key
+		admin.GET("/api-keys/:id/usage", v1.AdminApiKeyUsageHandler(svcCtx))                           // 查询 API key 用量
 	}
 }
@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+)
+
+func TestMain(m *testing.M) {
+	if _, err := xzap.SetUp(logging.LogConf{ServiceName: "rpc_test", Mode: "console", Path: os.TempDir(), Level: "error"}); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func newTestAuthenticator(t *testing.T, apiKeys map[string]base.ApiKey) *authenticator {
+	mr := miniredis.RunT(t)
+	kv := xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+	// 写入 API key 缓存, 查询时不访问数据库
+	for key, apiKey := range apiKeys {
+		require.NoError(t, kv.Write("cache:es:apikey:info:"+dao.HashApiKey(key), &apiKey, 60))
+	}
+	return newAuthenticator(&svc.ServerCtx{
+		C:       &config.Config{RateLimit: &config.RateLimit{Enable: true, Window: 60, ApiKeyLimit: 2}},
+		KvStore: kv,
+		Dao:     &dao.Dao{KvStore: kv},
+	})
+}
+
+func withApiKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(ApiKeyMetadata, key))
+}
+
+func TestAuthenticatorRequiresApiKey(t *testing.T) {
+	a := newTestAuthenticator(t, map[string]base.ApiKey{
+		"revoked": {Id: 2, Status: base.ApiKeyStatusActive + 1},
+	})
+
+	assert.Equal(t, errcode.ErrInvalidApiKey, a.check(context.Background(), "/market.Market/GetActivities"))
+	assert.Equal(t, errcode.ErrInvalidApiKey, a.check(withApiKey("revoked"), "/market.Market/GetActivities"))
+}
+
+func TestAuthenticatorRateLimit(t *testing.T) {
+	a := newTestAuthenticator(t, map[string]base.ApiKey{
+		"default": {Id: 1, Status: base.ApiKeyStatusActive},
+		"custom":  {Id: 3, Status: base.ApiKeyStatusActive, RateLimit: 3},
+	})
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, a.check(withApiKey("default"), "/market.Market/GetActivities"))
+	}
+	assert.Equal(t, errcode.ErrTooManyRequests, a.check(withApiKey("default"), "/market.Market/GetActivities"))
+
+	// key 自身配置的配额优先于默认配额
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, a.check(withApiKey("custom"), "/market.Market/StreamActivities"))
+	}
+	assert.Equal(t, errcode.ErrTooManyRequests, a.check(withApiKey("custom"), "/market.Market/StreamActivities"))
+}
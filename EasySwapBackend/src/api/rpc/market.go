@@ -0,0 +1,293 @@
+package rpc
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/proto/marketpb"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// validRankingPeriods 排行榜支持的时间范围
+var validRankingPeriods = map[string]bool{
+	"15m": true,
+	"1h":  true,
+	"6h":  true,
+	"1d":  true,
+	"7d":  true,
+	"30d": true,
+}
+
+// GetCollectionDetail 查询集合详情
+func (s *Server) GetCollectionDetail(ctx context.Context, req *marketpb.GetCollectionDetailRequest) (*marketpb.GetCollectionDetailResponse, error) {
+	chain, ok := s.chains[int(req.ChainId)]
+	if !ok || req.CollectionAddress == "" {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetCollectionDetail(ctx, s.svcCtx, chain, req.CollectionAddress)
+	if err != nil {
+		return nil, serviceErr(err, errcode.ErrUnexpected)
+	}
+
+	resp := &marketpb.GetCollectionDetailResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetCollectionItems 查询集合内 Item 列表
+func (s *Server) GetCollectionItems(ctx context.Context, req *marketpb.GetCollectionItemsRequest) (*marketpb.GetCollectionItemsResponse, error) {
+	chain, ok := s.chains[int(req.ChainId)]
+	if !ok || req.CollectionAddress == "" {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	filter := types.CollectionItemFilterParams{
+		Sort:        int(req.Sort),
+		TokenID:     req.TokenId,
+		UserAddress: req.UserAddress,
+		ChainID:     int(req.ChainId),
+		Page:        int(req.Page),
+		PageSize:    int(req.PageSize),
+	}
+	for _, status := range req.Status {
+		filter.Status = append(filter.Status, int(status))
+	}
+	for _, market := range req.Markets {
+		filter.Markets = append(filter.Markets, int(market))
+	}
+	for _, trait := range req.Traits {
+		filter.Traits = append(filter.Traits, types.TraitFilter{Trait: trait.Trait, Values: trait.Values})
+	}
+
+	// 价格区间校验
+	var err error
+	if filter.MinPrice, err = parsePrice(req.MinPrice); err != nil {
+		return nil, errcode.ErrInvalidParams
+	}
+	if filter.MaxPrice, err = parsePrice(req.MaxPrice); err != nil {
+		return nil, errcode.ErrInvalidParams
+	}
+	if filter.MinPrice.IsNegative() || filter.MaxPrice.IsNegative() ||
+		(filter.MaxPrice.IsPositive() && filter.MinPrice.GreaterThan(filter.MaxPrice)) {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetItems(ctx, s.svcCtx, chain, filter, req.CollectionAddress)
+	if err != nil {
+		return nil, errcode.ErrUnexpected
+	}
+
+	resp := &marketpb.GetCollectionItemsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetCollectionBids 查询集合出价信息
+func (s *Server) GetCollectionBids(ctx context.Context, req *marketpb.GetCollectionBidsRequest) (*marketpb.GetCollectionBidsResponse, error) {
+	chain, ok := s.chains[int(req.ChainId)]
+	if !ok || req.CollectionAddress == "" {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetBids(ctx, s.svcCtx, chain, req.CollectionAddress, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, errcode.ErrUnexpected
+	}
+
+	resp := &marketpb.GetCollectionBidsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetItemBids 查询单个 Item 的出价信息
+func (s *Server) GetItemBids(ctx context.Context, req *marketpb.GetItemBidsRequest) (*marketpb.GetItemBidsResponse, error) {
+	chain, ok := s.chains[int(req.ChainId)]
+	if !ok || req.CollectionAddress == "" || req.TokenId == "" {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetItemBidsInfo(ctx, s.svcCtx, chain, req.CollectionAddress, req.TokenId, int(req.Page), int(req.PageSize))
+	if err != nil {
+		return nil, errcode.ErrUnexpected
+	}
+
+	resp := &marketpb.GetItemBidsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetActivities 查询多链活动信息
+func (s *Server) GetActivities(ctx context.Context, req *marketpb.GetActivitiesRequest) (*marketpb.GetActivitiesResponse, error) {
+	filter := req.Filter
+	if filter == nil {
+		filter = &marketpb.ActivityFilter{}
+	}
+	return s.queryActivities(ctx, filter, int(req.Page), int(req.PageSize))
+}
+
+// queryActivities 按过滤条件查询活动, 供列表查询与活动推送共用
+func (s *Server) queryActivities(ctx context.Context, filter *marketpb.ActivityFilter, page, pageSize int) (*marketpb.GetActivitiesResponse, error) {
+	chainIDs, chainNames, ok := s.chainNames(filter.ChainIds)
+	if !ok {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetMultiChainActivities(ctx, s.svcCtx, chainIDs, chainNames,
+		filter.CollectionAddresses, filter.TokenId, filter.UserAddresses, filter.EventTypes, page, pageSize)
+	if err != nil {
+		return nil, errcode.NewCustomErr("Get multi-chain activities failed.")
+	}
+
+	resp := &marketpb.GetActivitiesResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetUserCollections 查询用户在所有支持链上持有的集合
+func (s *Server) GetUserCollections(ctx context.Context, req *marketpb.GetUserCollectionsRequest) (*marketpb.GetUserCollectionsResponse, error) {
+	chainIDs, chainNames, _ := s.chainNames(nil)
+	res, err := service.GetMultiChainUserCollections(ctx, s.svcCtx, chainIDs, chainNames, req.UserAddresses)
+	if err != nil {
+		return nil, errcode.NewCustomErr("query user multi chain collections err.")
+	}
+
+	resp := &marketpb.GetUserCollectionsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetUserItems 查询用户持有的 Item
+func (s *Server) GetUserItems(ctx context.Context, req *marketpb.GetUserItemsRequest) (*marketpb.GetUserItemsResponse, error) {
+	filter := portfolioFilter(req.Filter)
+	chainIDs, chainNames, ok := s.chainNames(filter.ChainIds)
+	if !ok {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetMultiChainUserItems(ctx, s.svcCtx, chainIDs, chainNames, filter.UserAddresses, filter.CollectionAddresses, int(filter.Page), int(filter.PageSize))
+	if err != nil {
+		return nil, errcode.NewCustomErr("query user multi chain items err.")
+	}
+
+	resp := &marketpb.GetUserItemsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetUserListings 查询用户挂单
+func (s *Server) GetUserListings(ctx context.Context, req *marketpb.GetUserListingsRequest) (*marketpb.GetUserListingsResponse, error) {
+	filter := portfolioFilter(req.Filter)
+	chainIDs, chainNames, ok := s.chainNames(filter.ChainIds)
+	if !ok {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetMultiChainUserListings(ctx, s.svcCtx, chainIDs, chainNames, filter.UserAddresses, filter.CollectionAddresses, int(filter.Page), int(filter.PageSize))
+	if err != nil {
+		return nil, errcode.NewCustomErr("query user multi chain listings err.")
+	}
+
+	resp := &marketpb.GetUserListingsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetUserBids 查询用户出价
+func (s *Server) GetUserBids(ctx context.Context, req *marketpb.GetUserBidsRequest) (*marketpb.GetUserBidsResponse, error) {
+	filter := portfolioFilter(req.Filter)
+	chainIDs, chainNames, ok := s.chainNames(filter.ChainIds)
+	if !ok {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	res, err := service.GetMultiChainUserBids(ctx, s.svcCtx, chainIDs, chainNames, filter.UserAddresses, filter.CollectionAddresses, int(filter.Page), int(filter.PageSize))
+	if err != nil {
+		return nil, errcode.NewCustomErr("query user multi chain bids err.")
+	}
+
+	resp := &marketpb.GetUserBidsResponse{}
+	if err := convert(ctx, res, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetTopRanking 查询集合交易量排行, 并发查询所有支持的链后按交易量降序合并
+func (s *Server) GetTopRanking(ctx context.Context, req *marketpb.GetTopRankingRequest) (*marketpb.GetTopRankingResponse, error) {
+	period := req.Range
+	if period == "" {
+		period = "1d"
+	}
+	if !validRankingPeriods[period] || req.Limit <= 0 {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	var allResult []*types.CollectionRankingInfo
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for _, chain := range s.svcCtx.C.ChainSupported {
+		wg.Add(1)
+		go func(chain string) {
+			defer wg.Done()
+			result, err := service.GetTopRanking(ctx, s.svcCtx, chain, period, req.Limit)
+			if err != nil {
+				xzap.WithContext(ctx).Error("failed on get top ranking", zap.Error(err), zap.String("chain", chain))
+				return
+			}
+			mu.Lock()
+			allResult = append(allResult, result...)
+			mu.Unlock()
+		}(chain.Name)
+	}
+	wg.Wait()
+
+	sort.SliceStable(allResult, func(i, j int) bool {
+		return allResult[i].Volume.GreaterThan(allResult[j].Volume)
+	})
+
+	resp := &marketpb.GetTopRankingResponse{}
+	if err := convert(ctx, types.CollectionRankingResp{Result: allResult}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// portfolioFilter 个人资产查询未传过滤条件时使用空条件
+func portfolioFilter(filter *marketpb.PortfolioFilter) *marketpb.PortfolioFilter {
+	if filter == nil {
+		return &marketpb.PortfolioFilter{}
+	}
+	return filter
+}
+
+// parsePrice 解析价格字符串, 为空时返回 0 (不限制)
+func parsePrice(price string) (decimal.Decimal, error) {
+	if price == "" {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromString(price)
+}
@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap/xgrpc"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/proto/marketpb"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+)
+
+// defaultStreamInterval 活动推送默认轮询间隔 (秒)
+const defaultStreamInterval = 3
+
+// Server 市场只读 gRPC 服务
+// 与 REST 接口共用 service 层, 仅负责参数转换与校验
+type Server struct {
+	marketpb.UnimplementedMarketServer
+
+	svcCtx *svc.ServerCtx
+	chains map[int]string // 链 ID 到链名称的映射, 来自 chain_supported 配置
+}
+
+// NewServer 创建市场 gRPC 服务
+func NewServer(svcCtx *svc.ServerCtx) *Server {
+	chains := make(map[int]string, len(svcCtx.C.ChainSupported))
+	for _, chain := range svcCtx.C.ChainSupported {
+		chains[chain.ChainID] = chain.Name
+	}
+	return &Server{svcCtx: svcCtx, chains: chains}
+}
+
+// NewGrpcServer 创建 gRPC server 并注册市场服务
+// 拦截器顺序: 请求/响应日志 -> 业务错误转换为 gRPC 状态码 -> API key 鉴权及限流
+func NewGrpcServer(svcCtx *svc.ServerCtx) *grpc.Server {
+	zapLogger := &xzap.ZapLogger{Logger: xzap.GetZapLogger()}
+	auth := newAuthenticator(svcCtx)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(xgrpc.PayloadUnaryServerInterceptor(zapLogger), errcode.ErrInterceptor, auth.unaryInterceptor),
+		grpc.ChainStreamInterceptor(xgrpc.PayloadStreamServerInterceptor(zapLogger), errcode.ErrStreamInterceptor, auth.streamInterceptor),
+	)
+	marketpb.RegisterMarketServer(s, NewServer(svcCtx))
+	return s
+}
+
+// chainNames 将链 ID 转换为链名称, 未传链 ID 时返回所有支持的链
+func (s *Server) chainNames(chainIDs []int32) ([]int, []string, bool) {
+	var ids []int
+	var names []string
+	if len(chainIDs) == 0 {
+		for _, chain := range s.svcCtx.C.ChainSupported {
+			ids = append(ids, chain.ChainID)
+			names = append(names, chain.Name)
+		}
+		return ids, names, true
+	}
+
+	for _, id := range chainIDs {
+		name, ok := s.chains[int(id)]
+		if !ok {
+			return nil, nil, false
+		}
+		ids = append(ids, int(id))
+		names = append(names, name)
+	}
+	return ids, names, true
+}
+
+// toProto 将 service 层返回结果转换为 proto 消息
+// proto 字段名与 REST 响应 JSON 字段一致, 通过 JSON 中转完成转换
+func toProto(src interface{}, dst proto.Message) error {
+	raw, err := json.Marshal(src)
+	if err != nil {
+		return errors.Wrap(err, "failed on marshal result")
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(raw, dst); err != nil {
+		return errors.Wrap(err, "failed on unmarshal result to proto")
+	}
+	return nil
+}
+
+// convert 转换 service 层结果, 转换失败时记录日志并返回通用错误
+func convert(ctx context.Context, src interface{}, dst proto.Message) error {
+	if err := toProto(src, dst); err != nil {
+		xzap.WithContext(ctx).Error("failed on convert grpc response", zap.Error(err))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// serviceErr 保留 service 层返回的业务错误, 其余错误转换为 fallback
+func serviceErr(err error, fallback *errcode.Err) error {
+	if errcode.IsErr(err) {
+		return err
+	}
+	return fallback
+}
@@ -0,0 +1,137 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/proto/marketpb"
+)
+
+const (
+	// streamPageSize 活动推送每次查询读取的活动数量
+	streamPageSize = 50
+	// streamMaxPages 单次轮询最多读取的页数, 超出时跳过更早的活动, 避免活动激增时长时间查询
+	streamMaxPages = 20
+)
+
+// StreamActivities 按过滤条件推送新产生的活动
+// 定时轮询最新活动, 逐页读取直到读到游标位置或不足一页, 以 (event_time, 活动标识) 作为游标去重, 按时间正序推送,
+// 订阅之前的历史活动不推送, 客户端断开或 server 关闭时结束.
+func (s *Server) StreamActivities(req *marketpb.StreamActivitiesRequest, stream marketpb.Market_StreamActivitiesServer) error {
+	ctx := stream.Context()
+	filter := req.Filter
+	if filter == nil {
+		filter = &marketpb.ActivityFilter{}
+	}
+
+	interval := int64(defaultStreamInterval)
+	if s.svcCtx.C.Grpc != nil && s.svcCtx.C.Grpc.StreamInterval > 0 {
+		interval = s.svcCtx.C.Grpc.StreamInterval
+	}
+
+	// 以订阅时的最新活动作为起始游标
+	latest, err := s.queryActivities(ctx, filter, 1, streamPageSize)
+	if err != nil {
+		return err
+	}
+	cursor := newActivityCursor()
+	cursor.advance(latest.Result)
+
+	ticker := time.NewTicker(time.Duration(interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		activities, err := s.pollActivities(ctx, filter, cursor)
+		if err != nil {
+			// 单次查询失败不中断推送, 下次轮询重试
+			xzap.WithContext(ctx).Error("failed on poll activities", zap.Error(err))
+			continue
+		}
+
+		for _, activity := range cursor.advance(activities) {
+			if err := stream.Send(activity); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// pollActivities 从最新活动开始逐页读取, 直到某页包含游标位置的活动或不足一页
+func (s *Server) pollActivities(ctx context.Context, filter *marketpb.ActivityFilter, cursor *activityCursor) ([]*marketpb.Activity, error) {
+	var activities []*marketpb.Activity
+	for page := 1; page <= streamMaxPages; page++ {
+		res, err := s.queryActivities(ctx, filter, page, streamPageSize)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, res.Result...)
+		if len(res.Result) < streamPageSize || cursor.reached(res.Result) {
+			return activities, nil
+		}
+	}
+	xzap.WithContext(ctx).Warn("too many new activities, skip older ones", zap.Int("count", len(activities)))
+	return activities, nil
+}
+
+// activityCursor 活动推送游标
+// 记录已推送的最大 event_time 及该时间点上已推送的活动, 同一时间的多条活动不会重复推送
+type activityCursor struct {
+	eventTime int64
+	seen      map[string]bool
+}
+
+func newActivityCursor() *activityCursor {
+	return &activityCursor{seen: make(map[string]bool)}
+}
+
+// reached 判断一页活动中是否包含游标位置及之前的活动, 即更早的页不会再有新活动
+func (c *activityCursor) reached(activities []*marketpb.Activity) bool {
+	for _, activity := range activities {
+		if activity.EventTime < c.eventTime ||
+			(activity.EventTime == c.eventTime && c.seen[activityKey(activity)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// advance 返回游标之后的新活动 (按时间正序), 并将游标推进到最新活动
+func (c *activityCursor) advance(activities []*marketpb.Activity) []*marketpb.Activity {
+	var fresh []*marketpb.Activity
+	for _, activity := range activities {
+		if activity.EventTime < c.eventTime ||
+			(activity.EventTime == c.eventTime && c.seen[activityKey(activity)]) {
+			continue
+		}
+		fresh = append(fresh, activity)
+	}
+
+	sort.SliceStable(fresh, func(i, j int) bool {
+		return fresh[i].EventTime < fresh[j].EventTime
+	})
+
+	for _, activity := range fresh {
+		if activity.EventTime > c.eventTime {
+			c.eventTime = activity.EventTime
+			c.seen = make(map[string]bool)
+		}
+		c.seen[activityKey(activity)] = true
+	}
+	return fresh
+}
+
+// activityKey 活动唯一标识
+func activityKey(activity *marketpb.Activity) string {
+	return fmt.Sprintf("%d:%s:%s:%s:%s:%s", activity.ChainId, activity.TxHash, activity.EventType,
+		activity.CollectionAddress, activity.TokenId, activity.Maker)
+}
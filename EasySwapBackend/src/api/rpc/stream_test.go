@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/proto/marketpb"
+)
+
+func testActivity(txHash string, eventTime int64) *marketpb.Activity {
+	return &marketpb.Activity{ChainId: 11155111, TxHash: txHash, EventType: "sale", EventTime: eventTime}
+}
+
+func TestActivityCursorAdvance(t *testing.T) {
+	c := newActivityCursor()
+	// 订阅时的最新活动作为起点, 按时间倒序返回
+	initial := c.advance([]*marketpb.Activity{testActivity("0x2", 20), testActivity("0x1", 10)})
+	assert.Len(t, initial, 2)
+	assert.Equal(t, int64(20), c.eventTime)
+
+	// 已推送的活动不重复推送, 同一时间点的新活动及更新的活动按时间正序推送
+	fresh := c.advance([]*marketpb.Activity{
+		testActivity("0x4", 30),
+		testActivity("0x3", 20),
+		testActivity("0x2", 20),
+		testActivity("0x1", 10),
+	})
+	if assert.Len(t, fresh, 2) {
+		assert.Equal(t, "0x3", fresh[0].TxHash)
+		assert.Equal(t, "0x4", fresh[1].TxHash)
+	}
+	assert.Empty(t, c.advance([]*marketpb.Activity{testActivity("0x4", 30), testActivity("0x3", 20)}))
+}
+
+func TestActivityCursorReached(t *testing.T) {
+	c := newActivityCursor()
+	c.advance([]*marketpb.Activity{testActivity("0x1", 10)})
+
+	// 整页都是游标之后的新活动, 需继续读取下一页
+	assert.False(t, c.reached([]*marketpb.Activity{testActivity("0x3", 12), testActivity("0x2", 10)}))
+	// 读到已推送的活动或更早的活动时停止
+	assert.True(t, c.reached([]*marketpb.Activity{testActivity("0x2", 11), testActivity("0x1", 10)}))
+	assert.True(t, c.reached([]*marketpb.Activity{testActivity("0x2", 11), testActivity("0x0", 9)}))
+}
@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/gin-gonic/gin"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// AdminCollectionAuthHandler 设置集合认证状态 (认证/取消认证)
+func AdminCollectionAuthHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminCollectionAuthReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		collectionAddr := c.Params.ByName("address")
+		if !ok || collectionAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.SetCollectionAuth(c.Request.Context(), svcCtx, op, chain, collectionAddr, req.Auth); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: collectionAddr})
+	}
+}
+
+// AdminCollectionStatusHandler 设置集合展示状态 (正常/隐藏/封禁)
+func AdminCollectionStatusHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminStatusReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		collectionAddr := c.Params.ByName("address")
+		if !ok || collectionAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.SetCollectionStatus(c.Request.Context(), svcCtx, op, chain, collectionAddr, req.Status); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: collectionAddr})
+	}
+}
+
+// AdminCollectionSocialsHandler 编辑集合社交信息 (官网/twitter/discord/instagram/banner)
+func AdminCollectionSocialsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminCollectionSocialsReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		collectionAddr := c.Params.ByName("address")
+		if !ok || collectionAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.UpdateCollectionSocials(c.Request.Context(), svcCtx, op, chain, collectionAddr, &req); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: collectionAddr})
+	}
+}
+
+// AdminCollectionImportHandler 触发集合导入
+func AdminCollectionImportHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminCollectionImportReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		collectionAddr := c.Params.ByName("address")
+		if !ok || collectionAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.ImportCollection(c.Request.Context(), svcCtx, op, chain, collectionAddr, req.TokenStandard); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: "Success to joined the import queue and waiting for import."})
+	}
+}
+
+// AdminCollectionMetadataRefreshHandler 全量刷新集合下所有 Item 的元数据
+func AdminCollectionMetadataRefreshHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminChainReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		collectionAddr := c.Params.ByName("address")
+		if !ok || collectionAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		count, err := service.RefreshCollectionMetadata(c.Request.Context(), svcCtx, op, chain, int64(req.ChainID), collectionAddr)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.AdminRefreshMetadataResp{Result: count})
+	}
+}
+
+// AdminItemStatusHandler 设置 Item 展示状态 (正常/隐藏/封禁)
+func AdminItemStatusHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminStatusReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		collectionAddr := c.Params.ByName("address")
+		tokenID := c.Params.ByName("token_id")
+		if !ok || collectionAddr == "" || tokenID == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.SetItemStatus(c.Request.Context(), svcCtx, op, chain, collectionAddr, tokenID, req.Status); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: tokenID})
+	}
+}
+
+// AdminUserAllowedHandler 允许/封禁用户, 封禁时撤销用户所有会话
+func AdminUserAllowedHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		userAddr := c.Params.ByName("address")
+		if userAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		var req types.AdminUserAllowedReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.SetUserAllowed(c.Request.Context(), svcCtx, op, userAddr, req.Allowed); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: userAddr})
+	}
+}
+
+// AdminAuditLogsHandler 分页查询管理操作审计日志
+func AdminAuditLogsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var filter types.AdminAuditLogFilterParams
+		if filterParam := c.Query("filters"); filterParam != "" {
+			if err := json.Unmarshal([]byte(filterParam), &filter); err != nil {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+		}
+
+		res, err := service.GetAdminAuditLogs(c.Request.Context(), svcCtx, filter)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// AdminApiKeyCreateHandler 为合作方签发 API key
+func AdminApiKeyCreateHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		var req types.AdminApiKeyCreateReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.IssueApiKey(c.Request.Context(), svcCtx, op, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.AdminApiKeyCreateResp{Result: res})
+	}
+}
+
+// AdminApiKeysHandler 查询所有 API key
+func AdminApiKeysHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := service.GetApiKeys(c.Request.Context(), svcCtx)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// AdminApiKeyRevokeHandler 撤销 API key
+func AdminApiKeyRevokeHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		op, ok := adminOperator(c)
+		if !ok {
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Params.ByName("id"), 10, 64)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.RevokeApiKey(c.Request.Context(), svcCtx, op, id); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.AdminApiKeyRevokeResp{Result: id})
+	}
+}
+
+// AdminApiKeyUsageHandler 查询 API key 最近若干天的用量 (?days=, 默认 7 天)
+func AdminApiKeyUsageHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := strconv.ParseInt(c.Params.ByName("id"), 10, 64)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+		days, err := strconv.Atoi(c.DefaultQuery("days", "7"))
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.GetApiKeyUsage(c.Request.Context(), svcCtx, id, days)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// adminOperator 获取当前管理员信息, 失败时已写入错误响应
+func adminOperator(c *gin.Context) (types.AdminOperator, bool) {
+	adminAddr, err := middleware.GetAuthUserAddress(c)
+	if err != nil {
+		xhttp.Error(c, errcode.ErrTokenVerify)
+		return types.AdminOperator{}, false
+	}
+	return types.AdminOperator{Address: adminAddr, IP: c.ClientIP()}, true
+}
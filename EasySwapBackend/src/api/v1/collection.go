@@ -11,6 +11,7 @@ import (
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
 	"github.com/ProjectsTask/EasySwapBase/xhttp"
 
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
 	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
@@ -49,6 +50,13 @@ func CollectionItemsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 			return
 		}
 
+		// 价格区间校验
+		if filter.MinPrice.IsNegative() || filter.MaxPrice.IsNegative() ||
+			(filter.MaxPrice.IsPositive() && filter.MinPrice.GreaterThan(filter.MaxPrice)) {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
 		// 5. 调用 Service 层获取 Item 列表
 		res, err := service.GetItems(c.Request.Context(), svcCtx, chain, filter, collectionAddr)
 		if err != nil {
@@ -183,10 +191,19 @@ func ItemDetailHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 
 		res, err := service.GetItem(c.Request.Context(), svcCtx, chain, int(chainID), collectionAddr, tokenID)
 		if err != nil {
+			if errcode.IsErr(err) {
+				xhttp.Error(c, err)
+				return
+			}
 			xhttp.Error(c, errcode.NewCustomErr("get item error"))
 			return
+		}
 
+		// 记录浏览量: 只统计登录用户, 按地址限流; 未登录请求的 IP 可伪造或轮换, 不计入浏览量
+		if userAddr, err := middleware.GetAuthUserAddress(c); err == nil {
+			service.RecordItemView(c.Request.Context(), svcCtx, chain, collectionAddr, tokenID, userAddr)
 		}
+
 		xhttp.OkJson(c, res)
 	}
 }
@@ -273,11 +290,7 @@ func HistorySalesHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 			return
 		}
 
-		xhttp.OkJson(c, struct {
-			Result interface{} `json:"result"`
-		}{
-			Result: res,
-		})
+		xhttp.OkJson(c, types.HistorySalesResp{Result: res})
 	}
 }
 
@@ -353,11 +366,7 @@ func ItemOwnerHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 			return
 		}
 
-		xhttp.OkJson(c, struct {
-			Result interface{} `json:"result"`
-		}{
-			Result: owner,
-		})
+		xhttp.OkJson(c, types.ItemOwnerResp{Result: owner})
 	}
 }
 
@@ -395,9 +404,7 @@ func GetItemImageHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 			return
 		}
 
-		xhttp.OkJson(c, struct {
-			Result interface{} `json:"result"`
-		}{Result: result})
+		xhttp.OkJson(c, types.ItemImageResp{Result: result})
 	}
 }
 
@@ -463,7 +470,7 @@ func CollectionDetailHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 		}
 		res, err := service.GetCollectionDetail(c.Request.Context(), svcCtx, chain, collectionAddr)
 		if err != nil {
-			xhttp.Error(c, errcode.ErrUnexpected)
+			xhttp.Error(c, err)
 			return
 		}
 
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// ActivityExportHandler 同步流式导出活动 (CSV / NDJSON)
+// 功能:
+// 1. 按用户、集合、时间范围等条件过滤, 行数超过上限时提示改用导出任务
+// 2. 分批查询并边查边写, 不在内存中缓存全部结果
+func ActivityExportHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filterParam := c.Query("filters")
+		if filterParam == "" {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+
+		var filter types.ActivityExportFilterParams
+		if err := json.Unmarshal([]byte(filterParam), &filter); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		export, err := service.PrepareActivityExport(c.Request.Context(), svcCtx, &filter)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+
+		c.Header("Content-Type", export.ContentType())
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", export.FileName()))
+		c.Status(http.StatusOK)
+		// 响应头已发送, 之后的错误只能记录日志并中断输出
+		if _, err := export.Write(c.Request.Context(), c.Writer, nil); err != nil {
+			xzap.WithContext(c.Request.Context()).Error("failed on stream activity export", zap.Error(err))
+		}
+	}
+}
+
+// ExportJobCreateHandler 创建异步活动导出任务 (需登录)
+func ExportJobCreateHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var req types.ActivityExportFilterParams
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.CreateActivityExportJob(c.Request.Context(), svcCtx, userAddr, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// ExportJobsHandler 分页查询当前用户的导出任务 (需登录)
+func ExportJobsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var filter types.ExportJobFilterParams
+		if filterParam := c.Query("filters"); filterParam != "" {
+			if err := json.Unmarshal([]byte(filterParam), &filter); err != nil {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+		}
+
+		res, err := service.GetExportJobs(c.Request.Context(), svcCtx, userAddr, filter)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// ExportJobHandler 查询当前用户的指定导出任务, 完成后返回下载链接 (需登录)
+func ExportJobHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Params.ByName("id"), 10, 64)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.GetExportJob(c.Request.Context(), svcCtx, userAddr, id)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// ExportFileHandler 按下载凭证下载导出文件, 凭证随任务返回, 文件过期后失效; 文件保存在共享数据库中, 任一实例均可下载
+func ExportFileHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		job, err := service.GetExportFile(c.Request.Context(), svcCtx, c.Params.ByName("token"))
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+
+		c.Header("Content-Type", service.ExportContentType(job.Format))
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", job.FileName))
+		c.Header("Content-Length", strconv.FormatInt(job.FileSize, 10))
+		c.Status(http.StatusOK)
+		// 响应头已发送, 之后的错误只能记录日志并中断输出
+		if _, err := service.WriteExportFile(c.Request.Context(), svcCtx, job, c.Writer); err != nil {
+			xzap.WithContext(c.Request.Context()).Error("failed on download export file", zap.Error(err), zap.Int64("id", job.Id))
+		}
+	}
+}
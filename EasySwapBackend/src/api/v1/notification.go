@@ -0,0 +1,205 @@
+package v1
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/gin-gonic/gin"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// NotificationsHandler 分页查询当前用户的站内通知 (需登录)
+func NotificationsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var filter types.NotificationFilterParams
+		if filterParam := c.Query("filters"); filterParam != "" {
+			if err := json.Unmarshal([]byte(filterParam), &filter); err != nil {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+		}
+
+		res, err := service.GetNotifications(c.Request.Context(), svcCtx, userAddr, filter)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// NotificationReadHandler 将指定通知或全部通知标记为已读 (需登录)
+func NotificationReadHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var req types.NotificationReadReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.ReadNotifications(c.Request.Context(), svcCtx, userAddr, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// NotificationPreferenceHandler 查询当前用户的通知偏好 (需登录)
+func NotificationPreferenceHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		res, err := service.GetNotificationPreference(c.Request.Context(), svcCtx, userAddr)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, types.NotificationPreferenceResp{Result: res})
+	}
+}
+
+// NotificationPreferenceUpdateHandler 更新当前用户的通知偏好 (需登录)
+// 屏蔽的通知类型不生成站内通知, 开启 webhook / 邮件后通知同时投递到对应渠道
+func NotificationPreferenceUpdateHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var req types.NotificationPreferenceReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.UpdateNotificationPreference(c.Request.Context(), svcCtx, userAddr, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.NotificationPreferenceResp{Result: res})
+	}
+}
+
+// FloorAlertsHandler 查询当前用户的地板价提醒 (需登录)
+func FloorAlertsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		res, err := service.GetFloorAlerts(c.Request.Context(), svcCtx, userAddr)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, types.FloorAlertsResp{Result: res})
+	}
+}
+
+// FloorAlertSetHandler 创建或更新集合地板价提醒 (需登录)
+func FloorAlertSetHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var req types.FloorAlertReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.SetFloorAlert(c.Request.Context(), svcCtx, chain, userAddr, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.FloorAlertResp{Result: res})
+	}
+}
+
+// FloorAlertDeleteHandler 删除地板价提醒 (需登录)
+func FloorAlertDeleteHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		id, err := strconv.ParseInt(c.Params.ByName("id"), 10, 64)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.DeleteFloorAlert(c.Request.Context(), svcCtx, userAddr, id); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
+
+// NotificationDeliveriesHandler 分页查询当前用户通知的 webhook / 邮件投递记录 (需登录)
+func NotificationDeliveriesHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var filter types.NotificationDeliveryFilterParams
+		if filterParam := c.Query("filters"); filterParam != "" {
+			if err := json.Unmarshal([]byte(filterParam), &filter); err != nil {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+		}
+
+		res, err := service.GetNotificationDeliveries(c.Request.Context(), svcCtx, userAddr, filter)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
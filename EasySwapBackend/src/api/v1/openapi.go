@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/gin-gonic/gin"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/openapi"
+)
+
+// OpenAPIHandler 返回 OpenAPI 3 接口文档
+// 文档本身不使用统一响应结构包装, 便于直接导入 Swagger UI 等工具
+func OpenAPIHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		spec, err := openapi.Spec()
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", spec)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ProjectsTask/EasySwapBase/xhttp"
 	"github.com/gin-gonic/gin"
 
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
 	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
@@ -54,8 +55,267 @@ func OrderInfosHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 			return
 		}
 		// 5. 返回结果 (包装在 result 字段中)
-		xhttp.OkJson(c, struct {
-			Result interface{} `json:"result"`
-		}{Result: res})
+		xhttp.OkJson(c, types.OrderInfosResp{Result: res})
+	}
+}
+
+// TraitBidCreateHandler 创建属性出价 (需登录)
+// 属性出价为 Maker 签名的链下集合出价, 没有托管, 由后端撮合, 适用于集合中拥有指定 (trait, trait_value) 的任意 Item
+func TraitBidCreateHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 1. 获取登录用户地址
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		// 2. 解析请求参数
+		var req types.TraitBidReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		// 3. 调用 Service 层创建属性出价
+		res, err := service.CreateTraitBid(c.Request.Context(), svcCtx, chain, userAddr, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// TraitBidFillTxHandler 撮合属性出价 (需登录, 仅订单创建者)
+// 返回出价人购买指定 Item 挂单的 matchOrder 待签名交易
+func TraitBidFillTxHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		orderID := c.Params.ByName("order_id")
+		if orderID == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		var req types.TraitBidFillTxReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.BuildTraitBidFillTx(c.Request.Context(), svcCtx, chain, userAddr, orderID, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// TraitBidCancelHandler 取消属性出价 (需登录, 仅订单创建者)
+func TraitBidCancelHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		orderID := c.Params.ByName("order_id")
+		if orderID == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		var req types.CancelTraitBidReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.CancelTraitBid(c.Request.Context(), svcCtx, chain, userAddr, orderID); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: orderID})
+	}
+}
+
+// SignedOrderCreateHandler 接收链下 EIP-712 签名订单
+// 签名即可证明 Maker 身份, 无需登录; 订单以合约 OrderKey 作为订单 ID 保存
+func SignedOrderCreateHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req types.SignedOrderReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.CreateSignedOrder(c.Request.Context(), svcCtx, chain, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// SignedOrderCancelHandler 取消尚未上链的链下签名订单 (需登录, 仅订单 Maker)
+func SignedOrderCancelHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		orderID := c.Params.ByName("order_id")
+		if orderID == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		var req types.CancelSignedOrderReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.CancelSignedOrder(c.Request.Context(), svcCtx, chain, userAddr, orderID); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.CommonResp{Result: orderID})
+	}
+}
+
+// MakeOrdersTxHandler 构造 makeOrders 待签名交易 (挂单/出价)
+func MakeOrdersTxHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req types.MakeOrdersTxReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if _, ok := chainIDToChain[req.ChainID]; !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.BuildMakeOrdersTx(c.Request.Context(), svcCtx, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// CancelOrdersTxHandler 构造 cancelOrders 待签名交易
+func CancelOrdersTxHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req types.CancelOrdersTxReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.BuildCancelOrdersTx(c.Request.Context(), svcCtx, chain, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// EditOrdersTxHandler 构造 editOrders 待签名交易 (改价)
+func EditOrdersTxHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req types.EditOrdersTxReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.BuildEditOrdersTx(c.Request.Context(), svcCtx, chain, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// MatchOrdersTxHandler 构造 matchOrder/matchOrders 待签名交易 (购买/接受出价)
+func MatchOrdersTxHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req types.MatchOrdersTxReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.BuildMatchOrdersTx(c.Request.Context(), svcCtx, chain, &req)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
 	}
 }
@@ -194,3 +194,151 @@ func UserMultiChainBidsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 		xhttp.OkJson(c, res)
 	}
 }
+
+// UserMultiChainEscrowHandler 查询用户在 Vault 中托管的资产
+// 功能:
+// 1. 汇总用户买单锁定的 ETH 及卖单托管的 NFT, 按订单及集合展示
+func UserMultiChainEscrowHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filterParam := c.Query("filters")
+		if filterParam == "" {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+
+		var filter types.PortfolioEscrowFilterParams
+		err := json.Unmarshal([]byte(filterParam), &filter)
+		if err != nil {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+		if len(filter.UserAddresses) == 0 {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		// if filter.ChainID is empty, show all chain info
+		if len(filter.ChainID) == 0 {
+			for _, chain := range svcCtx.C.ChainSupported {
+				filter.ChainID = append(filter.ChainID, chain.ChainID)
+			}
+		}
+
+		var chainNames []string
+		for _, chainID := range filter.ChainID {
+			chain, ok := chainIDToChain[chainID]
+			if !ok {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+			chainNames = append(chainNames, chain)
+		}
+
+		res, err := service.GetMultiChainUserEscrow(c.Request.Context(), svcCtx, filter.ChainID, chainNames, filter.UserAddresses, filter.CollectionAddresses)
+		if err != nil {
+			xhttp.Error(c, errcode.NewCustomErr("query user multi chain escrow err."))
+			return
+		}
+
+		xhttp.OkJson(c, res)
+	}
+}
+
+// UserMultiChainValuationHandler 查询用户持仓估值及盈亏
+// 功能:
+// 1. 按地板价及集合最高出价为持仓估值, 以买入成交价作为成本计算未实现盈亏
+// 2. 汇总卖出成交按成交时的费率扣除协议手续费后的已实现盈亏
+func UserMultiChainValuationHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filterParam := c.Query("filters")
+		if filterParam == "" {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+
+		var filter types.PortfolioValuationFilterParams
+		err := json.Unmarshal([]byte(filterParam), &filter)
+		if err != nil {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+		if len(filter.UserAddresses) == 0 {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		// if filter.ChainID is empty, show all chain info
+		if len(filter.ChainID) == 0 {
+			for _, chain := range svcCtx.C.ChainSupported {
+				filter.ChainID = append(filter.ChainID, chain.ChainID)
+			}
+		}
+
+		var chainNames []string
+		for _, chainID := range filter.ChainID {
+			chain, ok := chainIDToChain[chainID]
+			if !ok {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+			chainNames = append(chainNames, chain)
+		}
+
+		res, err := service.GetMultiChainUserValuation(c.Request.Context(), svcCtx, filter.ChainID, chainNames, filter.UserAddresses)
+		if err != nil {
+			xhttp.Error(c, errcode.NewCustomErr("query user multi chain valuation err."))
+			return
+		}
+
+		xhttp.OkJson(c, res)
+	}
+}
+
+// UserMultiChainValueHistoryHandler 查询用户持仓价值历史
+// 功能:
+// 1. 按周期采样, 以各时间点的地板价快照计算当时持仓的价值
+func UserMultiChainValueHistoryHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		filterParam := c.Query("filters")
+		if filterParam == "" {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+
+		var filter types.PortfolioValueHistoryFilterParams
+		err := json.Unmarshal([]byte(filterParam), &filter)
+		if err != nil {
+			xhttp.Error(c, errcode.NewCustomErr("Filter param is nil."))
+			return
+		}
+		if len(filter.UserAddresses) == 0 {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		// if filter.ChainID is empty, show all chain info
+		if len(filter.ChainID) == 0 {
+			for _, chain := range svcCtx.C.ChainSupported {
+				filter.ChainID = append(filter.ChainID, chain.ChainID)
+			}
+		}
+
+		var chainNames []string
+		for _, chainID := range filter.ChainID {
+			chain, ok := chainIDToChain[chainID]
+			if !ok {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+			chainNames = append(chainNames, chain)
+		}
+
+		res, err := service.GetMultiChainUserValueHistory(c.Request.Context(), svcCtx, chainNames, filter.UserAddresses, filter.Period)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+
+		xhttp.OkJson(c, res)
+	}
+}
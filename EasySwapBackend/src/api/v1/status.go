@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"github.com/ProjectsTask/EasySwapBase/health"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/gin-gonic/gin"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// SyncStatusHandler 各链订单簿事件索引进度, 前端据此提示数据延迟
+func SyncStatusHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := service.GetSyncStatus(c.Request.Context(), svcCtx)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, types.SyncStatusResp{Result: res})
+	}
+}
+
+// LivenessHandler 存活检查, backend 没有后台循环, 进程可响应即存活
+func LivenessHandler() gin.HandlerFunc {
+	return gin.WrapH(health.LivenessHandler())
+}
+
+// ReadinessHandler 就绪检查, MySQL、Redis 或任一链节点不可用时返回 503
+func ReadinessHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return gin.WrapH(svcCtx.HealthChecker().ReadinessHandler())
+}
@@ -1,11 +1,14 @@
 package v1
 
 import (
+	"strconv"
+
 	"github.com/ProjectsTask/EasySwapBase/errcode"
 	"github.com/ProjectsTask/EasySwapBase/kit/validator"
 	"github.com/ProjectsTask/EasySwapBase/xhttp"
 	"github.com/gin-gonic/gin"
 
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
 	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
@@ -14,13 +17,8 @@ import (
 // UserLoginHandler 处理用户登录请求
 // 功能:
 // 1. 接收前端提交的签名信息和 Nonce
-// 2. 验证签名合法性 (EIP-191/712)
-// 3. 验证通过后颁发 JWT 或 Session Token
-// UserLoginHandler 处理用户登录请求
-// 功能:
-// 1. 接收前端提交的签名信息和 Nonce
-// 2. 验证签名合法性 (EIP-191/712)
-// 3. 验证通过后颁发 JWT 或 Session Token
+// 2. 验证 SIWE 消息及签名合法性 (EIP-4361/191, 合约钱包 EIP-1271)
+// 3. 验证通过后创建会话, 颁发 access/refresh token
 func UserLoginHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		req := types.LoginReq{}
@@ -37,7 +35,10 @@ func UserLoginHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 		}
 
 		// 3. 调用 Service 执行登录逻辑 (验证签名、生成Token)
-		res, err := service.UserLogin(c.Request.Context(), svcCtx, req)
+		res, err := service.UserLogin(c.Request.Context(), svcCtx, req, types.LoginDevice{
+			UserAgent: c.Request.UserAgent(),
+			IP:        c.ClientIP(),
+		})
 		if err != nil {
 			xhttp.Error(c, errcode.NewCustomErr(err.Error()))
 			return
@@ -50,11 +51,11 @@ func UserLoginHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 	}
 }
 
-// GetLoginMessageHandler 获取登录签名消息 (Nonce)
+// GetLoginMessageHandler 获取 SIWE (EIP-4361) 登录签名消息
 // 功能:
 // 1. 生成唯一的随机字符串 (Nonce)
 // 2. 缓存 Nonce 到 Redis，关联用户地址
-// 3. 返回 Nonce 给前端供用户签名
+// 3. 返回包含 Nonce 的 SIWE 消息给前端供用户签名
 func GetLoginMessageHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// 1. 获取用户地址参数
@@ -64,14 +65,25 @@ func GetLoginMessageHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 			return
 		}
 
-		// 2. 调用 Service 生成登录消息
-		res, err := service.GetUserLoginMsg(c.Request.Context(), svcCtx, address)
+		// 2. 获取链 ID, 写入 SIWE 消息的 Chain ID 字段
+		chainID, err := strconv.ParseInt(c.Query("chain_id"), 10, 64)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+		if _, ok := chainIDToChain[int(chainID)]; !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		// 3. 调用 Service 生成登录消息
+		res, err := service.GetUserLoginMsg(c.Request.Context(), svcCtx, address, int(chainID))
 		if err != nil {
 			xhttp.Error(c, errcode.NewCustomErr(err.Error()))
 			return
 		}
 
-		// 3. 返回消息对象
+		// 4. 返回消息对象
 		xhttp.OkJson(c, res)
 	}
 }
@@ -95,3 +107,101 @@ func GetSigStatusHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
 		xhttp.OkJson(c, res)
 	}
 }
+
+// RefreshTokenHandler 使用 refresh token 换取新的 access/refresh token
+func RefreshTokenHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req types.RefreshTokenReq
+		if err := c.BindJSON(&req); err != nil || req.RefreshToken == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		res, err := service.RefreshUserToken(c.Request.Context(), svcCtx, req.RefreshToken)
+		if err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// UserLogoutHandler 退出当前会话 (需登录)
+func UserLogoutHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+		sessionID, err := middleware.GetAuthSessionID(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		if err := service.UserLogout(c.Request.Context(), svcCtx, userAddr, sessionID); err != nil {
+			xhttp.Error(c, errcode.NewCustomErr(err.Error()))
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
+
+// UserLogoutAllHandler 退出所有会话 (需登录)
+func UserLogoutAllHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		if err := service.UserLogoutAll(c.Request.Context(), svcCtx, userAddr); err != nil {
+			xhttp.Error(c, errcode.NewCustomErr(err.Error()))
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
+
+// UserSessionsHandler 查询当前用户的登录会话 (设备) 列表 (需登录)
+func UserSessionsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+		sessionID, _ := middleware.GetAuthSessionID(c)
+
+		res, err := service.GetUserSessions(c.Request.Context(), svcCtx, userAddr, sessionID)
+		if err != nil {
+			xhttp.Error(c, errcode.NewCustomErr(err.Error()))
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// UserSessionRevokeHandler 撤销指定会话 (需登录, 用于下线其他设备)
+func UserSessionRevokeHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+		sessionID := c.Params.ByName("session_id")
+		if sessionID == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.UserLogout(c.Request.Context(), svcCtx, userAddr, sessionID); err != nil {
+			xhttp.Error(c, errcode.NewCustomErr(err.Error()))
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
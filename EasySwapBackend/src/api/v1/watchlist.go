@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/gin-gonic/gin"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// FavoriteItemsHandler 分页查询当前用户收藏的 Item (需登录)
+func FavoriteItemsHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var filter types.FavoriteFilterParams
+		if filterParam := c.Query("filters"); filterParam != "" {
+			if err := json.Unmarshal([]byte(filterParam), &filter); err != nil {
+				xhttp.Error(c, errcode.ErrInvalidParams)
+				return
+			}
+		}
+
+		res, err := service.GetFavoriteItems(c.Request.Context(), svcCtx, userAddr, filter)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// FavoriteItemAddHandler 收藏 Item (需登录)
+func FavoriteItemAddHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var req types.FavoriteItemReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.AddFavoriteItem(c.Request.Context(), svcCtx, chain, userAddr, &req); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
+
+// FavoriteItemRemoveHandler 取消收藏 Item (需登录, ?chain_id=)
+func FavoriteItemRemoveHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		collectionAddr := c.Params.ByName("address")
+		tokenID := c.Params.ByName("token_id")
+		if collectionAddr == "" || tokenID == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chainID, err := strconv.Atoi(c.Query("chain_id"))
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+		if _, ok := chainIDToChain[chainID]; !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.RemoveFavoriteItem(c.Request.Context(), svcCtx, userAddr, chainID, collectionAddr, tokenID); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
+
+// WatchlistHandler 查询当前用户关注的集合及实时地板价、24h 交易量和涨跌幅 (需登录)
+func WatchlistHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		res, err := service.GetWatchlist(c.Request.Context(), svcCtx, userAddr)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrUnexpected)
+			return
+		}
+		xhttp.OkJson(c, res)
+	}
+}
+
+// WatchCollectionHandler 关注集合 (需登录)
+func WatchCollectionHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		var req types.WatchCollectionReq
+		if err := c.BindJSON(&req); err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chain, ok := chainIDToChain[req.ChainID]
+		if !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.WatchCollection(c.Request.Context(), svcCtx, chain, userAddr, &req); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
+
+// UnwatchCollectionHandler 取消关注集合 (需登录, ?chain_id=)
+func UnwatchCollectionHandler(svcCtx *svc.ServerCtx) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userAddr, err := middleware.GetAuthUserAddress(c)
+		if err != nil {
+			xhttp.Error(c, errcode.ErrTokenVerify)
+			return
+		}
+
+		collectionAddr := c.Params.ByName("address")
+		if collectionAddr == "" {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		chainID, err := strconv.Atoi(c.Query("chain_id"))
+		if err != nil {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+		if _, ok := chainIDToChain[chainID]; !ok {
+			xhttp.Error(c, errcode.ErrInvalidParams)
+			return
+		}
+
+		if err := service.UnwatchCollection(c.Request.Context(), svcCtx, userAddr, chainID, collectionAddr); err != nil {
+			xhttp.Error(c, err)
+			return
+		}
+		xhttp.OkJson(c, nil)
+	}
+}
@@ -2,36 +2,143 @@ package app
 
 import (
 	"context"
+	"net"
+	"net/http"
 
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
 	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
+	"github.com/ProjectsTask/EasySwapBackend/src/api/rpc"
 	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/mq"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/v1"
 )
 
 // Platform 平台结构体，作为整个应用程序的容器
 type Platform struct {
-	config    *config.Config
-	router    *gin.Engine
-	serverCtx *svc.ServerCtx
+	config     *config.Config
+	router     *gin.Engine
+	grpcServer *grpc.Server // 市场只读 gRPC 服务, 未配置时为 nil
+	serverCtx  *svc.ServerCtx
+	server     *http.Server
+	supervisor *supervisor.Supervisor // 后台任务监督器, Stop 时停止并等待正在执行的任务
 }
 
 // NewPlatform 创建一个新的 Platform 实例
 func NewPlatform(config *config.Config, router *gin.Engine, serverCtx *svc.ServerCtx) (*Platform, error) {
-	return &Platform{
+	p := &Platform{
 		config:    config,
 		router:    router,
 		serverCtx: serverCtx,
-	}, nil
+		server: &http.Server{
+			Addr:    config.Api.Port,
+			Handler: router,
+		},
+		supervisor: supervisor.New(context.Background()),
+	}
+	if config.Grpc != nil && config.Grpc.Port != "" {
+		p.grpcServer = rpc.NewGrpcServer(serverCtx)
+	}
+	if p.metricsEnabled() {
+		// 元数据刷新队列由 backend 写入, 在此采集其长度
+		var queues []metrics.Queue
+		for _, chain := range config.ChainSupported {
+			queues = append(queues, metrics.Queue{
+				Name:  "item_metadata_refresh",
+				Chain: chain.Name,
+				Key:   mq.GetRefreshSingleItemMetadataKey(config.ProjectCfg.Name, chain.Name),
+				Type:  metrics.SetQueue,
+			})
+		}
+		if err := metrics.RegisterQueues(serverCtx.KvStore, queues...); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *Platform) metricsEnabled() bool {
+	return p.config.Metrics != nil && p.config.Metrics.Port != ""
 }
 
 // Start 启动平台服务
-// 这是一个阻塞调用，会启动 HTTP 服务器监听指定端口
+// 这是一个阻塞调用，会启动 HTTP 服务器监听指定端口, 调用 Stop 后返回
+// 配置了 gRPC 端口时, gRPC 服务与 HTTP 服务同时运行; 配置了指标端口时另起 HTTP 服务暴露 /metrics
+// 开启导出时在后台执行异步导出任务
 func (p *Platform) Start() {
+	if p.grpcServer != nil {
+		lis, err := net.Listen("tcp", p.config.Grpc.Port)
+		if err != nil {
+			panic(err)
+		}
+		xzap.WithContext(context.Background()).Info("EasySwap-End grpc run", zap.String("port", p.config.Grpc.Port))
+		go func() {
+			if err := p.grpcServer.Serve(lis); err != nil {
+				panic(err)
+			}
+		}()
+	}
+
+	if p.metricsEnabled() {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		xzap.WithContext(context.Background()).Info("EasySwap-End metrics run", zap.String("port", p.config.Metrics.Port))
+		go func() {
+			if err := http.ListenAndServe(p.config.Metrics.Port, mux); err != nil {
+				panic(err)
+			}
+		}()
+	}
+
+	if p.config.Export != nil && p.config.Export.Enabled {
+		xzap.WithContext(context.Background()).Info("EasySwap-End export jobs run")
+		p.supervisor.Go("export_jobs", func(ctx context.Context) error {
+			service.RunExportJobs(ctx, p.serverCtx)
+			return nil
+		})
+	}
+
 	xzap.WithContext(context.Background()).Info("EasySwap-End run", zap.String("port", p.config.Api.Port))
-	if err := p.router.Run(p.config.Api.Port); err != nil {
+	if err := p.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(err)
 	}
 }
+
+// Stop 停止平台服务: 不再接受新请求并等待处理中的请求完成, 停止后台任务 (正在执行的导出任务归还后再退出)
+// ctx 到期时强制关闭 gRPC 连接, 无论前面的步骤是否出错都会停止后台任务
+func (p *Platform) Stop(ctx context.Context) error {
+	var stopErr error
+	if err := p.server.Shutdown(ctx); err != nil {
+		stopErr = errors.Wrap(err, "failed on shutdown http server")
+	}
+	if p.grpcServer != nil {
+		stopGrpcServer(ctx, p.grpcServer)
+	}
+	if err := p.supervisor.Shutdown(ctx); err != nil && stopErr == nil {
+		stopErr = err
+	}
+	return stopErr
+}
+
+// stopGrpcServer 等待处理中的 gRPC 请求完成
+// GracefulStop 不会取消流式请求的 context, 订阅活动流的连接会一直阻塞, ctx 到期时调用 Stop 关闭剩余连接
+func stopGrpcServer(ctx context.Context, server *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		xzap.WithContext(ctx).Warn("grpc graceful stop timeout, closing remaining streams")
+		server.Stop()
+		<-done
+	}
+}
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestMain(m *testing.M) {
+	if _, err := xzap.SetUp(logging.LogConf{ServiceName: "app_test", Mode: "console", Path: os.TempDir(), Level: "error"}); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestStopClosesOpenStreams(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+
+	// 打开一个不会主动结束的流式请求, GracefulStop 会一直等待它返回
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	stream, err := healthpb.NewHealthClient(conn).Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.NoError(t, err)
+
+	p := &Platform{
+		server:     &http.Server{},
+		grpcServer: grpcServer,
+		supervisor: supervisor.New(context.Background()),
+	}
+	loopStopped := make(chan struct{})
+	p.supervisor.Go("test", func(ctx context.Context) error {
+		<-ctx.Done()
+		close(loopStopped)
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	stopped := make(chan struct{})
+	go func() {
+		p.Stop(ctx)
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Stop blocked on open grpc stream")
+	}
+	// 流被强制关闭后仍停止后台任务
+	select {
+	case <-loopStopped:
+	case <-time.After(time.Second):
+		t.Fatal("supervised loop not stopped")
+	}
+	_, err = stream.Recv()
+	assert.Error(t, err)
+}
+
+func TestStopWithoutOpenStreams(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+
+	p := &Platform{
+		server:     &http.Server{},
+		grpcServer: grpcServer,
+		supervisor: supervisor.New(context.Background()),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, p.Stop(ctx))
+}
@@ -0,0 +1,171 @@
+// Package client EasySwap Backend 接口的 Go 客户端
+// 接口方法由 openapi.Routes 生成 (client_gen.go), 请求/响应使用 src/types/v1 中的类型.
+// 业务错误以 *errcode.Err 返回, 已知错误码返回对应的预定义错误, 可直接与 errcode.ErrXxx 比较.
+package client
+
+//go:generate go run ../api/openapi/clientgen -out client_gen.go
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/xhttp"
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/api/openapi"
+)
+
+// apiKeyHeader 合作方 API key 请求头
+const apiKeyHeader = "X-API-Key"
+
+// Client 接口客户端, 可并发使用
+type Client struct {
+	baseURL     string
+	httpClient  *http.Client
+	accessToken string
+	apiKey      string
+}
+
+type Option func(*Client)
+
+// WithHTTPClient 使用自定义 http.Client
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithAccessToken 使用登录后获得的 access token 调用需登录的接口
+func WithAccessToken(accessToken string) Option {
+	return func(c *Client) {
+		c.accessToken = accessToken
+	}
+}
+
+// WithApiKey 使用合作方 API key 调用接口
+func WithApiKey(apiKey string) Option {
+	return func(c *Client) {
+		c.apiKey = apiKey
+	}
+}
+
+// New 创建客户端, endpoint 为服务地址, 如 http://127.0.0.1:80
+func New(endpoint string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(endpoint, "/") + openapi.BasePath,
+		httpClient: xhttp.NewDefaultHTTPClient(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithAccessToken 返回使用指定 access token 的客户端副本, 用于登录后调用需登录的接口
+func (c *Client) WithAccessToken(accessToken string) *Client {
+	cp := *c
+	cp.accessToken = accessToken
+	return &cp
+}
+
+// response 统一响应结构
+type response struct {
+	TraceId string          `json:"trace_id"`
+	Code    uint32          `json:"code"`
+	Msg     string          `json:"msg"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// do 发送请求并解析统一响应结构, out 为 nil 时忽略 data
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out interface{}) error {
+	rawURL := c.baseURL + path
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		raw, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed on marshal request body")
+		}
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, reader)
+	if err != nil {
+		return errors.Wrap(err, "failed on new request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	}
+	if c.apiKey != "" {
+		req.Header.Set(apiKeyHeader, c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed on request %s %s", method, path)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed on read response body")
+	}
+
+	var envelope response
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return fmt.Errorf("unexpected response from %s %s: status %d", method, path, resp.StatusCode)
+	}
+	if envelope.Code != errcode.CodeOK {
+		return parseErr(envelope.Code, envelope.Msg, resp.StatusCode)
+	}
+
+	if out == nil || len(envelope.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return errors.Wrap(err, "failed on unmarshal response data")
+	}
+	return nil
+}
+
+// parseErr 将错误码转换为业务错误, 已知错误码返回预定义错误
+func parseErr(code uint32, msg string, httpCode int) error {
+	if code != errcode.CodeCustom {
+		if e, ok := errcode.GetCodeToErr()[code]; ok {
+			return e
+		}
+	}
+	return errcode.NewErr(code, msg, httpCode)
+}
+
+// setQuery 设置查询参数, 非必填参数为零值时不传
+func setQuery(query url.Values, name string, value interface{}, required bool) {
+	if !required && reflect.ValueOf(value).IsZero() {
+		return
+	}
+	query.Set(name, fmt.Sprint(value))
+}
+
+// setFilters 将过滤参数编码为 JSON 放入 filters 查询参数
+func setFilters(query url.Values, filters interface{}) error {
+	raw, err := json.Marshal(filters)
+	if err != nil {
+		return errors.Wrap(err, "failed on marshal filters")
+	}
+	query.Set(openapi.FiltersParam, string(raw))
+	return nil
+}
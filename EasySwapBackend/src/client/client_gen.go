@@ -0,0 +1,803 @@
+// Code generated by clientgen from openapi.Routes. DO NOT EDIT.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// GetSyncStatus 各链事件索引进度
+// GET /api/v1/status
+func (c *Client) GetSyncStatus(ctx context.Context) (*types.SyncStatusResp, error) {
+	query := url.Values{}
+	var result types.SyncStatusResp
+	if err := c.do(ctx, http.MethodGet, "/status", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetLoginMessage 生成 SIWE 登录签名消息
+// GET /api/v1/user/:address/login-message
+func (c *Client) GetLoginMessage(ctx context.Context, address string, chainID int) (*types.UserLoginMsgResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.UserLoginMsgResp
+	if err := c.do(ctx, http.MethodGet, "/user/"+url.PathEscape(address)+"/login-message", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UserLogin 登录验证
+// POST /api/v1/user/login
+func (c *Client) UserLogin(ctx context.Context, req *types.LoginReq) (*types.UserLoginResp, error) {
+	query := url.Values{}
+	var result types.UserLoginResp
+	if err := c.do(ctx, http.MethodPost, "/user/login", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSigStatus 获取用户签名状态
+// GET /api/v1/user/:address/sig-status
+func (c *Client) GetSigStatus(ctx context.Context, address string) (*types.UserSignStatusResp, error) {
+	query := url.Values{}
+	var result types.UserSignStatusResp
+	if err := c.do(ctx, http.MethodGet, "/user/"+url.PathEscape(address)+"/sig-status", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RefreshToken 使用 refresh token 换取新 token
+// POST /api/v1/user/token/refresh
+func (c *Client) RefreshToken(ctx context.Context, req *types.RefreshTokenReq) (*types.UserTokens, error) {
+	query := url.Values{}
+	var result types.UserTokens
+	if err := c.do(ctx, http.MethodPost, "/user/token/refresh", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UserLogout 退出当前会话
+// POST /api/v1/user/logout
+func (c *Client) UserLogout(ctx context.Context) error {
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, "/user/logout", query, nil, nil)
+}
+
+// UserLogoutAll 退出所有会话
+// POST /api/v1/user/logout-all
+func (c *Client) UserLogoutAll(ctx context.Context) error {
+	query := url.Values{}
+	return c.do(ctx, http.MethodPost, "/user/logout-all", query, nil, nil)
+}
+
+// GetUserSessions 当前用户的会话列表
+// GET /api/v1/user/sessions
+func (c *Client) GetUserSessions(ctx context.Context) (*types.UserSessionsResp, error) {
+	query := url.Values{}
+	var result types.UserSessionsResp
+	if err := c.do(ctx, http.MethodGet, "/user/sessions", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RevokeUserSession 撤销指定会话
+// DELETE /api/v1/user/sessions/:session_id
+func (c *Client) RevokeUserSession(ctx context.Context, sessionID string) error {
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, "/user/sessions/"+url.PathEscape(sessionID), query, nil, nil)
+}
+
+// GetCollectionDetail 集合详情
+// GET /api/v1/collections/:address
+func (c *Client) GetCollectionDetail(ctx context.Context, address string, chainID int) (*types.CollectionDetailResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.CollectionDetailResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address), query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCollectionBids 集合出价信息
+// GET /api/v1/collections/:address/bids
+func (c *Client) GetCollectionBids(ctx context.Context, address string, filters types.CollectionBidFilterParams) (*types.CollectionBidsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.CollectionBidsResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/bids", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetItemBids Item 出价信息
+// GET /api/v1/collections/:address/:token_id/bids
+func (c *Client) GetItemBids(ctx context.Context, address string, tokenID string, filters types.CollectionBidFilterParams) (*types.ItemBidsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.ItemBidsResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID)+"/bids", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCollectionItems 集合 Item 列表
+// GET /api/v1/collections/:address/items
+func (c *Client) GetCollectionItems(ctx context.Context, address string, filters types.CollectionItemFilterParams) (*types.NFTListingInfoResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.NFTListingInfoResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/items", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetItemDetail Item 详情
+// GET /api/v1/collections/:address/:token_id
+func (c *Client) GetItemDetail(ctx context.Context, address string, tokenID string, chainID int) (*types.ItemDetailInfoResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.ItemDetailInfoResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID), query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetItemTraits Item 属性
+// GET /api/v1/collections/:address/:token_id/traits
+func (c *Client) GetItemTraits(ctx context.Context, address string, tokenID string, chainID int) (*types.ItemTraitsResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.ItemTraitsResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID)+"/traits", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetItemTopTraitPrice Item 属性的最高价格
+// GET /api/v1/collections/:address/top-trait
+func (c *Client) GetItemTopTraitPrice(ctx context.Context, address string, filters types.TopTraitFilterParams) (*types.ItemTopTraitResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.ItemTopTraitResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/top-trait", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetItemImage Item 图片
+// GET /api/v1/collections/:address/:token_id/image
+func (c *Client) GetItemImage(ctx context.Context, address string, tokenID string, chainID int) (*types.ItemImageResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.ItemImageResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID)+"/image", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetHistorySales 历史成交价格
+// GET /api/v1/collections/:address/history-sales
+func (c *Client) GetHistorySales(ctx context.Context, address string, chainID int, duration string) (*types.HistorySalesResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	setQuery(query, "duration", duration, false)
+	var result types.HistorySalesResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/history-sales", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetItemOwner Item 所有者
+// GET /api/v1/collections/:address/:token_id/owner
+func (c *Client) GetItemOwner(ctx context.Context, address string, tokenID string, chainID int) (*types.ItemOwnerResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.ItemOwnerResp
+	if err := c.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID)+"/owner", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RefreshItemMetadata 刷新 Item 元数据
+// POST /api/v1/collections/:address/:token_id/metadata
+func (c *Client) RefreshItemMetadata(ctx context.Context, address string, tokenID string, chainID int) (*types.CommonResp, error) {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID)+"/metadata", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetTopRanking 集合交易量排行
+// GET /api/v1/collections/ranking
+func (c *Client) GetTopRanking(ctx context.Context, limit int64, rangeParam string) (*types.CollectionRankingResp, error) {
+	query := url.Values{}
+	setQuery(query, "limit", limit, true)
+	setQuery(query, "range", rangeParam, false)
+	var result types.CollectionRankingResp
+	if err := c.do(ctx, http.MethodGet, "/collections/ranking", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetActivities 多链活动列表
+// GET /api/v1/activities
+func (c *Client) GetActivities(ctx context.Context, filters types.ActivityMultiChainFilterParams) (*types.ActivityResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.ActivityResp
+	if err := c.do(ctx, http.MethodGet, "/activities", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserCollections 用户持有的集合
+// GET /api/v1/portfolio/collections
+func (c *Client) GetUserCollections(ctx context.Context, filters types.UserCollectionsParams) (*types.UserCollectionsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserCollectionsResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/collections", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserItems 用户持有的 Item
+// GET /api/v1/portfolio/items
+func (c *Client) GetUserItems(ctx context.Context, filters types.PortfolioMultiChainItemFilterParams) (*types.UserItemsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserItemsResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/items", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserListings 用户挂单
+// GET /api/v1/portfolio/listings
+func (c *Client) GetUserListings(ctx context.Context, filters types.PortfolioMultiChainListingFilterParams) (*types.UserListingsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserListingsResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/listings", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserBids 用户出价
+// GET /api/v1/portfolio/bids
+func (c *Client) GetUserBids(ctx context.Context, filters types.PortfolioMultiChainBidFilterParams) (*types.UserBidsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserBidsResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/bids", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserEscrow 用户 Vault 托管资产
+// GET /api/v1/portfolio/escrow
+func (c *Client) GetUserEscrow(ctx context.Context, filters types.PortfolioEscrowFilterParams) (*types.UserEscrowResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserEscrowResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/escrow", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserValuation 用户持仓估值及盈亏
+// GET /api/v1/portfolio/valuation
+func (c *Client) GetUserValuation(ctx context.Context, filters types.PortfolioValuationFilterParams) (*types.UserValuationResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserValuationResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/valuation", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetUserValueHistory 用户持仓价值历史
+// GET /api/v1/portfolio/value-history
+func (c *Client) GetUserValueHistory(ctx context.Context, filters types.PortfolioValueHistoryFilterParams) (*types.UserValueHistoryResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.UserValueHistoryResp
+	if err := c.do(ctx, http.MethodGet, "/portfolio/value-history", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateExportJob 创建活动导出任务
+// POST /api/v1/exports
+func (c *Client) CreateExportJob(ctx context.Context, req *types.ActivityExportFilterParams) (*types.ExportJobResp, error) {
+	query := url.Values{}
+	var result types.ExportJobResp
+	if err := c.do(ctx, http.MethodPost, "/exports", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExportJobs 导出任务列表
+// GET /api/v1/exports
+func (c *Client) GetExportJobs(ctx context.Context, filters types.ExportJobFilterParams) (*types.ExportJobsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.ExportJobsResp
+	if err := c.do(ctx, http.MethodGet, "/exports", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetExportJob 导出任务详情及下载链接
+// GET /api/v1/exports/:id
+func (c *Client) GetExportJob(ctx context.Context, id string) (*types.ExportJobResp, error) {
+	query := url.Values{}
+	var result types.ExportJobResp
+	if err := c.do(ctx, http.MethodGet, "/exports/"+url.PathEscape(id), query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFavoriteItems 收藏的 Item 列表
+// GET /api/v1/favorites
+func (c *Client) GetFavoriteItems(ctx context.Context, filters types.FavoriteFilterParams) (*types.FavoriteItemsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.FavoriteItemsResp
+	if err := c.do(ctx, http.MethodGet, "/favorites", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AddFavoriteItem 收藏 Item
+// POST /api/v1/favorites
+func (c *Client) AddFavoriteItem(ctx context.Context, req *types.FavoriteItemReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/favorites", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RemoveFavoriteItem 取消收藏 Item
+// DELETE /api/v1/favorites/:address/:token_id
+func (c *Client) RemoveFavoriteItem(ctx context.Context, address string, tokenID string, chainID int) error {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	return c.do(ctx, http.MethodDelete, "/favorites/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID), query, nil, nil)
+}
+
+// GetWatchlist 关注的集合及实时行情
+// GET /api/v1/watchlist
+func (c *Client) GetWatchlist(ctx context.Context) (*types.WatchlistResp, error) {
+	query := url.Values{}
+	var result types.WatchlistResp
+	if err := c.do(ctx, http.MethodGet, "/watchlist", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// WatchCollection 关注集合
+// POST /api/v1/watchlist
+func (c *Client) WatchCollection(ctx context.Context, req *types.WatchCollectionReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/watchlist", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UnwatchCollection 取消关注集合
+// DELETE /api/v1/watchlist/:address
+func (c *Client) UnwatchCollection(ctx context.Context, address string, chainID int) error {
+	query := url.Values{}
+	setQuery(query, "chain_id", chainID, true)
+	return c.do(ctx, http.MethodDelete, "/watchlist/"+url.PathEscape(address), query, nil, nil)
+}
+
+// GetNotifications 站内通知列表
+// GET /api/v1/notifications
+func (c *Client) GetNotifications(ctx context.Context, filters types.NotificationFilterParams) (*types.NotificationsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.NotificationsResp
+	if err := c.do(ctx, http.MethodGet, "/notifications", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ReadNotifications 标记通知已读
+// POST /api/v1/notifications/read
+func (c *Client) ReadNotifications(ctx context.Context, req *types.NotificationReadReq) (*types.NotificationReadResp, error) {
+	query := url.Values{}
+	var result types.NotificationReadResp
+	if err := c.do(ctx, http.MethodPost, "/notifications/read", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetNotificationPreference 查询通知偏好
+// GET /api/v1/notifications/preferences
+func (c *Client) GetNotificationPreference(ctx context.Context) (*types.NotificationPreferenceResp, error) {
+	query := url.Values{}
+	var result types.NotificationPreferenceResp
+	if err := c.do(ctx, http.MethodGet, "/notifications/preferences", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// UpdateNotificationPreference 更新通知偏好
+// PUT /api/v1/notifications/preferences
+func (c *Client) UpdateNotificationPreference(ctx context.Context, req *types.NotificationPreferenceReq) (*types.NotificationPreferenceResp, error) {
+	query := url.Values{}
+	var result types.NotificationPreferenceResp
+	if err := c.do(ctx, http.MethodPut, "/notifications/preferences", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetFloorAlerts 查询地板价提醒
+// GET /api/v1/notifications/floor-alerts
+func (c *Client) GetFloorAlerts(ctx context.Context) (*types.FloorAlertsResp, error) {
+	query := url.Values{}
+	var result types.FloorAlertsResp
+	if err := c.do(ctx, http.MethodGet, "/notifications/floor-alerts", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// SetFloorAlert 创建/更新地板价提醒
+// POST /api/v1/notifications/floor-alerts
+func (c *Client) SetFloorAlert(ctx context.Context, req *types.FloorAlertReq) (*types.FloorAlertResp, error) {
+	query := url.Values{}
+	var result types.FloorAlertResp
+	if err := c.do(ctx, http.MethodPost, "/notifications/floor-alerts", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeleteFloorAlert 删除地板价提醒
+// DELETE /api/v1/notifications/floor-alerts/:id
+func (c *Client) DeleteFloorAlert(ctx context.Context, id string) error {
+	query := url.Values{}
+	return c.do(ctx, http.MethodDelete, "/notifications/floor-alerts/"+url.PathEscape(id), query, nil, nil)
+}
+
+// GetNotificationDeliveries webhook / 邮件投递记录
+// GET /api/v1/notifications/deliveries
+func (c *Client) GetNotificationDeliveries(ctx context.Context, filters types.NotificationDeliveryFilterParams) (*types.NotificationDeliveriesResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.NotificationDeliveriesResp
+	if err := c.do(ctx, http.MethodGet, "/notifications/deliveries", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateSignedOrder 提交链下签名订单 (上链前为 Pending)
+// POST /api/v1/signed-orders
+func (c *Client) CreateSignedOrder(ctx context.Context, req *types.SignedOrderReq) (*types.SignedOrderResp, error) {
+	query := url.Values{}
+	var result types.SignedOrderResp
+	if err := c.do(ctx, http.MethodPost, "/signed-orders", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CancelSignedOrder 取消链下签名订单
+// POST /api/v1/signed-orders/:order_id/cancel
+func (c *Client) CancelSignedOrder(ctx context.Context, orderID string, req *types.CancelSignedOrderReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/signed-orders/"+url.PathEscape(orderID)+"/cancel", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BuildMakeOrdersTx 构造 makeOrders 交易
+// POST /api/v1/order-txs/make
+func (c *Client) BuildMakeOrdersTx(ctx context.Context, req *types.MakeOrdersTxReq) (*types.UnsignedTxResp, error) {
+	query := url.Values{}
+	var result types.UnsignedTxResp
+	if err := c.do(ctx, http.MethodPost, "/order-txs/make", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BuildCancelOrdersTx 构造 cancelOrders 交易
+// POST /api/v1/order-txs/cancel
+func (c *Client) BuildCancelOrdersTx(ctx context.Context, req *types.CancelOrdersTxReq) (*types.UnsignedTxResp, error) {
+	query := url.Values{}
+	var result types.UnsignedTxResp
+	if err := c.do(ctx, http.MethodPost, "/order-txs/cancel", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BuildEditOrdersTx 构造 editOrders 交易
+// POST /api/v1/order-txs/edit
+func (c *Client) BuildEditOrdersTx(ctx context.Context, req *types.EditOrdersTxReq) (*types.UnsignedTxResp, error) {
+	query := url.Values{}
+	var result types.UnsignedTxResp
+	if err := c.do(ctx, http.MethodPost, "/order-txs/edit", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BuildMatchOrdersTx 构造 matchOrder(s) 交易
+// POST /api/v1/order-txs/match
+func (c *Client) BuildMatchOrdersTx(ctx context.Context, req *types.MatchOrdersTxReq) (*types.UnsignedTxResp, error) {
+	query := url.Values{}
+	var result types.UnsignedTxResp
+	if err := c.do(ctx, http.MethodPost, "/order-txs/match", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetOrderInfos 批量查询 Item 最佳出价
+// GET /api/v1/bid-orders
+func (c *Client) GetOrderInfos(ctx context.Context, filters types.OrderInfosParam) (*types.OrderInfosResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.OrderInfosResp
+	if err := c.do(ctx, http.MethodGet, "/bid-orders", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CreateTraitBid 创建属性出价 (链下签名, 由后端撮合)
+// POST /api/v1/bid-orders/trait
+func (c *Client) CreateTraitBid(ctx context.Context, req *types.TraitBidReq) (*types.TraitBidResp, error) {
+	query := url.Values{}
+	var result types.TraitBidResp
+	if err := c.do(ctx, http.MethodPost, "/bid-orders/trait", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// BuildTraitBidFillTx 撮合属性出价, 构造购买 Item 挂单的 matchOrder 交易
+// POST /api/v1/bid-orders/trait/:order_id/fill
+func (c *Client) BuildTraitBidFillTx(ctx context.Context, orderID string, req *types.TraitBidFillTxReq) (*types.UnsignedTxResp, error) {
+	query := url.Values{}
+	var result types.UnsignedTxResp
+	if err := c.do(ctx, http.MethodPost, "/bid-orders/trait/"+url.PathEscape(orderID)+"/fill", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// CancelTraitBid 取消属性出价
+// POST /api/v1/bid-orders/trait/:order_id/cancel
+func (c *Client) CancelTraitBid(ctx context.Context, orderID string, req *types.CancelTraitBidReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/bid-orders/trait/"+url.PathEscape(orderID)+"/cancel", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminSetCollectionAuth 认证/取消认证集合
+// POST /api/v1/admin/collections/:address/auth
+func (c *Client) AdminSetCollectionAuth(ctx context.Context, address string, req *types.AdminCollectionAuthReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/admin/collections/"+url.PathEscape(address)+"/auth", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminSetCollectionStatus 隐藏/封禁集合
+// POST /api/v1/admin/collections/:address/status
+func (c *Client) AdminSetCollectionStatus(ctx context.Context, address string, req *types.AdminStatusReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/admin/collections/"+url.PathEscape(address)+"/status", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminUpdateCollectionSocials 编辑集合社交信息
+// POST /api/v1/admin/collections/:address/socials
+func (c *Client) AdminUpdateCollectionSocials(ctx context.Context, address string, req *types.AdminCollectionSocialsReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/admin/collections/"+url.PathEscape(address)+"/socials", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminImportCollection 触发集合导入
+// POST /api/v1/admin/collections/:address/import
+func (c *Client) AdminImportCollection(ctx context.Context, address string, req *types.AdminCollectionImportReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/admin/collections/"+url.PathEscape(address)+"/import", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminRefreshCollectionMetadata 全量刷新集合元数据
+// POST /api/v1/admin/collections/:address/metadata
+func (c *Client) AdminRefreshCollectionMetadata(ctx context.Context, address string, req *types.AdminChainReq) (*types.AdminRefreshMetadataResp, error) {
+	query := url.Values{}
+	var result types.AdminRefreshMetadataResp
+	if err := c.do(ctx, http.MethodPost, "/admin/collections/"+url.PathEscape(address)+"/metadata", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminSetItemStatus 隐藏/封禁 Item
+// POST /api/v1/admin/collections/:address/:token_id/status
+func (c *Client) AdminSetItemStatus(ctx context.Context, address string, tokenID string, req *types.AdminStatusReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/admin/collections/"+url.PathEscape(address)+"/"+url.PathEscape(tokenID)+"/status", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminSetUserAllowed 允许/封禁用户
+// POST /api/v1/admin/users/:address/allowed
+func (c *Client) AdminSetUserAllowed(ctx context.Context, address string, req *types.AdminUserAllowedReq) (*types.CommonResp, error) {
+	query := url.Values{}
+	var result types.CommonResp
+	if err := c.do(ctx, http.MethodPost, "/admin/users/"+url.PathEscape(address)+"/allowed", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminGetAuditLogs 查询审计日志
+// GET /api/v1/admin/audit-logs
+func (c *Client) AdminGetAuditLogs(ctx context.Context, filters types.AdminAuditLogFilterParams) (*types.AdminAuditLogsResp, error) {
+	query := url.Values{}
+	if err := setFilters(query, filters); err != nil {
+		return nil, err
+	}
+	var result types.AdminAuditLogsResp
+	if err := c.do(ctx, http.MethodGet, "/admin/audit-logs", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminCreateApiKey 签发合作方 API key
+// POST /api/v1/admin/api-keys
+func (c *Client) AdminCreateApiKey(ctx context.Context, req *types.AdminApiKeyCreateReq) (*types.AdminApiKeyCreateResp, error) {
+	query := url.Values{}
+	var result types.AdminApiKeyCreateResp
+	if err := c.do(ctx, http.MethodPost, "/admin/api-keys", query, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminGetApiKeys 查询 API key 列表
+// GET /api/v1/admin/api-keys
+func (c *Client) AdminGetApiKeys(ctx context.Context) (*types.AdminApiKeysResp, error) {
+	query := url.Values{}
+	var result types.AdminApiKeysResp
+	if err := c.do(ctx, http.MethodGet, "/admin/api-keys", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminRevokeApiKey 撤销 API key
+// POST /api/v1/admin/api-keys/:id/revoke
+func (c *Client) AdminRevokeApiKey(ctx context.Context, id string) (*types.AdminApiKeyRevokeResp, error) {
+	query := url.Values{}
+	var result types.AdminApiKeyRevokeResp
+	if err := c.do(ctx, http.MethodPost, "/admin/api-keys/"+url.PathEscape(id)+"/revoke", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// AdminGetApiKeyUsage 查询 API key 用量
+// GET /api/v1/admin/api-keys/:id/usage
+func (c *Client) AdminGetApiKeyUsage(ctx context.Context, id string, days int) (*types.AdminApiKeyUsageResp, error) {
+	query := url.Values{}
+	setQuery(query, "days", days, false)
+	var result types.AdminApiKeyUsageResp
+	if err := c.do(ctx, http.MethodGet, "/admin/api-keys/"+url.PathEscape(id)+"/usage", query, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
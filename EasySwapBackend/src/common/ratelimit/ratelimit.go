@@ -0,0 +1,94 @@
+// Package ratelimit 基于 Redis 有序集合的滑动窗口限流
+// 每个计数桶对应一个有序集合, 成员为请求, 分值为请求时间 (毫秒);
+// 每次请求先移除窗口外的记录, 窗口内请求数未达配额时记录本次请求.
+// 检查与记录在 Lua 脚本中原子执行, 多实例部署时共享同一计数.
+package ratelimit
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/pkg/errors"
+)
+
+const keyPrefix = "cache:es:ratelimit"
+
+// slidingWindowScript 返回 {是否放行, 窗口内请求数, 最早请求移出窗口的时间(毫秒)}
+const slidingWindowScript = `local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], 0, now - window)
+local count = redis.call('ZCARD', KEYS[1])
+local allowed = 0
+if count < limit then
+    redis.call('ZADD', KEYS[1], now, ARGV[4])
+    count = count + 1
+    allowed = 1
+end
+redis.call('PEXPIRE', KEYS[1], window)
+local reset = now + window
+local oldest = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+if oldest[2] then
+    reset = tonumber(oldest[2]) + window
+end
+return {allowed, count, reset}`
+
+// Result 限流检查结果
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAt    time.Time     // 窗口内最早的请求移出窗口的时间, 届时至少释放一个配额
+	RetryAfter time.Duration // 被拒绝时距下一次可请求的时间
+}
+
+// Limiter 滑动窗口限流器
+type Limiter struct {
+	kv     *xkv.Store
+	window time.Duration
+}
+
+// New 创建限流器, window 为滑动窗口长度
+func New(kv *xkv.Store, window time.Duration) *Limiter {
+	return &Limiter{kv: kv, window: window}
+}
+
+// Allow 检查 bucket 在当前窗口内是否还有配额, 有则计入本次请求
+func (l *Limiter) Allow(bucket string, limit int) (*Result, error) {
+	now := time.Now()
+	nowMs := now.UnixMilli()
+	member := fmt.Sprintf("%d-%d", nowMs, rand.Int63())
+
+	resp, err := l.kv.Eval(slidingWindowScript, Key(bucket), nowMs, l.window.Milliseconds(), limit, member)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on eval rate limit script")
+	}
+	values, ok := resp.([]interface{})
+	if !ok || len(values) != 3 {
+		return nil, errors.Errorf("unexpected rate limit script result: %v", resp)
+	}
+	allowed, _ := values[0].(int64)
+	count, _ := values[1].(int64)
+	resetMs, _ := values[2].(int64)
+
+	res := &Result{
+		Allowed:   allowed == 1,
+		Limit:     limit,
+		Remaining: limit - int(count),
+		ResetAt:   time.UnixMilli(resetMs),
+	}
+	if res.Remaining < 0 {
+		res.Remaining = 0
+	}
+	if !res.Allowed {
+		res.RetryAfter = res.ResetAt.Sub(now)
+	}
+	return res, nil
+}
+
+// Key 计数桶对应的 Redis key
+func Key(bucket string) string {
+	return keyPrefix + ":" + bucket
+}
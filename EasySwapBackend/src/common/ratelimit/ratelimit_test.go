@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func newTestStore(t *testing.T) *xkv.Store {
+	mr := miniredis.RunT(t)
+	return xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+}
+
+func TestAllowWithinLimit(t *testing.T) {
+	l := New(newTestStore(t), time.Minute)
+
+	for i := 1; i <= 3; i++ {
+		res, err := l.Allow("ip:1.2.3.4", 3)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, 3-i, res.Remaining)
+	}
+
+	res, err := l.Allow("ip:1.2.3.4", 3)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+	assert.Equal(t, 0, res.Remaining)
+	assert.Greater(t, res.RetryAfter, time.Duration(0))
+	assert.LessOrEqual(t, res.RetryAfter, time.Minute)
+
+	// 不同计数桶互不影响
+	res, err = l.Allow("ip:5.6.7.8", 3)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
+
+func TestAllowSlidingWindow(t *testing.T) {
+	window := 200 * time.Millisecond
+	l := New(newTestStore(t), window)
+
+	res, err := l.Allow("user:0xabc", 1)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+	res, err = l.Allow("user:0xabc", 1)
+	require.NoError(t, err)
+	assert.False(t, res.Allowed)
+
+	// 被拒绝的请求不计入窗口, 最早的请求移出窗口后恢复配额
+	time.Sleep(window + 50*time.Millisecond)
+	res, err = l.Allow("user:0xabc", 1)
+	require.NoError(t, err)
+	assert.True(t, res.Allowed)
+}
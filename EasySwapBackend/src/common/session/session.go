@@ -0,0 +1,443 @@
+// Package session 基于 JWT 的登录会话管理
+// access token 短期有效, 用于接口鉴权; refresh token 长期有效, 用于换取新的 token 对.
+// 每次登录创建一个会话 (对应一个设备), 会话登记在 Redis 中, 删除会话即可撤销该设备的所有 token.
+// 签名密钥支持轮换: 新 token 使用 active_kid 对应的密钥签名, 校验时按 token 头部的 kid 查找密钥.
+package session
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+)
+
+const (
+	sessionKeyPrefix      = "cache:es:session:data"
+	userSessionsKeyPrefix = "cache:es:session:user"
+	blockedKeyPrefix      = "cache:es:session:blocked"
+
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+
+	defaultIssuer     = "easyswap"
+	defaultAccessTTL  = 15 * 60
+	defaultRefreshTTL = 30 * 24 * 60 * 60
+	minSecretLength   = 32
+)
+
+// rotateScript 原子地校验并轮换会话的 refresh token, 避免并发刷新时同一 refresh token 换取多个 token 对
+// ARGV: 当前 refresh token 的 jti, 轮换后的会话数据, 过期时间 (秒)
+// 返回 1: 已轮换; 0: 会话不存在; -1: refresh token 已失效
+const rotateScript = `local data = redis.call('GET', KEYS[1])
+if not data then
+    return 0
+end
+local s = cjson.decode(data)
+if s.refresh_id ~= ARGV[1] then
+    return -1
+end
+redis.call('SET', KEYS[1], ARGV[2], 'EX', tonumber(ARGV[3]))
+return 1`
+
+var (
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrSessionRevoked = errors.New("session is revoked")
+	ErrRefreshReused  = errors.New("refresh token is reused")
+	ErrUserBlocked    = errors.New("user is blocked")
+)
+
+// Claims token 载荷, Subject 为用户地址 (小写)
+type Claims struct {
+	jwt.RegisteredClaims
+	SessionID string `json:"sid"`
+	Type      string `json:"typ"`
+}
+
+// Session 登录会话 (设备)
+type Session struct {
+	ID            string `json:"id"`
+	Address       string `json:"address"`
+	UserAgent     string `json:"user_agent"`
+	IP            string `json:"ip"`
+	CreatedAt     int64  `json:"created_at"`
+	LastRefreshAt int64  `json:"last_refresh_at"`
+	ExpiresAt     int64  `json:"expires_at"`
+	RefreshID     string `json:"refresh_id"` // 当前有效 refresh token 的 jti, 旧 refresh token 重用时撤销会话
+}
+
+// TokenPair 登录/刷新返回的 token 对
+type TokenPair struct {
+	SessionID        string
+	AccessToken      string
+	AccessExpiresAt  int64
+	RefreshToken     string
+	RefreshExpiresAt int64
+}
+
+// Manager 会话管理
+type Manager struct {
+	kv         *xkv.Store
+	issuer     string
+	activeKid  string
+	keys       map[string][]byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewManager 创建会话管理器
+func NewManager(kv *xkv.Store, cfg *config.Session) (*Manager, error) {
+	if cfg == nil || len(cfg.Keys) == 0 {
+		return nil, errors.New("session keys are not configured")
+	}
+
+	m := &Manager{
+		kv:         kv,
+		issuer:     cfg.Issuer,
+		activeKid:  cfg.ActiveKid,
+		keys:       make(map[string][]byte, len(cfg.Keys)),
+		accessTTL:  time.Duration(cfg.AccessTTL) * time.Second,
+		refreshTTL: time.Duration(cfg.RefreshTTL) * time.Second,
+	}
+	for _, key := range cfg.Keys {
+		if key.Kid == "" || len(key.Secret) < minSecretLength {
+			return nil, errors.Errorf("session key %q must have a kid and a secret of at least %d bytes", key.Kid, minSecretLength)
+		}
+		m.keys[key.Kid] = []byte(key.Secret)
+	}
+	if _, ok := m.keys[m.activeKid]; !ok {
+		return nil, errors.Errorf("active session key %q is not configured", m.activeKid)
+	}
+	if m.issuer == "" {
+		m.issuer = defaultIssuer
+	}
+	if m.accessTTL <= 0 {
+		m.accessTTL = defaultAccessTTL * time.Second
+	}
+	if m.refreshTTL <= 0 {
+		m.refreshTTL = defaultRefreshTTL * time.Second
+	}
+	return m, nil
+}
+
+// Create 创建会话并签发 token 对
+func (m *Manager) Create(address, userAgent, ip string) (*TokenPair, error) {
+	if err := m.checkBlocked(address); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	s := &Session{
+		ID:        uuid.NewString(),
+		Address:   strings.ToLower(address),
+		UserAgent: userAgent,
+		IP:        ip,
+		CreatedAt: now.Unix(),
+	}
+	pair, err := m.issue(s, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.save(s); err != nil {
+		return nil, err
+	}
+	if err := m.register(s); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// Verify 校验 access token 并确认会话未被撤销、用户未被封禁
+func (m *Manager) Verify(accessToken string) (*Claims, error) {
+	claims, err := m.parse(accessToken, TokenTypeAccess)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkBlocked(claims.Subject); err != nil {
+		return nil, err
+	}
+	if _, err := m.get(claims.SessionID); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Parse 仅校验 access token 签名和有效期, 不查询会话是否已撤销
+// 用于限流等只需识别请求方身份的场景, 鉴权必须使用 Verify
+func (m *Manager) Parse(accessToken string) (*Claims, error) {
+	return m.parse(accessToken, TokenTypeAccess)
+}
+
+// Refresh 使用 refresh token 换取新的 token 对, 旧 refresh token 随即失效
+// 已失效的 refresh token 再次使用说明可能被盗用, 直接撤销整个会话
+func (m *Manager) Refresh(refreshToken string) (*TokenPair, error) {
+	claims, err := m.parse(refreshToken, TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkBlocked(claims.Subject); err != nil {
+		return nil, err
+	}
+	s, err := m.get(claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if s.RefreshID != claims.ID {
+		if err := m.Revoke(s.Address, s.ID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshReused
+	}
+
+	now := time.Now()
+	s.LastRefreshAt = now.Unix()
+	pair, err := m.issue(s, now)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on encode session")
+	}
+
+	// 读取后会话可能已被并发请求轮换, 校验与保存需原子执行
+	resp, err := m.kv.Eval(rotateScript, sessionKey(s.ID), claims.ID, string(data), int(m.refreshTTL.Seconds()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on rotate session")
+	}
+	switch code, _ := resp.(int64); code {
+	case 1:
+		// 会话数据与用户会话索引可能不在同一 Redis 节点, 轮换后单独续期索引
+		if err := m.register(s); err != nil {
+			return nil, err
+		}
+		return pair, nil
+	case 0:
+		return nil, ErrSessionRevoked
+	default:
+		if err := m.Revoke(s.Address, s.ID); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshReused
+	}
+}
+
+// List 查询用户的有效会话, 按创建时间倒序
+func (m *Manager) List(address string) ([]*Session, error) {
+	userKey := userSessionsKey(address)
+	ids, err := m.kv.Smembers(userKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on list sessions")
+	}
+
+	sessions := make([]*Session, 0, len(ids))
+	for _, id := range ids {
+		s, err := m.get(id)
+		if errors.Is(err, ErrSessionRevoked) {
+			// 会话已过期, 清理索引
+			_, _ = m.kv.Srem(userKey, id)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt > sessions[j].CreatedAt
+	})
+	return sessions, nil
+}
+
+// Revoke 撤销用户的指定会话
+func (m *Manager) Revoke(address, sessionID string) error {
+	s, err := m.get(sessionID)
+	if err != nil && !errors.Is(err, ErrSessionRevoked) {
+		return err
+	}
+	if s != nil && s.Address != strings.ToLower(address) {
+		return ErrSessionRevoked
+	}
+
+	if _, err := m.kv.Del(sessionKey(sessionID)); err != nil {
+		return errors.Wrap(err, "failed on revoke session")
+	}
+	if _, err := m.kv.Srem(userSessionsKey(address), sessionID); err != nil {
+		return errors.Wrap(err, "failed on revoke session")
+	}
+	return nil
+}
+
+// RevokeAll 撤销用户的所有会话
+func (m *Manager) RevokeAll(address string) error {
+	userKey := userSessionsKey(address)
+	ids, err := m.kv.Smembers(userKey)
+	if err != nil {
+		return errors.Wrap(err, "failed on list sessions")
+	}
+
+	keys := make([]string, 0, len(ids)+1)
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	keys = append(keys, userKey)
+	if _, err := m.kv.Del(keys...); err != nil {
+		return errors.Wrap(err, "failed on revoke sessions")
+	}
+	return nil
+}
+
+// Block 封禁用户并撤销其所有会话, 封禁期间不能创建、刷新会话, 已签发的 access token 校验失败
+// 先写入封禁标记再撤销会话, 与之并发创建的会话也会在校验时被拒绝
+func (m *Manager) Block(address string) error {
+	if err := m.kv.Set(blockedKey(address), "1"); err != nil {
+		return errors.Wrap(err, "failed on block user")
+	}
+	return m.RevokeAll(address)
+}
+
+// Unblock 解除用户封禁
+func (m *Manager) Unblock(address string) error {
+	if _, err := m.kv.Del(blockedKey(address)); err != nil {
+		return errors.Wrap(err, "failed on unblock user")
+	}
+	return nil
+}
+
+func (m *Manager) checkBlocked(address string) error {
+	blocked, err := m.kv.Exists(blockedKey(address))
+	if err != nil {
+		return errors.Wrap(err, "failed on get user blocked")
+	}
+	if blocked {
+		return ErrUserBlocked
+	}
+	return nil
+}
+
+// issue 为会话签发新的 token 对, 并更新会话的 refresh token 及过期时间
+func (m *Manager) issue(s *Session, now time.Time) (*TokenPair, error) {
+	accessExpiresAt := now.Add(m.accessTTL)
+	accessToken, err := m.sign(s, TokenTypeAccess, uuid.NewString(), now, accessExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshID := uuid.NewString()
+	refreshExpiresAt := now.Add(m.refreshTTL)
+	refreshToken, err := m.sign(s, TokenTypeRefresh, refreshID, now, refreshExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	s.RefreshID = refreshID
+	s.ExpiresAt = refreshExpiresAt.Unix()
+	return &TokenPair{
+		SessionID:        s.ID,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt.Unix(),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt.Unix(),
+	}, nil
+}
+
+func (m *Manager) sign(s *Session, tokenType, jti string, now, expiresAt time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   s.Address,
+			ID:        jti,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		SessionID: s.ID,
+		Type:      tokenType,
+	})
+	token.Header["kid"] = m.activeKid
+
+	signed, err := token.SignedString(m.keys[m.activeKid])
+	if err != nil {
+		return "", errors.Wrap(err, "failed on sign token")
+	}
+	return signed, nil
+}
+
+func (m *Manager) parse(tokenString, tokenType string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, ErrInvalidToken
+		}
+		kid, _ := token.Header["kid"].(string)
+		key, ok := m.keys[kid]
+		if !ok {
+			return nil, ErrInvalidToken
+		}
+		return key, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+	if claims.Type != tokenType || claims.Issuer != m.issuer || claims.SessionID == "" || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+func (m *Manager) get(sessionID string) (*Session, error) {
+	data, err := m.kv.Get(sessionKey(sessionID))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get session")
+	}
+	if data == "" {
+		return nil, ErrSessionRevoked
+	}
+
+	var s Session
+	if err := json.Unmarshal([]byte(data), &s); err != nil {
+		return nil, errors.Wrap(err, "failed on decode session")
+	}
+	return &s, nil
+}
+
+// register 将会话登记到用户会话索引并续期索引, 保证索引不早于其中的会话过期, List/RevokeAll 可以找到所有有效会话
+func (m *Manager) register(s *Session) error {
+	userKey := userSessionsKey(s.Address)
+	if _, err := m.kv.Sadd(userKey, s.ID); err != nil {
+		return errors.Wrap(err, "failed on register session")
+	}
+	if err := m.kv.Expire(userKey, int(m.refreshTTL.Seconds())); err != nil {
+		return errors.Wrap(err, "failed on register session")
+	}
+	return nil
+}
+
+func (m *Manager) save(s *Session) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return errors.Wrap(err, "failed on encode session")
+	}
+	if err := m.kv.Setex(sessionKey(s.ID), string(data), int(m.refreshTTL.Seconds())); err != nil {
+		return errors.Wrap(err, "failed on save session")
+	}
+	return nil
+}
+
+func sessionKey(sessionID string) string {
+	return sessionKeyPrefix + ":" + sessionID
+}
+
+func userSessionsKey(address string) string {
+	return userSessionsKeyPrefix + ":" + strings.ToLower(address)
+}
+
+func blockedKey(address string) string {
+	return blockedKeyPrefix + ":" + strings.ToLower(address)
+}
@@ -0,0 +1,140 @@
+package session
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+)
+
+func newTestManager(t *testing.T) *Manager {
+	m, _ := newTestManagerWithRedis(t, 0)
+	return m
+}
+
+func newTestManagerWithRedis(t *testing.T, refreshTTL int64) (*Manager, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	kv := xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+	m, err := NewManager(kv, &config.Session{
+		ActiveKid:  "k1",
+		Keys:       []*config.SessionKey{{Kid: "k1", Secret: "0123456789abcdef0123456789abcdef"}},
+		RefreshTTL: refreshTTL,
+	})
+	require.NoError(t, err)
+	return m, mr
+}
+
+func TestRefreshRotatesToken(t *testing.T) {
+	m := newTestManager(t)
+	pair, err := m.Create("0xABC", "ua", "127.0.0.1")
+	require.NoError(t, err)
+
+	next, err := m.Refresh(pair.RefreshToken)
+	require.NoError(t, err)
+	assert.Equal(t, pair.SessionID, next.SessionID)
+
+	claims, err := m.Verify(next.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc", claims.Subject)
+
+	// 旧 refresh token 重用时撤销整个会话
+	_, err = m.Refresh(pair.RefreshToken)
+	assert.ErrorIs(t, err, ErrRefreshReused)
+	_, err = m.Verify(next.AccessToken)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+}
+
+func TestRefreshConcurrentSingleWinner(t *testing.T) {
+	m := newTestManager(t)
+	pair, err := m.Create("0xabc", "ua", "127.0.0.1")
+	require.NoError(t, err)
+
+	const n = 8
+	var wg sync.WaitGroup
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = m.Refresh(pair.RefreshToken)
+		}(i)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, err := range errs {
+		if err == nil {
+			succeeded++
+		}
+	}
+	assert.Equal(t, 1, succeeded)
+}
+
+func TestRefreshRevokedSession(t *testing.T) {
+	m := newTestManager(t)
+	pair, err := m.Create("0xabc", "ua", "127.0.0.1")
+	require.NoError(t, err)
+	require.NoError(t, m.Revoke("0xabc", pair.SessionID))
+
+	_, err = m.Refresh(pair.RefreshToken)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+}
+
+func TestBlockRejectsSessions(t *testing.T) {
+	m := newTestManager(t)
+	pair, err := m.Create("0xabc", "ua", "127.0.0.1")
+	require.NoError(t, err)
+
+	require.NoError(t, m.Block("0xABC"))
+	_, err = m.Verify(pair.AccessToken)
+	assert.ErrorIs(t, err, ErrUserBlocked)
+	_, err = m.Refresh(pair.RefreshToken)
+	assert.ErrorIs(t, err, ErrUserBlocked)
+	_, err = m.Create("0xabc", "ua", "127.0.0.1")
+	assert.ErrorIs(t, err, ErrUserBlocked)
+
+	// 解除封禁后需重新登录, 封禁时撤销的会话不会恢复
+	require.NoError(t, m.Unblock("0xabc"))
+	_, err = m.Verify(pair.AccessToken)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+	next, err := m.Create("0xabc", "ua", "127.0.0.1")
+	require.NoError(t, err)
+	_, err = m.Verify(next.AccessToken)
+	assert.NoError(t, err)
+}
+
+func TestRefreshExtendsSessionIndex(t *testing.T) {
+	m, mr := newTestManagerWithRedis(t, 100)
+	pair, err := m.Create("0xabc", "ua", "127.0.0.1")
+	require.NoError(t, err)
+
+	// 持续刷新的会话超过创建时索引的过期时间后仍在索引中
+	mr.FastForward(60 * time.Second)
+	pair, err = m.Refresh(pair.RefreshToken)
+	require.NoError(t, err)
+	mr.FastForward(60 * time.Second)
+	pair, err = m.Refresh(pair.RefreshToken)
+	require.NoError(t, err)
+
+	sessions, err := m.List("0xabc")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, pair.SessionID, sessions[0].ID)
+
+	require.NoError(t, m.RevokeAll("0xabc"))
+	_, err = m.Verify(pair.AccessToken)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+	_, err = m.Refresh(pair.RefreshToken)
+	assert.ErrorIs(t, err, ErrSessionRevoked)
+}
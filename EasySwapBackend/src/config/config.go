@@ -7,6 +7,7 @@ import (
 	//"github.com/ProjectsTask/EasySwapBase/image"
 	logging "github.com/ProjectsTask/EasySwapBase/logger"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
 	"github.com/spf13/viper"
 )
 
@@ -20,6 +21,14 @@ type Config struct {
 	Evm            *erc.NftErc       `toml:"evm" json:"evm"`                                                        // EVM 节点配置
 	MetadataParse  *MetadataParse    `toml:"metadata_parse" mapstructure:"metadata_parse" json:"metadata_parse"`    // 元数据解析规则
 	ChainSupported []*ChainSupported `toml:"chain_supported" mapstructure:"chain_supported" json:"chain_supported"` // 支持的链列表
+	EasySwapMarket *EasySwapMarket   `toml:"easyswap_market" mapstructure:"easyswap_market" json:"easyswap_market"` // 订单簿合约配置(EIP-712 签名域)
+	Siwe           *Siwe             `toml:"siwe" mapstructure:"siwe" json:"siwe"`                                  // 钱包登录配置(EIP-4361)
+	Session        *Session          `toml:"session" mapstructure:"session" json:"session"`                         // 登录会话 token 配置
+	RateLimit      *RateLimit        `toml:"rate_limit" mapstructure:"rate_limit" json:"rate_limit"`                // 接口限流配置
+	Grpc           *Grpc             `toml:"grpc" mapstructure:"grpc" json:"grpc"`                                  // 市场只读 gRPC 接口配置
+	Metrics        *Metrics          `toml:"metrics" mapstructure:"metrics" json:"metrics"`                         // Prometheus 指标配置
+	Trace          *xtrace.Config    `toml:"trace" mapstructure:"trace" json:"trace"`                               // OpenTelemetry 链路追踪配置
+	Export         *Export           `toml:"export" mapstructure:"export" json:"export"`                            // 活动导出配置
 }
 
 type ProjectCfg struct {
@@ -29,6 +38,9 @@ type ProjectCfg struct {
 type Api struct {
 	Port   string `toml:"port" json:"port"`
 	MaxNum int64  `toml:"max_num" json:"max_num"`
+	// 可信反向代理的 IP 或 CIDR, 仅来自这些地址的请求才读取 X-Forwarded-For 等头部确定客户端 IP;
+	// 为空时不信任任何代理, 客户端 IP 即连接的远端地址
+	TrustedProxies []string `toml:"trusted_proxies" mapstructure:"trusted_proxies" json:"trusted_proxies"`
 }
 
 type KvConf struct {
@@ -56,6 +68,87 @@ type ChainSupported struct {
 	Endpoint string `toml:"endpoint" mapstructure:"endpoint" json:"endpoint"`
 }
 
+// EasySwapMarket 订单簿合约配置
+// Name/Version 需与合约 initialize 时传入的 EIP712Name/EIP712Version 一致
+type EasySwapMarket struct {
+	Name     string `toml:"name" mapstructure:"name" json:"name"`
+	Version  string `toml:"version" mapstructure:"version" json:"version"`
+	Contract string `toml:"contract" mapstructure:"contract" json:"contract"` // 订单簿合约地址 (verifyingContract)
+	Vault    string `toml:"vault" mapstructure:"vault" json:"vault"`          // Vault 合约地址, 卖单需授权给 Vault
+	Fee      int64  `toml:"fee" mapstructure:"fee" json:"fee"`                // 协议手续费率, 单位万分之一 (100 = 1%), 仅在尚未同步到链上费率记录时使用
+}
+
+// Siwe Sign-In with Ethereum 登录消息配置
+// Domain/URI 需与前端站点一致, 钱包会据此提示用户防止钓鱼
+type Siwe struct {
+	Domain        string `toml:"domain" mapstructure:"domain" json:"domain"`
+	URI           string `toml:"uri" mapstructure:"uri" json:"uri"`
+	Statement     string `toml:"statement" mapstructure:"statement" json:"statement"`
+	ExpireSeconds int64  `toml:"expire_seconds" mapstructure:"expire_seconds" json:"expire_seconds"` // 登录消息有效期 (秒)
+}
+
+// Session 登录会话 token 配置
+// 轮换密钥时先新增 key 并切换 active_kid, 旧 key 保留至其签发的 refresh token 全部过期后再删除
+type Session struct {
+	Issuer     string        `toml:"issuer" mapstructure:"issuer" json:"issuer"`
+	ActiveKid  string        `toml:"active_kid" mapstructure:"active_kid" json:"active_kid"` // 签发新 token 使用的密钥 ID
+	Keys       []*SessionKey `toml:"keys" mapstructure:"keys" json:"keys"`
+	AccessTTL  int64         `toml:"access_ttl" mapstructure:"access_ttl" json:"access_ttl"`    // access token 有效期 (秒)
+	RefreshTTL int64         `toml:"refresh_ttl" mapstructure:"refresh_ttl" json:"refresh_ttl"` // refresh token 及会话有效期 (秒)
+}
+
+// SessionKey token 签名密钥 (HS256), secret 不少于 32 字节
+type SessionKey struct {
+	Kid    string `toml:"kid" mapstructure:"kid" json:"kid"`
+	Secret string `toml:"secret" mapstructure:"secret" json:"secret"`
+}
+
+// RateLimit 接口限流配置 (滑动窗口)
+// 按请求方身份分别计数: 携带 API key 按 key, 已登录按用户地址, 否则按 IP.
+// 配置了 routes 的接口使用独立的计数桶和配额, 其余接口共用默认配额.
+type RateLimit struct {
+	Enable      bool              `toml:"enable" mapstructure:"enable" json:"enable"`
+	Window      int64             `toml:"window" mapstructure:"window" json:"window"`                      // 滑动窗口长度 (秒)
+	IPLimit     int               `toml:"ip_limit" mapstructure:"ip_limit" json:"ip_limit"`                // 匿名请求每个窗口的配额 (按 IP)
+	UserLimit   int               `toml:"user_limit" mapstructure:"user_limit" json:"user_limit"`          // 登录用户每个窗口的配额 (按地址)
+	ApiKeyLimit int               `toml:"api_key_limit" mapstructure:"api_key_limit" json:"api_key_limit"` // API key 每个窗口的默认配额
+	Routes      []*RouteRateLimit `toml:"routes" mapstructure:"routes" json:"routes"`
+}
+
+// RouteRateLimit 单个接口的限流配额, path 为 gin 路由模板, 如 /api/v1/collections/:address/items
+// API key 在该接口的配额按 key 自身配额与默认 API key 配额的比例缩放
+type RouteRateLimit struct {
+	Method      string `toml:"method" mapstructure:"method" json:"method"`
+	Path        string `toml:"path" mapstructure:"path" json:"path"`
+	IPLimit     int    `toml:"ip_limit" mapstructure:"ip_limit" json:"ip_limit"`
+	UserLimit   int    `toml:"user_limit" mapstructure:"user_limit" json:"user_limit"`
+	ApiKeyLimit int    `toml:"api_key_limit" mapstructure:"api_key_limit" json:"api_key_limit"`
+}
+
+// Grpc 市场只读 gRPC 接口配置, 未配置 port 时不启动 gRPC 服务
+// 请求需在 metadata x-api-key 中携带有效 API key, 开启限流时按 key 的配额计数
+type Grpc struct {
+	Port           string `toml:"port" mapstructure:"port" json:"port"`
+	StreamInterval int64  `toml:"stream_interval" mapstructure:"stream_interval" json:"stream_interval"` // 活动推送轮询间隔 (秒)
+}
+
+// Metrics Prometheus 指标配置, 指标使用独立端口暴露, 未配置 port 时不开启
+type Metrics struct {
+	Port string `toml:"port" mapstructure:"port" json:"port"`
+}
+
+// Export 活动导出配置
+// 超过 sync_max_rows 行的导出需创建异步任务, 由后台任务分批读取并分块写入数据库, 未开启 enabled 时不启动异步任务.
+// 导出文件保存在各实例共享的数据库中, 任一实例均可领取任务及提供下载.
+type Export struct {
+	Enabled     bool  `toml:"enabled" mapstructure:"enabled" json:"enabled"`                   // 是否开启异步导出任务
+	Interval    int64 `toml:"interval" mapstructure:"interval" json:"interval"`                // 任务轮询间隔 (秒)
+	BatchSize   int   `toml:"batch_size" mapstructure:"batch_size" json:"batch_size"`          // 每批读取的行数
+	SyncMaxRows int64 `toml:"sync_max_rows" mapstructure:"sync_max_rows" json:"sync_max_rows"` // 同步流式导出允许的最大行数
+	FileTTL     int64 `toml:"file_ttl" mapstructure:"file_ttl" json:"file_ttl"`                // 导出文件保留时长 (秒)
+	MaxAttempts int   `toml:"max_attempts" mapstructure:"max_attempts" json:"max_attempts"`    // 任务最多领取执行的次数, 超过后标记为失败
+}
+
 // UnmarshalConfig unmarshal conifg file
 // @params path: the path of config dir
 func UnmarshalConfig(configFilePath string) (*Config, error) {
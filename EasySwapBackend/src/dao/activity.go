@@ -31,6 +31,9 @@ var eventTypesToID = map[string]int{
 	"item_bid":              multi.ItemBid,
 	"cancel_collection_bid": multi.CancelCollectionBid,
 	"cancel_item_bid":       multi.CancelItemBid,
+	"edit_list":             multi.EditListing,
+	"edit_collection_bid":   multi.EditCollectionBid,
+	"edit_item_bid":         multi.EditItemBid,
 }
 
 var idToEventTypes = map[int]string{
@@ -46,6 +49,9 @@ var idToEventTypes = map[int]string{
 	multi.ItemBid:             "item_bid",
 	multi.CancelCollectionBid: "cancel_collection_bid",
 	multi.CancelItemBid:       "cancel_item_bid",
+	multi.EditListing:         "edit_list",
+	multi.EditCollectionBid:   "edit_collection_bid",
+	multi.EditItemBid:         "edit_item_bid",
 }
 
 type ActivityCountCache struct {
@@ -115,7 +121,7 @@ func (d *Dao) QueryMultiChainActivities(ctx context.Context, chainName []string,
 			sqlMid += "UNION ALL "
 		}
 		// 子查询: 选择需要的字段，并固定 chain_name
-		sqlMid += fmt.Sprintf("(select '%s' as chain_name,id,collection_address,token_id,currency_address,activity_type,maker,taker,price,tx_hash,event_time,marketplace_id ", chain)
+		sqlMid += fmt.Sprintf("(select '%s' as chain_name,id,collection_address,token_id,currency_address,activity_type,maker,taker,price,prev_price,tx_hash,event_time,marketplace_id ", chain)
 		sqlMid += fmt.Sprintf("from %s ", multi.ActivityTableName(chain))
 
 		// 2.3 添加 UserAddress 过滤 (针对 Maker 或 Taker)
@@ -185,7 +191,7 @@ func (d *Dao) QueryMultiChainActivities(ctx context.Context, chainName []string,
 
 	// 5. 执行主查询
 	sql := sqlHead + sqlMid + sqlTail
-	if err := d.DB.Raw(sql).Scan(&activities).Error; err != nil {
+	if err := d.DB.WithContext(ctx).Raw(sql).Scan(&activities).Error; err != nil {
 		return nil, 0, errors.Wrap(err, "failed on query activity")
 	}
 
@@ -206,7 +212,7 @@ func (d *Dao) QueryMultiChainActivities(ctx context.Context, chainName []string,
 	}
 
 	// 6.2 尝试从缓存读取
-	strNum, err := d.KvStore.Get(cacheKey)
+	strNum, err := d.KvStore.GetCtx(ctx, cacheKey)
 	if err != nil {
 		return nil, 0, errors.Wrap(err, "failed on get activity number from cache")
 	}
@@ -224,12 +230,12 @@ func (d *Dao) QueryMultiChainActivities(ctx context.Context, chainName []string,
 		// 这里假设 DB 能够处理或者 sqlTail 不包含 Limit (其实包含了).
 		// NOTE: 原始代码逻辑似乎直接拼上了 sqlTail (含 Limit), 这会导致 Count 结果也是 PageSize.
 		// 但修改业务逻辑风险较高, 此次仅可以做注释说明.
-		if err := d.DB.Raw(sqlCnt).Scan(&total).Error; err != nil {
+		if err := d.DB.WithContext(ctx).Raw(sqlCnt).Scan(&total).Error; err != nil {
 			return nil, 0, errors.Wrap(err, "failed on count activity")
 		}
 
 		// 写入缓存 (TTL 30s)
-		if err := d.KvStore.Setex(cacheKey, strconv.FormatInt(total, 10), 30); err != nil {
+		if err := d.KvStore.SetexCtx(ctx, cacheKey, strconv.FormatInt(total, 10), 30); err != nil {
 			return nil, 0, errors.Wrap(err, "failed on cache activities number")
 		}
 	}
@@ -387,6 +393,7 @@ func (d *Dao) QueryMultiChainActivityExternalInfo(ctx context.Context, chainID [
 			TokenID:           act.TokenId,
 			Currency:          act.CurrencyAddress,
 			Price:             act.Price,
+			PrevPrice:         act.PrevPrice,
 			Maker:             act.Maker,
 			Taker:             act.Taker,
 			TxHash:            act.TxHash,
@@ -0,0 +1,194 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// GetUserRole 查询用户角色, 用户不存在时返回普通用户角色
+func (d *Dao) GetUserRole(ctx context.Context, userAddr string) (string, error) {
+	var user base.User
+	if err := d.DB.WithContext(ctx).Table(base.UserTableName()).
+		Select("id, role").
+		Where("address = ?", userAddr).
+		Find(&user).Error; err != nil {
+		return "", errors.Wrap(err, "failed on get user role")
+	}
+	return user.Role, nil
+}
+
+// withAuditLog 在同一事务中执行管理操作并写入审计日志
+// 操作失败时不会留下审计记录, 审计日志写入失败时操作回滚
+func (d *Dao) withAuditLog(ctx context.Context, log *base.AdminAuditLog, fn func(tx *gorm.DB) error) error {
+	return d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := fn(tx); err != nil {
+			return err
+		}
+		if err := tx.Table(base.AdminAuditLogTableName()).Create(log).Error; err != nil {
+			return errors.Wrap(err, "failed on create admin audit log")
+		}
+		return nil
+	})
+}
+
+// UpdateCollectionByAdmin 更新集合字段 (认证状态/展示状态/社交信息) 并记录审计日志
+func (d *Dao) UpdateCollectionByAdmin(ctx context.Context, chain, collectionAddr string, fields map[string]interface{}, log *base.AdminAuditLog) error {
+	return d.withAuditLog(ctx, log, func(tx *gorm.DB) error {
+		result := tx.Table(multi.CollectionTableName(chain)).
+			Where("address = ?", collectionAddr).
+			Updates(fields)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed on update collection")
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// UpdateItemStatusByAdmin 更新 Item 展示状态并记录审计日志
+func (d *Dao) UpdateItemStatusByAdmin(ctx context.Context, chain, collectionAddr, tokenID string, status int, log *base.AdminAuditLog) error {
+	return d.withAuditLog(ctx, log, func(tx *gorm.DB) error {
+		result := tx.Table(multi.ItemTableName(chain)).
+			Where("collection_address = ? and token_id = ?", collectionAddr, tokenID).
+			Updates(map[string]interface{}{
+				"status":      status,
+				"update_time": time.Now().UnixMilli(),
+			})
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed on update item status")
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	})
+}
+
+// UpdateUserAllowedByAdmin 设置用户是否允许访问并记录审计日志
+// 用户尚未登录过时直接创建用户记录, 以便提前封禁
+func (d *Dao) UpdateUserAllowedByAdmin(ctx context.Context, userAddr string, allowed bool, log *base.AdminAuditLog) error {
+	return d.withAuditLog(ctx, log, func(tx *gorm.DB) error {
+		now := time.Now().UnixMilli()
+		user := base.User{
+			Address:    userAddr,
+			IsAllowed:  allowed,
+			CreateTime: now,
+			UpdateTime: now,
+		}
+		if err := tx.Table(base.UserTableName()).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"is_allowed", "update_time"}),
+		}).Create(&user).Error; err != nil {
+			return errors.Wrap(err, "failed on update user allowed")
+		}
+		return nil
+	})
+}
+
+// CreateCollectionImportByAdmin 将集合加入导入任务并记录审计日志
+// 导入服务消费 import_status 为等待导入的全局集合, 并通过导入记录上报进度
+func (d *Dao) CreateCollectionImportByAdmin(ctx context.Context, chain, collectionAddr string, tokenStandard int64, log *base.AdminAuditLog) error {
+	return d.withAuditLog(ctx, log, func(tx *gorm.DB) error {
+		var global multi.GlobalCollection
+		if err := tx.Table(multi.GlobalCollectionTableName(chain)).
+			Where("collection_address = ?", collectionAddr).
+			Find(&global).Error; err != nil {
+			return errors.Wrap(err, "failed on get global collection")
+		}
+
+		if global.Id == 0 {
+			global = multi.GlobalCollection{
+				CollectionAddress: collectionAddr,
+				TokenStandard:     tokenStandard,
+				ImportStatus:      multi.ImportStatusWaiting,
+			}
+			if err := tx.Table(multi.GlobalCollectionTableName(chain)).Create(&global).Error; err != nil {
+				return errors.Wrap(err, "failed on create global collection")
+			}
+		} else if err := tx.Table(multi.GlobalCollectionTableName(chain)).
+			Where("id = ?", global.Id).
+			Updates(map[string]interface{}{
+				"import_status": multi.ImportStatusWaiting,
+				"update_time":   time.Now().UnixMilli(),
+			}).Error; err != nil {
+			return errors.Wrap(err, "failed on update global collection")
+		}
+
+		record := multi.CollectionImportRecord{CollectionAddress: collectionAddr}
+		if err := tx.Table(multi.CollectionImportRecordTableName(chain)).Create(&record).Error; err != nil {
+			return errors.Wrap(err, "failed on create collection import record")
+		}
+		return nil
+	})
+}
+
+// QueryCollectionTokenIDs 查询集合下所有 Item 的 TokenID
+// 按主键游标分批读取, 用于全量刷新元数据
+func (d *Dao) QueryCollectionTokenIDs(ctx context.Context, chain, collectionAddr string) ([]string, error) {
+	var tokenIDs []string
+	cursor := int64(0)
+	for {
+		var items []multi.Item
+		if err := d.DB.WithContext(ctx).Table(multi.ItemTableName(chain)).
+			Select("id, token_id").
+			Where("collection_address = ? and id > ?", collectionAddr, cursor).
+			Order("id asc").
+			Limit(MaxBatchReadCollections).
+			Scan(&items).Error; err != nil {
+			return nil, errors.Wrap(err, "failed on get collection token ids")
+		}
+
+		for _, item := range items {
+			tokenIDs = append(tokenIDs, item.TokenId)
+		}
+		if len(items) < MaxBatchReadCollections {
+			break
+		}
+		cursor = items[len(items)-1].Id
+	}
+	return tokenIDs, nil
+}
+
+// CreateAdminAuditLog 单独写入审计日志, 用于不涉及数据库修改的管理操作 (如刷新元数据)
+func (d *Dao) CreateAdminAuditLog(ctx context.Context, log *base.AdminAuditLog) error {
+	if err := d.DB.WithContext(ctx).Table(base.AdminAuditLogTableName()).Create(log).Error; err != nil {
+		return errors.Wrap(err, "failed on create admin audit log")
+	}
+	return nil
+}
+
+// QueryAdminAuditLogs 分页查询审计日志, 按时间倒序
+func (d *Dao) QueryAdminAuditLogs(ctx context.Context, adminAddr, targetType, target string, page, pageSize int) ([]base.AdminAuditLog, int64, error) {
+	db := d.DB.WithContext(ctx).Table(base.AdminAuditLogTableName())
+	if adminAddr != "" {
+		db.Where("admin_address = ?", adminAddr)
+	}
+	if targetType != "" {
+		db.Where("target_type = ?", targetType)
+	}
+	if target != "" {
+		db.Where("target = ?", target)
+	}
+
+	var count int64
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on count admin audit logs")
+	}
+
+	var logs []base.AdminAuditLog
+	if err := db.Order("id desc").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&logs).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on get admin audit logs")
+	}
+	return logs, count, nil
+}
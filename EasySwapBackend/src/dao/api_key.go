@@ -0,0 +1,151 @@
+package dao
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	apiKeyCacheKeyPrefix = "cache:es:apikey:info"
+	apiKeyCacheSeconds   = 60 // 撤销时主动删除缓存, 过期时间仅用于兜底
+
+	apiKeyUsageKeyPrefix = "cache:es:apikey:usage"
+	apiKeyUsageSeconds   = 90 * 24 * 60 * 60 // 用量统计保留 90 天
+
+	// ApiKeyUsageTotal/ApiKeyUsageRejected 用量统计中的汇总字段, 其余字段为 "METHOD 路由"
+	ApiKeyUsageTotal    = "total"
+	ApiKeyUsageRejected = "rejected"
+)
+
+// HashApiKey 计算 API key 的 sha256 摘要, 数据库中只保存摘要
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// GetActiveApiKey 根据明文 key 查询有效的 API key, 不存在或已撤销时返回 nil
+// 查询结果 (包括不存在) 缓存在 Redis 中, 避免每个请求查询数据库
+func (d *Dao) GetActiveApiKey(ctx context.Context, key string) (*base.ApiKey, error) {
+	hash := HashApiKey(key)
+	cacheKey := apiKeyCacheKey(hash)
+
+	var apiKey base.ApiKey
+	ok, err := d.KvStore.Read(cacheKey, &apiKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on read api key cache")
+	}
+	if !ok {
+		if err := d.DB.WithContext(ctx).Table(base.ApiKeyTableName()).
+			Where("key_hash = ?", hash).
+			Find(&apiKey).Error; err != nil {
+			return nil, errors.Wrap(err, "failed on get api key")
+		}
+		_ = d.KvStore.Write(cacheKey, &apiKey, apiKeyCacheSeconds)
+	}
+
+	if apiKey.Id == 0 || apiKey.Status != base.ApiKeyStatusActive {
+		return nil, nil
+	}
+	return &apiKey, nil
+}
+
+// CreateApiKeyByAdmin 签发 API key 并记录审计日志
+func (d *Dao) CreateApiKeyByAdmin(ctx context.Context, apiKey *base.ApiKey, log *base.AdminAuditLog) error {
+	return d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(base.ApiKeyTableName()).Create(apiKey).Error; err != nil {
+			return errors.Wrap(err, "failed on create api key")
+		}
+		// 审计日志目标为 key ID, 创建后才能确定
+		log.Target = fmt.Sprintf("%d", apiKey.Id)
+		if err := tx.Table(base.AdminAuditLogTableName()).Create(log).Error; err != nil {
+			return errors.Wrap(err, "failed on create admin audit log")
+		}
+		return nil
+	})
+}
+
+// RevokeApiKeyByAdmin 撤销 API key 并记录审计日志, 同时删除 key 缓存使其立即失效
+func (d *Dao) RevokeApiKeyByAdmin(ctx context.Context, id int64, log *base.AdminAuditLog) error {
+	var apiKey base.ApiKey
+	err := d.withAuditLog(ctx, log, func(tx *gorm.DB) error {
+		if err := tx.Table(base.ApiKeyTableName()).
+			Where("id = ?", id).
+			First(&apiKey).Error; err != nil {
+			return err
+		}
+		if err := tx.Table(base.ApiKeyTableName()).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"status":      base.ApiKeyStatusRevoked,
+				"update_time": time.Now().UnixMilli(),
+			}).Error; err != nil {
+			return errors.Wrap(err, "failed on revoke api key")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.KvStore.Del(apiKeyCacheKey(apiKey.KeyHash)); err != nil {
+		return errors.Wrap(err, "failed on delete api key cache")
+	}
+	return nil
+}
+
+// QueryApiKeys 查询所有 API key, 按创建时间倒序
+func (d *Dao) QueryApiKeys(ctx context.Context) ([]base.ApiKey, error) {
+	var apiKeys []base.ApiKey
+	if err := d.DB.WithContext(ctx).Table(base.ApiKeyTableName()).
+		Order("id desc").
+		Find(&apiKeys).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get api keys")
+	}
+	return apiKeys, nil
+}
+
+// IncrApiKeyUsage 按天累计 API key 的请求数, route 为 "METHOD 路由"
+func (d *Dao) IncrApiKeyUsage(apiKeyID int64, route string, rejected bool) error {
+	key := apiKeyUsageKey(apiKeyID, time.Now())
+	if _, err := d.KvStore.Hincrby(key, ApiKeyUsageTotal, 1); err != nil {
+		return errors.Wrap(err, "failed on incr api key usage")
+	}
+	field := route
+	if rejected {
+		field = ApiKeyUsageRejected
+	}
+	if _, err := d.KvStore.Hincrby(key, field, 1); err != nil {
+		return errors.Wrap(err, "failed on incr api key usage")
+	}
+	return d.KvStore.Expire(key, apiKeyUsageSeconds)
+}
+
+// QueryApiKeyUsage 查询 API key 最近 days 天 (含当天) 的用量, key 为日期 (yyyymmdd)
+func (d *Dao) QueryApiKeyUsage(apiKeyID int64, days int) (map[string]map[string]string, error) {
+	usage := make(map[string]map[string]string, days)
+	now := time.Now()
+	for i := 0; i < days; i++ {
+		day := now.AddDate(0, 0, -i)
+		fields, err := d.KvStore.Hgetall(apiKeyUsageKey(apiKeyID, day))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get api key usage")
+		}
+		usage[day.UTC().Format("20060102")] = fields
+	}
+	return usage, nil
+}
+
+func apiKeyCacheKey(hash string) string {
+	return apiKeyCacheKeyPrefix + ":" + hash
+}
+
+func apiKeyUsageKey(apiKeyID int64, day time.Time) string {
+	return fmt.Sprintf("%s:%d:%s", apiKeyUsageKeyPrefix, apiKeyID, day.UTC().Format("20060102"))
+}
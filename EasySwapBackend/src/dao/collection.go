@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ProjectsTask/EasySwapBase/kit/convert"
 	"github.com/ProjectsTask/EasySwapBase/ordermanager"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
 	"github.com/pkg/errors"
@@ -18,7 +19,7 @@ const MaxBatchReadCollections = 500
 const MaxRetries = 3
 const QueryTimeout = time.Second * 30
 
-var collectionFields = []string{"id", "chain_id", "token_standard", "name", "address", "image_uri", "floor_price", "sale_price", "item_amount", "owner_amount"}
+var collectionFields = []string{"id", "chain_id", "token_standard", "name", "address", "image_uri", "floor_price", "sale_price", "item_amount", "owner_amount", "status"}
 
 // QueryHistorySalesPriceInfo 查询指定时间段内的NFT销售历史价格信息
 // 功能: 用于绘制价格走势图或计算近期均价
@@ -446,10 +447,11 @@ func (d *Dao) QueryCollectionsListed(ctx context.Context, chain string, collecti
 	}
 
 	for _, address := range collectionAddrs {
-		count, err := d.KvStore.GetInt(ordermanager.GenCollectionListedKey(chain, address))
+		value, err := d.KvStore.GetCtx(ctx, ordermanager.GenCollectionListedKey(chain, address))
 		if err != nil {
 			return nil, errors.Wrap(err, "failed on set collection listed count")
 		}
+		count := convert.ToInt(value)
 		collectionsListed = append(collectionsListed, types.CollectionListed{
 			CollectionAddr: address,
 			Count:          count,
@@ -527,39 +529,63 @@ func GetHoldersCountKey(chain string) string {
 // 返回:
 //   - map[string]float64: CollectionAddr -> ChangeRate (小数格式, e.g. 0.05 = 5%)
 func (d *Dao) QueryCollectionFloorChange(chain string, timeDiff int64) (map[string]float64, error) {
-	collectionFloorChange := make(map[string]float64)
+	return d.queryCollectionFloorChange(chain, timeDiff, nil)
+}
+
+// QueryCollectionsFloorChange 查询指定集合的地板价涨跌幅, 只扫描给定集合的地板价记录
+func (d *Dao) QueryCollectionsFloorChange(chain string, timeDiff int64, collectionAddrs []string) (map[string]float64, error) {
+	if len(collectionAddrs) == 0 {
+		return map[string]float64{}, nil
+	}
+	return d.queryCollectionFloorChange(chain, timeDiff, collectionAddrs)
+}
+
+// queryCollectionFloorChange 查询集合地板价涨跌幅, collectionAddrs 为空时查询全部集合
+func (d *Dao) queryCollectionFloorChange(chain string, timeDiff int64, collectionAddrs []string) (map[string]float64, error) {
 	var collectionPrices []multi.CollectionFloorPrice
 
 	// SQL 逻辑分析:
 	// 目标: 获取每个 Collection 的「最新价格」和「指定的历史价格」
 	// 实现方式: UNION 两个子查询结果，并按时间倒序排列
-	// 1. 子查询 A: 获取全量集合的最新一条 FloorPrice 记录 (GROUP BY address MAX(event_time))
-	// 2. 子查询 B: 获取全量集合在 T-timeDiff 之前的最新一条记录 (作为历史锚点)
+	// 1. 子查询 A: 获取集合的最新一条 FloorPrice 记录 (GROUP BY address MAX(event_time))
+	// 2. 子查询 B: 获取集合在 T-timeDiff 之前的最新一条记录 (作为历史锚点)
 	// 结果: 每个 Collection Address 会有 1~2 条记录. 排序后第一条是最新, 第二条是历史.
+	latestFilter, historyFilter := "", "WHERE event_time <= UNIX_TIMESTAMP() - ?"
+	args := []interface{}{timeDiff}
+	if len(collectionAddrs) > 0 {
+		latestFilter = "WHERE collection_address in (?)"
+		historyFilter += " AND collection_address in (?)"
+		args = []interface{}{collectionAddrs, timeDiff, collectionAddrs}
+	}
 	rawSql := fmt.Sprintf(`SELECT collection_address, price, event_time 
 		FROM %s 
 		WHERE (collection_address, event_time) IN (
 			SELECT collection_address, MAX(event_time)
 			FROM %s
+			%s
 			GROUP BY collection_address
 		) OR (collection_address, event_time) IN (
 			SELECT collection_address, MAX(event_time)
 			FROM %s 
-			WHERE event_time <= UNIX_TIMESTAMP() - ? 
+			%s 
 			GROUP BY collection_address
 		) 
 		ORDER BY collection_address,event_time DESC`,
 		multi.CollectionFloorPriceTableName(chain),
-		multi.CollectionFloorPriceTableName(chain),
-		multi.CollectionFloorPriceTableName(chain))
+		multi.CollectionFloorPriceTableName(chain), latestFilter,
+		multi.CollectionFloorPriceTableName(chain), historyFilter)
 
-	if err := d.DB.Raw(rawSql, timeDiff).Scan(&collectionPrices).Error; err != nil {
+	if err := d.DB.Raw(rawSql, args...).Scan(&collectionPrices).Error; err != nil {
 		return nil, errors.Wrap(err, "failed on get collection floor change")
 	}
 
-	// 遍历计算涨跌幅
-	// 由于 SQL 已经按 Address, Time DESC 排序
-	// 相同的 Address 的记录会相邻, 且最新在前, 历史在后
+	return floorChangeRates(collectionPrices), nil
+}
+
+// floorChangeRates 根据按 (collection_address, event_time DESC) 排序的地板价记录计算涨跌幅
+// 相同 Address 的记录相邻, 且最新在前, 历史在后; 没有历史记录的集合涨跌幅视为 0
+func floorChangeRates(collectionPrices []multi.CollectionFloorPrice) map[string]float64 {
+	collectionFloorChange := make(map[string]float64)
 	for i := 0; i < len(collectionPrices); i++ {
 		// 检查是否有配对的历史记录 (当前是最新, 下一条是同一集合的历史记录)
 		if i < len(collectionPrices)-1 &&
@@ -581,7 +607,7 @@ func (d *Dao) QueryCollectionFloorChange(chain string, timeDiff int64) (map[stri
 		}
 	}
 
-	return collectionFloorChange, nil
+	return collectionFloorChange
 }
 
 // QueryCollectionsSellPrice 查询批量集合的 Collection Offer 最高价 (Buy Order)
@@ -0,0 +1,307 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// ActivityExportFilter 活动导出过滤条件, 地址需已转为小写
+type ActivityExportFilter struct {
+	CollectionAddrs []string
+	TokenID         string
+	UserAddrs       []string // 作为 Maker 或 Taker
+	EventTypes      []int
+	StartTime       int64
+	EndTime         int64 // 0 表示不限
+}
+
+// ActivityEventTypeID 将事件类型名称转换为活动类型
+func ActivityEventTypeID(eventType string) (int, bool) {
+	id, ok := eventTypesToID[eventType]
+	return id, ok
+}
+
+// ActivityEventTypeName 将活动类型转换为事件类型名称, 未知类型返回 unknown
+func ActivityEventTypeName(activityType int) string {
+	if eventType, ok := idToEventTypes[activityType]; ok {
+		return eventType
+	}
+	return "unknown"
+}
+
+func (d *Dao) activityExportQuery(ctx context.Context, chain string, filter *ActivityExportFilter) *gorm.DB {
+	db := d.DB.WithContext(ctx).Table(multi.ActivityTableName(chain))
+	if len(filter.UserAddrs) > 0 {
+		db = db.Where("(maker in (?) or taker in (?))", filter.UserAddrs, filter.UserAddrs)
+	}
+	if len(filter.CollectionAddrs) > 0 {
+		db = db.Where("collection_address in (?)", filter.CollectionAddrs)
+	}
+	if filter.TokenID != "" {
+		db = db.Where("token_id = ?", filter.TokenID)
+	}
+	if len(filter.EventTypes) > 0 {
+		db = db.Where("activity_type in (?)", filter.EventTypes)
+	}
+	if filter.StartTime > 0 {
+		db = db.Where("event_time >= ?", filter.StartTime)
+	}
+	if filter.EndTime > 0 {
+		db = db.Where("event_time <= ?", filter.EndTime)
+	}
+	return db
+}
+
+// CountExportActivities 统计满足导出条件的活动数
+func (d *Dao) CountExportActivities(ctx context.Context, chain string, filter *ActivityExportFilter) (int64, error) {
+	var count int64
+	if err := d.activityExportQuery(ctx, chain, filter).Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on count export activities")
+	}
+	return count, nil
+}
+
+// QueryExportActivities 按 id 游标分批查询满足导出条件的活动, 返回 id 大于 afterID 的至多 limit 条, 按 id 升序
+// 每批为独立的短查询, 导出大量数据时不会长时间占用数据库连接
+func (d *Dao) QueryExportActivities(ctx context.Context, chain string, filter *ActivityExportFilter, afterID int64, limit int) ([]multi.Activity, error) {
+	var activities []multi.Activity
+	if err := d.activityExportQuery(ctx, chain, filter).
+		Select("id, activity_type, maker, taker, marketplace_id, collection_address, token_id, currency_address, price, block_number, tx_hash, event_time").
+		Where("id > ?", afterID).
+		Order("id asc").
+		Limit(limit).
+		Scan(&activities).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get export activities")
+	}
+	return activities, nil
+}
+
+// CreateExportJob 创建导出任务
+func (d *Dao) CreateExportJob(ctx context.Context, job *base.ExportJob) error {
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).Create(job).Error; err != nil {
+		return errors.Wrap(err, "failed on create export job")
+	}
+	return nil
+}
+
+// CountUserActiveExportJobs 统计用户等待或执行中的导出任务数
+func (d *Dao) CountUserActiveExportJobs(ctx context.Context, userAddr string) (int64, error) {
+	var count int64
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("user_address = ? and status in (?)", userAddr,
+			[]int{base.ExportJobStatusPending, base.ExportJobStatusRunning}).
+		Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on count active export jobs")
+	}
+	return count, nil
+}
+
+// QueryUserExportJobs 分页查询用户的导出任务, 按创建时间倒序
+func (d *Dao) QueryUserExportJobs(ctx context.Context, userAddr string, page, pageSize int) ([]base.ExportJob, int64, error) {
+	db := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("user_address = ?", userAddr)
+
+	var count int64
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on count export jobs")
+	}
+
+	var jobs []base.ExportJob
+	if err := db.Order("id desc").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&jobs).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on get export jobs")
+	}
+	return jobs, count, nil
+}
+
+// QueryUserExportJob 查询用户的指定导出任务, 不存在时返回 nil
+func (d *Dao) QueryUserExportJob(ctx context.Context, userAddr string, id int64) (*base.ExportJob, error) {
+	var jobs []base.ExportJob
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("id = ? and user_address = ?", id, userAddr).
+		Limit(1).
+		Find(&jobs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get export job")
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return &jobs[0], nil
+}
+
+// QueryExportJobByToken 按下载凭证查询已完成的导出任务, 不存在时返回 nil
+func (d *Dao) QueryExportJobByToken(ctx context.Context, token string) (*base.ExportJob, error) {
+	var jobs []base.ExportJob
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("download_token = ? and status = ?", token, base.ExportJobStatusDone).
+		Limit(1).
+		Find(&jobs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get export job by token")
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return &jobs[0], nil
+}
+
+// ErrExportJobLost 任务租约已过期并被其他实例重新领取, 当前执行应放弃
+var ErrExportJobLost = errors.New("export job claimed by another worker")
+
+// ClaimExportJob 领取一个待执行的导出任务, 没有任务时返回 nil
+// 执行中的任务超过 lease 秒没有进度时视为执行实例已退出, 领取次数未达 maxAttempts 时可被重新领取;
+// 多个实例同时领取时通过条件更新保证只有一个成功, 领取成功后 attempts 加 1
+func (d *Dao) ClaimExportJob(ctx context.Context, lease int64, maxAttempts int) (*base.ExportJob, error) {
+	now := time.Now().UnixMilli()
+	var jobs []base.ExportJob
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("status = ? or (status = ? and update_time < ? and attempts < ?)",
+			base.ExportJobStatusPending, base.ExportJobStatusRunning, now-lease*1000, maxAttempts).
+		Order("id asc").
+		Limit(1).
+		Find(&jobs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get pending export job")
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+
+	job := jobs[0]
+	result := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("id = ? and status = ? and update_time = ?", job.Id, job.Status, job.UpdateTime).
+		Updates(map[string]interface{}{
+			"status":      base.ExportJobStatusRunning,
+			"rows":        0,
+			"attempts":    gorm.Expr("attempts + 1"),
+			"update_time": now,
+		})
+	if result.Error != nil {
+		return nil, errors.Wrap(result.Error, "failed on claim export job")
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+	job.Status = base.ExportJobStatusRunning
+	job.Attempts++
+	job.UpdateTime = now
+	return &job, nil
+}
+
+// FailExhaustedExportJobs 将租约已过期且领取次数达到 maxAttempts 的任务标记为失败, 不再重新领取
+func (d *Dao) FailExhaustedExportJobs(ctx context.Context, lease int64, maxAttempts int) (int64, error) {
+	now := time.Now().UnixMilli()
+	result := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("status = ? and update_time < ? and attempts >= ?", base.ExportJobStatusRunning, now-lease*1000, maxAttempts).
+		Updates(map[string]interface{}{
+			"status":      base.ExportJobStatusFailed,
+			"last_error":  "exceeded max attempts",
+			"update_time": now,
+		})
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, "failed on fail exhausted export jobs")
+	}
+	return result.RowsAffected, nil
+}
+
+// UpdateClaimedExportJob 更新当前执行持有的任务, 同时续期执行租约
+// 任务已被其他实例重新领取 (attempts 变化) 或不再是执行中时返回 ErrExportJobLost
+func (d *Dao) UpdateClaimedExportJob(ctx context.Context, job *base.ExportJob, fields map[string]interface{}) error {
+	fields["update_time"] = time.Now().UnixMilli()
+	db := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("id = ? and status = ? and attempts = ?", job.Id, base.ExportJobStatusRunning, job.Attempts)
+	result := db.Session(&gorm.Session{}).Updates(fields)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on update export job")
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	// 字段值未变化时影响行数同样为 0, 再确认任务是否仍由当前执行持有
+	var count int64
+	if err := db.Count(&count).Error; err != nil {
+		return errors.Wrap(err, "failed on check export job")
+	}
+	if count == 0 {
+		return ErrExportJobLost
+	}
+	return nil
+}
+
+// ReleaseExportJob 服务退出时归还执行中的任务, 恢复为等待状态且不计入领取次数
+func (d *Dao) ReleaseExportJob(ctx context.Context, job *base.ExportJob) error {
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("id = ? and status = ? and attempts = ?", job.Id, base.ExportJobStatusRunning, job.Attempts).
+		Updates(map[string]interface{}{
+			"status":      base.ExportJobStatusPending,
+			"rows":        0,
+			"attempts":    gorm.Expr("attempts - 1"),
+			"update_time": time.Now().UnixMilli(),
+		}).Error; err != nil {
+		return errors.Wrap(err, "failed on release export job")
+	}
+	return nil
+}
+
+// CreateExportFileChunk 保存导出文件分块
+func (d *Dao) CreateExportFileChunk(ctx context.Context, chunk *base.ExportFileChunk) error {
+	if err := d.DB.WithContext(ctx).Table(base.ExportFileChunkTableName()).Create(chunk).Error; err != nil {
+		return errors.Wrap(err, "failed on create export file chunk")
+	}
+	return nil
+}
+
+// QueryExportFileChunk 查询导出文件的指定分块, 不存在时返回 nil
+func (d *Dao) QueryExportFileChunk(ctx context.Context, jobID int64, attempt, seq int) (*base.ExportFileChunk, error) {
+	var chunks []base.ExportFileChunk
+	if err := d.DB.WithContext(ctx).Table(base.ExportFileChunkTableName()).
+		Where("job_id = ? and attempt = ? and seq = ?", jobID, attempt, seq).
+		Limit(1).
+		Find(&chunks).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get export file chunk")
+	}
+	if len(chunks) == 0 {
+		return nil, nil
+	}
+	return &chunks[0], nil
+}
+
+// DeleteExportFileChunks 删除任务第 attempt 次及之前执行生成的文件分块
+func (d *Dao) DeleteExportFileChunks(ctx context.Context, jobID int64, attempt int) error {
+	if err := d.DB.WithContext(ctx).Table(base.ExportFileChunkTableName()).
+		Where("job_id = ? and attempt <= ?", jobID, attempt).
+		Delete(&base.ExportFileChunk{}).Error; err != nil {
+		return errors.Wrap(err, "failed on delete export file chunks")
+	}
+	return nil
+}
+
+// UpdateExportJob 更新导出任务的状态或进度, 同时刷新 update_time 以续期执行租约
+func (d *Dao) UpdateExportJob(ctx context.Context, id int64, fields map[string]interface{}) error {
+	fields["update_time"] = time.Now().UnixMilli()
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("id = ?", id).
+		Updates(fields).Error; err != nil {
+		return errors.Wrap(err, "failed on update export job")
+	}
+	return nil
+}
+
+// QueryExpiredExportJobs 查询文件已过期但尚未清理的导出任务
+func (d *Dao) QueryExpiredExportJobs(ctx context.Context, now int64, limit int) ([]base.ExportJob, error) {
+	var jobs []base.ExportJob
+	if err := d.DB.WithContext(ctx).Table(base.ExportJobTableName()).
+		Where("status = ? and expire_time < ?", base.ExportJobStatusDone, now).
+		Order("id asc").
+		Limit(limit).
+		Scan(&jobs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get expired export jobs")
+	}
+	return jobs, nil
+}
@@ -0,0 +1,96 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimExportJobAttemptCap(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `ob_export_job` WHERE status = ? or (status = ? and update_time < ? and attempts < ?) ORDER BY id asc LIMIT 1")).
+		WithArgs(base.ExportJobStatusPending, base.ExportJobStatusRunning, sqlmock.AnyArg(), 3).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "attempts", "update_time"}).
+			AddRow(7, base.ExportJobStatusRunning, 1, 100))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_export_job` SET `attempts`=attempts + 1,`rows`=?,`status`=?,`update_time`=? WHERE id = ? and status = ? and update_time = ?")).
+		WithArgs(0, base.ExportJobStatusRunning, sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	job, err := d.ClaimExportJob(context.Background(), 300, 3)
+	require.NoError(t, err)
+	require.NotNil(t, job)
+	assert.Equal(t, int64(7), job.Id)
+	assert.Equal(t, 2, job.Attempts)
+	assert.Equal(t, base.ExportJobStatusRunning, job.Status)
+
+	// 领取次数用尽的任务不再领取, 标记为失败
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_export_job` SET `last_error`=?,`status`=?,`update_time`=? WHERE status = ? and update_time < ? and attempts >= ?")).
+		WithArgs("exceeded max attempts", base.ExportJobStatusFailed, sqlmock.AnyArg(), base.ExportJobStatusRunning, sqlmock.AnyArg(), 3).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	count, err := d.FailExhaustedExportJobs(context.Background(), 300, 3)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateClaimedExportJobLost(t *testing.T) {
+	d, mock := newMockDao(t)
+	job := &base.ExportJob{Id: 7, Attempts: 2}
+	update := regexp.QuoteMeta("UPDATE `ob_export_job` SET `rows`=?,`update_time`=? WHERE id = ? and status = ? and attempts = ?")
+	count := regexp.QuoteMeta("SELECT count(*) FROM `ob_export_job` WHERE id = ? and status = ? and attempts = ?")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(update).
+		WithArgs(int64(10), sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, d.UpdateClaimedExportJob(context.Background(), job, map[string]interface{}{"rows": int64(10)}))
+
+	// 任务已被重新领取
+	mock.ExpectBegin()
+	mock.ExpectExec(update).
+		WithArgs(int64(20), sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(count).
+		WithArgs(int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+	err := d.UpdateClaimedExportJob(context.Background(), job, map[string]interface{}{"rows": int64(20)})
+	assert.ErrorIs(t, err, ErrExportJobLost)
+
+	// 字段值未变化时仍由当前执行持有
+	mock.ExpectBegin()
+	mock.ExpectExec(update).
+		WithArgs(int64(20), sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(count).
+		WithArgs(int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+	require.NoError(t, d.UpdateClaimedExportJob(context.Background(), job, map[string]interface{}{"rows": int64(20)}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReleaseExportJob(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_export_job` SET `attempts`=attempts - 1,`rows`=?,`status`=?,`update_time`=? WHERE id = ? and status = ? and attempts = ?")).
+		WithArgs(0, base.ExportJobStatusPending, sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, d.ReleaseExportJob(context.Background(), &base.ExportJob{Id: 7, Attempts: 2}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/pkg/errors"
+)
+
+// QueryIndexedStatus 查询各链指定类型的索引进度, 返回 chain_id -> 进度
+func (d *Dao) QueryIndexedStatus(ctx context.Context, indexType int) (map[int]base.IndexedStatus, error) {
+	var statuses []base.IndexedStatus
+	if err := d.DB.WithContext(ctx).Table(base.IndexedStatusTableName()).
+		Where("index_type = ?", indexType).
+		Find(&statuses).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query indexed status")
+	}
+
+	result := make(map[int]base.IndexedStatus, len(statuses))
+	for _, status := range statuses {
+		result[status.ChainId] = status
+	}
+	return result, nil
+}
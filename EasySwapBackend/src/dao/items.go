@@ -26,8 +26,13 @@ const (
 	listPriceDesc = 2
 	salePriceDesc = 3
 	salePriceAsc  = 4
+	rarityAsc     = 5
+	rarityDesc    = 6
 )
 
+// itemRarityFields item 稀有度相关字段
+const itemRarityFields = "ci.rarity_score as rarity_score, ci.rarity_rank as rarity_rank, ci.trait_count_rank as trait_count_rank, "
+
 type CollectionItem struct {
 	multi.Item
 	MarketID       int    `json:"market_id"`
@@ -45,6 +50,7 @@ type CollectionItem struct {
 // 统计维度: 按价格(Price)分组统计
 // 统计指标:
 //   - size: 该价格下的挂单总份数 (quantity_remaining)
+//   - filled: 该价格下有效出价已成交的份数 (size - quantity_remaining)
 //   - total: 该价格下的总资金规模 (size * price)
 //   - bidders: 该价格下的独立出价人数 (count distinct maker)
 func (d *Dao) QueryCollectionBids(ctx context.Context, chain string, collectionAddr string, page, pageSize int) ([]types.CollectionBids, int64, error) {
@@ -73,6 +79,7 @@ func (d *Dao) QueryCollectionBids(ctx context.Context, chain string, collectionA
 	// 注意: quantity_remaining > 0 确保只统计还有效的余额
 	if err := db.Select(`
 			sum(quantity_remaining) AS size, 
+			sum(size - quantity_remaining) AS filled,
 			price,
 			sum(quantity_remaining)*price as total,
 			COUNT(DISTINCT maker) AS bidders`).
@@ -119,6 +126,7 @@ func (d *Dao) QueryCollectionItemOrder(ctx context.Context, chain string, filter
 			"ci.id as id, ci.chain_id as chain_id, " +
 				"ci.collection_address as collection_address,ci.token_id as token_id, " +
 				"ci.name as name, ci.owner as owner, " +
+				itemRarityFields +
 				"min(co.price) as list_price, " + // 最低挂单价
 				"SUBSTRING_INDEX(GROUP_CONCAT(co.marketplace_id ORDER BY co.price,co.marketplace_id),',', 1) AS market_id, " +
 				"min(co.price) != 0 as listing") // 如果有价格则标记 listing=true
@@ -187,6 +195,7 @@ func (d *Dao) QueryCollectionItemOrder(ctx context.Context, chain string, filter
 			"ci.id as id, ci.chain_id as chain_id," +
 				"ci.collection_address as collection_address,ci.token_id as token_id, " +
 				"ci.name as name, ci.owner as owner, " +
+				itemRarityFields +
 				"min(co.price) as list_price, " +
 				"SUBSTRING_INDEX(GROUP_CONCAT(co.marketplace_id ORDER BY co.price,co.marketplace_id),',', 1) AS market_id")
 
@@ -259,6 +268,7 @@ func (d *Dao) QueryCollectionItemOrder(ctx context.Context, chain string, filter
 				"ci.id as id, ci.chain_id as chain_id," +
 					"ci.collection_address as collection_address, ci.token_id as token_id, " +
 					"ci.name as name, ci.owner as owner, " +
+					itemRarityFields +
 					"co.list_price as list_price, co.market_id as market_id, co.listing as listing").
 			Where(fmt.Sprintf("ci.collection_address = '%s'", collectionAddr))
 
@@ -268,8 +278,53 @@ func (d *Dao) QueryCollectionItemOrder(ctx context.Context, chain string, filter
 		if filter.UserAddress != "" {
 			db.Where(fmt.Sprintf("ci.owner = '%s'", filter.UserAddress))
 		}
+
+		// 价格区间过滤: 仅保留挂单价格在区间内的 Item
+		if filter.MinPrice.IsPositive() {
+			db.Where("co.list_price >= ?", filter.MinPrice)
+		}
+		if filter.MaxPrice.IsPositive() {
+			db.Where("co.list_price <= ?", filter.MaxPrice)
+		}
+	}
+
+	// 聚合查询的价格区间过滤: 只作用于最低挂单价, 不作用于 Offer 价格
+	if len(filter.Status) == 1 && filter.Status[0] == BuyNow {
+		if filter.MinPrice.IsPositive() {
+			db.Having("min(co.price) >= ?", filter.MinPrice)
+		}
+		if filter.MaxPrice.IsPositive() {
+			db.Having("min(co.price) <= ?", filter.MaxPrice)
+		}
+	} else if len(filter.Status) == 1 && filter.Status[0] == HasOffer {
+		// co 为 Offer 单, 通过子查询取 Item 当前持有者的最低挂单价
+		listPrice := fmt.Sprintf("(select min(lo.price) from %s lo where lo.collection_address = ci.collection_address "+
+			"and lo.token_id = ci.token_id and lo.order_type = %d and lo.order_status = %d and lo.maker = ci.owner)",
+			coTableName, multi.ListingOrder, multi.OrderStatusActive)
+		if filter.MinPrice.IsPositive() {
+			db.Where(listPrice+" >= ?", filter.MinPrice)
+		}
+		if filter.MaxPrice.IsPositive() {
+			db.Where(listPrice+" <= ?", filter.MaxPrice)
+		}
+	} else if len(filter.Status) == 2 {
+		// co 同时包含 Listing 和 Offer, 只取 Listing 的最低价
+		if filter.MinPrice.IsPositive() {
+			db.Having("min(case when co.order_type = ? then co.price end) >= ?", multi.ListingOrder, filter.MinPrice)
+		}
+		if filter.MaxPrice.IsPositive() {
+			db.Having("min(case when co.order_type = ? then co.price end) <= ?", multi.ListingOrder, filter.MaxPrice)
+		}
 	}
 
+	// 属性过滤
+	d.applyItemTraitFilter(ctx, db, chain, collectionAddr, filter.Traits)
+
+	// 隐藏或封禁的 Item 不在列表中展示, 封禁集合不展示任何 Item
+	db.Where("ci.status = ?", multi.ItemStatusNormal)
+	db.Where(fmt.Sprintf("not exists (select 1 from %s c where c.address = ? and c.status = ?)", multi.CollectionTableName(chain)),
+		collectionAddr, multi.CollectionStatusBanned)
+
 	// -------------------------------------------------------------
 	// 统计总数 (Count)
 	// -------------------------------------------------------------
@@ -311,6 +366,11 @@ func (d *Dao) QueryCollectionItemOrder(ctx context.Context, chain string, filter
 		db.Order("sale_price desc,ci.id asc")
 	case salePriceAsc:
 		db.Order("sale_price = 0,sale_price asc,ci.id asc")
+	case rarityAsc:
+		// 最稀有在前, 未计算稀有度(rank=0)的排在最后
+		db.Order("ci.rarity_rank = 0,ci.rarity_rank asc,ci.id asc")
+	case rarityDesc:
+		db.Order("ci.rarity_rank = 0,ci.rarity_rank desc,ci.id asc")
 	}
 
 	// -------------------------------------------------------------
@@ -328,6 +388,23 @@ func (d *Dao) QueryCollectionItemOrder(ctx context.Context, chain string, filter
 	return items, count, nil
 }
 
+// applyItemTraitFilter 为 Item 查询添加属性过滤条件
+// 同一属性的多个值之间为 OR 关系, 不同属性之间为 AND 关系
+// 每个属性生成一个 ci.token_id in (子查询) 条件
+func (d *Dao) applyItemTraitFilter(ctx context.Context, db *gorm.DB, chain string, collectionAddr string, traits []types.TraitFilter) {
+	for _, trait := range traits {
+		if trait.Trait == "" || len(trait.Values) == 0 {
+			continue
+		}
+
+		subQuery := d.DB.WithContext(ctx).Table(multi.ItemTraitTableName(chain)).
+			Select("token_id").
+			Where("collection_address = ? and trait = ? and trait_value in (?)",
+				collectionAddr, trait.Trait, trait.Values)
+		db.Where("ci.token_id in (?)", subQuery)
+	}
+}
+
 type UserItemCount struct {
 	Owner  string `json:"owner"`
 	Counts int64  `json:"counts"`
@@ -391,7 +468,7 @@ func (d *Dao) QueryLastSalePrice(ctx context.Context, chain string,
 		multi.ActivityTableName(chain),
 		multi.ActivityTableName(chain))
 
-	if err := d.DB.Raw(sql, collectionAddr, tokenIds,
+	if err := d.DB.WithContext(ctx).Raw(sql, collectionAddr, tokenIds,
 		multi.Sale, multi.Sale).Scan(&lastSales).Error; err != nil {
 		return nil, errors.Wrap(err, "failed on get item last sale price")
 	}
@@ -434,7 +511,7 @@ func (d *Dao) QueryBestBids(ctx context.Context, chain string, userAddr string,
 	// 而是返回了所有符合条件的 Bids?
 	// 函数名 QueryBestBids 暗示返回"最佳", 但 SQL 似乎返回列表.
 	// 调用方可能需要自己处理, 或者这里只是获取所有有效出价.
-	if err := d.DB.Raw(sql, collectionAddr, tokenIds,
+	if err := d.DB.WithContext(ctx).Raw(sql, collectionAddr, tokenIds,
 		multi.ItemBidOrder, multi.OrderStatusActive,
 		time.Now().Unix()).Scan(&bestBids).Error; err != nil {
 		return nil, errors.Wrap(err, "failed on get item best bids")
@@ -472,7 +549,7 @@ SELECT order_id, token_id, event_time, price, salt, expire_time, maker, order_ty
 		sql = baseSql + fmt.Sprintf(" AND maker != '%s'", userAddr)
 	}
 
-	if err := d.DB.Raw(sql, conditions, multi.ItemBidOrder, multi.OrderStatusActive, time.Now().Unix()).Scan(&bestBids).Error; err != nil {
+	if err := d.DB.WithContext(ctx).Raw(sql, conditions, multi.ItemBidOrder, multi.OrderStatusActive, time.Now().Unix()).Scan(&bestBids).Error; err != nil {
 		return nil, errors.Wrap(err, "failed on get item best bids")
 	}
 
@@ -515,7 +592,7 @@ func (d *Dao) QueryCollectionsBestBid(ctx context.Context, chain string, userAdd
 	}
 
 	now := time.Now().Unix()
-	if err := d.DB.Raw(sql,
+	if err := d.DB.WithContext(ctx).Raw(sql,
 		collectionAddrs, multi.CollectionBidOrder, multi.OrderStatusActive, now, // subquery params
 		multi.CollectionBidOrder, multi.OrderStatusActive, now, // mainquery params
 	).Scan(&bestBid).Error; err != nil {
@@ -552,7 +629,7 @@ func (d *Dao) QueryCollectionBestBid(ctx context.Context, chain string,
 	// 按价格降序取 Limit 1
 	sql += " ORDER BY price DESC LIMIT 1"
 
-	if err := d.DB.Raw(sql, collectionAddr, multi.CollectionBidOrder,
+	if err := d.DB.WithContext(ctx).Raw(sql, collectionAddr, multi.CollectionBidOrder,
 		multi.OrderStatusActive, time.Now().Unix()).Scan(&bestBid).Error; err != nil {
 		return bestBid, errors.Wrap(err, "failed on get item best bids")
 	}
@@ -594,7 +671,7 @@ func (d *Dao) QueryCollectionTopNBid(ctx context.Context, chain string,
 	// 考虑到 depth 图通常不需要太深, Limit N 作为 DB 限制是可以接受的优化.
 	sql += fmt.Sprintf(" ORDER BY price DESC LIMIT %d", num)
 
-	if err := d.DB.Raw(sql, collectionAddr, multi.CollectionBidOrder,
+	if err := d.DB.WithContext(ctx).Raw(sql, collectionAddr, multi.CollectionBidOrder,
 		multi.OrderStatusActive, time.Now().Unix()).Scan(&bestBids).Error; err != nil {
 		return nil, errors.Wrap(err, "failed on get item best bids")
 	}
@@ -617,7 +694,7 @@ func (d *Dao) QueryCollectionTopNBid(ctx context.Context, chain string,
 	return results, nil
 }
 
-var collectionDetailFields = []string{"id", "chain_id", "token_standard", "name", "address", "image_uri", "floor_price", "sale_price", "item_amount", "owner_amount"}
+var collectionDetailFields = []string{"id", "chain_id", "token_standard", "name", "address", "image_uri", "floor_price", "sale_price", "item_amount", "owner_amount", "status"}
 
 const OrderType = 1
 const OrderStatus = 0
@@ -1052,7 +1129,9 @@ func (d *Dao) QueryItemInfo(ctx context.Context, chain, collectionAddr, tokenID
 			"ci.collection_address as collection_address, "+
 			"ci.token_id as token_id, "+
 			"ci.name as name, "+
-			"ci.owner as owner").
+			"ci.owner as owner, "+
+			"ci.status as status, "+
+			"COALESCE(ci.views, 0) as views").
 		Where("ci.collection_address =? and ci.token_id = ? ",
 			collectionAddr, tokenID).
 		Scan(&item).Error
@@ -1121,7 +1200,7 @@ func (d *Dao) QueryItemBids(ctx context.Context, chain string, collectionAddr, t
 	db := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
 		Select("marketplace_id, collection_address, token_id, order_id, salt, "+
 			"event_time, expire_time, price, maker as bidder, order_type, "+
-			"quantity_remaining as bid_unfilled, size as bid_size").
+			"quantity_remaining as bid_unfilled, size as bid_size, trait, trait_value").
 
 		// Condition 1: Collection Level Bids
 		Where("collection_address = ? and order_type = ? and order_status = ? "+
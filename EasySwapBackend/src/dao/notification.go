@@ -0,0 +1,144 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ProjectsTask/EasySwapBase/notify"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QueryNotifications 分页查询用户站内通知, 按时间倒序
+func (d *Dao) QueryNotifications(ctx context.Context, userAddr string, unreadOnly bool, page, pageSize int) ([]base.Notification, int64, error) {
+	db := d.DB.WithContext(ctx).Table(base.NotificationTableName()).
+		Where("user_address = ?", userAddr)
+	if unreadOnly {
+		db.Where("is_read = ?", false)
+	}
+
+	var count int64
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on count notifications")
+	}
+
+	var notifications []base.Notification
+	if err := db.Order("id desc").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&notifications).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on get notifications")
+	}
+	return notifications, count, nil
+}
+
+// CountUnreadNotifications 查询用户未读通知数
+func (d *Dao) CountUnreadNotifications(ctx context.Context, userAddr string) (int64, error) {
+	var count int64
+	if err := d.DB.WithContext(ctx).Table(base.NotificationTableName()).
+		Where("user_address = ? and is_read = ?", userAddr, false).
+		Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on count unread notifications")
+	}
+	return count, nil
+}
+
+// MarkNotificationsRead 将用户的通知标记为已读, ids 为空时标记全部, 返回标记的通知数
+func (d *Dao) MarkNotificationsRead(ctx context.Context, userAddr string, ids []int64) (int64, error) {
+	db := d.DB.WithContext(ctx).Table(base.NotificationTableName()).
+		Where("user_address = ? and is_read = ?", userAddr, false)
+	if len(ids) > 0 {
+		db = db.Where("id in (?)", ids)
+	}
+	result := db.Update("is_read", true)
+	if result.Error != nil {
+		return 0, errors.Wrap(result.Error, "failed on mark notifications read")
+	}
+	return result.RowsAffected, nil
+}
+
+// QueryNotificationPreference 查询用户通知偏好, 没有设置时返回 nil
+func (d *Dao) QueryNotificationPreference(ctx context.Context, userAddr string) (*base.NotificationPreference, error) {
+	return notify.QueryPreference(ctx, d.DB, userAddr)
+}
+
+// UpsertNotificationPreference 保存用户通知偏好
+func (d *Dao) UpsertNotificationPreference(ctx context.Context, pref *base.NotificationPreference) error {
+	if err := d.DB.WithContext(ctx).Table(base.NotificationPreferenceTableName()).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_address"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"muted_kinds", "webhook_enabled", "webhook_url", "webhook_secret", "email_enabled", "email", "update_time",
+			}),
+		}).
+		Create(pref).Error; err != nil {
+		return errors.Wrap(err, "failed on upsert notification preference")
+	}
+	return nil
+}
+
+// QueryFloorAlerts 查询用户的地板价提醒
+func (d *Dao) QueryFloorAlerts(ctx context.Context, userAddr string) ([]base.NotificationFloorAlert, error) {
+	var alerts []base.NotificationFloorAlert
+	if err := d.DB.WithContext(ctx).Table(base.NotificationFloorAlertTableName()).
+		Where("user_address = ?", userAddr).
+		Order("id desc").
+		Find(&alerts).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query floor alerts")
+	}
+	return alerts, nil
+}
+
+// UpsertFloorAlert 保存地板价提醒, 同一集合已存在时更新阈值并重置触发状态
+func (d *Dao) UpsertFloorAlert(ctx context.Context, alert *base.NotificationFloorAlert) error {
+	if err := d.DB.WithContext(ctx).Table(base.NotificationFloorAlertTableName()).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_address"}, {Name: "chain_id"}, {Name: "collection_address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"threshold", "triggered", "update_time"}),
+		}).
+		Create(alert).Error; err != nil {
+		return errors.Wrap(err, "failed on upsert floor alert")
+	}
+	return nil
+}
+
+// DeleteFloorAlert 删除用户的地板价提醒, 不存在时返回 gorm.ErrRecordNotFound
+func (d *Dao) DeleteFloorAlert(ctx context.Context, userAddr string, id int64) error {
+	result := d.DB.WithContext(ctx).Table(base.NotificationFloorAlertTableName()).
+		Where("id = ? and user_address = ?", id, userAddr).
+		Delete(&base.NotificationFloorAlert{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on delete floor alert")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// QueryNotificationDeliveries 分页查询用户通知的外部渠道投递记录, 按时间倒序
+func (d *Dao) QueryNotificationDeliveries(ctx context.Context, userAddr, channel string, status, page, pageSize int) ([]base.NotificationDelivery, int64, error) {
+	db := d.DB.WithContext(ctx).Table(base.NotificationDeliveryTableName()).
+		Where("user_address = ?", userAddr)
+	if channel != "" {
+		db.Where("channel = ?", channel)
+	}
+	if status > 0 {
+		db.Where("status = ?", status)
+	}
+
+	var count int64
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on count notification deliveries")
+	}
+
+	var deliveries []base.NotificationDelivery
+	if err := db.Order("id desc").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&deliveries).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on get notification deliveries")
+	}
+	return deliveries, count, nil
+}
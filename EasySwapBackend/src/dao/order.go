@@ -0,0 +1,43 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+)
+
+// QueryOrdersByIDs 按订单 ID 批量查询订单
+func (d *Dao) QueryOrdersByIDs(ctx context.Context, chain string, orderIDs []string) ([]multi.Order, error) {
+	var orders []multi.Order
+	if err := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
+		Where("order_id in (?)", orderIDs).
+		Find(&orders).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query orders")
+	}
+	return orders, nil
+}
+
+// MaxOrderLineageDepth 查询订单修改历史时最多回溯的订单数
+const MaxOrderLineageDepth = 50
+
+// QueryOrderLineage 沿 prev_order_id 回溯 editOrders 修改链, 返回从当前订单到最初订单的列表
+func (d *Dao) QueryOrderLineage(ctx context.Context, chain string, orderID string) ([]multi.Order, error) {
+	var lineage []multi.Order
+	for orderID != "" && len(lineage) < MaxOrderLineageDepth {
+		var orders []multi.Order
+		if err := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
+			Select("order_id, prev_order_id, price, event_time, expire_time, order_status").
+			Where("order_id = ?", orderID).
+			Limit(1).
+			Find(&orders).Error; err != nil {
+			return nil, errors.Wrap(err, "failed on query order lineage")
+		}
+		if len(orders) == 0 {
+			break
+		}
+		lineage = append(lineage, orders[0])
+		orderID = orders[0].PrevOrderID
+	}
+	return lineage, nil
+}
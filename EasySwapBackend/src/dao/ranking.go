@@ -141,9 +141,30 @@ func (d *Dao) GetTradeInfoByCollection(chain, collectionAddr, period string) (*C
 	}, nil
 }
 
+// collectionTradeStats 单个周期内集合的成交聚合结果
+type collectionTradeStats struct {
+	CollectionAddress string
+	ItemCount         int64
+	Volume            decimal.Decimal
+	FloorPrice        decimal.Decimal
+}
+
 // GetCollectionRankingByActivity 获取基于交易活动的集合排行榜信息
 // 功能: 批量计算所有集合在指定时间段内的 Volume, Floor Price 及其排名数据
 func (d *Dao) GetCollectionRankingByActivity(chain, period string) ([]*CollectionTrade, error) {
+	return d.queryCollectionsTrade(chain, period, nil)
+}
+
+// GetCollectionsTradeInfo 获取指定集合在特定时间段内的交易统计信息, 只聚合给定集合的成交记录
+func (d *Dao) GetCollectionsTradeInfo(chain, period string, collectionAddrs []string) ([]*CollectionTrade, error) {
+	if len(collectionAddrs) == 0 {
+		return nil, nil
+	}
+	return d.queryCollectionsTrade(chain, period, collectionAddrs)
+}
+
+// queryCollectionsTrade 聚合集合在当前及上一周期的成交数据, collectionAddrs 为空时统计全部集合
+func (d *Dao) queryCollectionsTrade(chain, period string, collectionAddrs []string) ([]*CollectionTrade, error) {
 	// 1. 获取时间段对应的 Epoch
 	epoch, ok := periodToEpoch[period]
 	if !ok {
@@ -159,44 +180,45 @@ func (d *Dao) GetCollectionRankingByActivity(chain, period string) ([]*Collectio
 	prevEndTime := startTime
 	prevStartTime := startTime.Add(-duration)
 
-	// 定义中间结果结构体
-	type TradeStats struct {
-		CollectionAddress string
-		ItemCount         int64
-		Volume            decimal.Decimal
-		FloorPrice        decimal.Decimal
-	}
-
 	// 3. 聚合查询 当前周期 统计数据
 	// Group By CollectionAddress
-	var currentStats []TradeStats
-	err := d.DB.WithContext(d.ctx).Table(multi.ActivityTableName(chain)).
-		Select("collection_address, COUNT(*) as item_count, COALESCE(SUM(price), 0) as volume, COALESCE(MIN(price), 0) as floor_price").
-		Where("activity_type = ? AND event_time >= ? AND event_time <= ?", multi.Sale, startTime, endTime).
-		Group("collection_address").
-		Find(&currentStats).Error
+	currentStats, err := d.queryTradeStats(chain, startTime, endTime, collectionAddrs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get current stats")
 	}
 
 	// 4. 聚合查询 上一周期 统计数据
-	var prevStats []TradeStats
-	err = d.DB.WithContext(d.ctx).Table(multi.ActivityTableName(chain)).
-		Select("collection_address, COUNT(*) as item_count, COALESCE(SUM(price), 0) as volume, COALESCE(MIN(price), 0) as floor_price").
-		Where("activity_type = ? AND event_time >= ? AND event_time <= ?", multi.Sale, prevStartTime, prevEndTime).
-		Group("collection_address").
-		Find(&prevStats).Error
+	prevStats, err := d.queryTradeStats(chain, prevStartTime, prevEndTime, collectionAddrs)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get previous stats")
 	}
 
-	// 5. 构建上一周期的 Map 索引, 方便快速查找
-	prevStatsMap := make(map[string]TradeStats)
+	return mergeTradeStats(currentStats, prevStats), nil
+}
+
+// queryTradeStats 按集合聚合 [startTime, endTime] 内的成交数量, 交易额及最低成交价
+func (d *Dao) queryTradeStats(chain string, startTime, endTime time.Time, collectionAddrs []string) ([]collectionTradeStats, error) {
+	var stats []collectionTradeStats
+	db := d.DB.WithContext(d.ctx).Table(multi.ActivityTableName(chain)).
+		Select("collection_address, COUNT(*) as item_count, COALESCE(SUM(price), 0) as volume, COALESCE(MIN(price), 0) as floor_price").
+		Where("activity_type = ? AND event_time >= ? AND event_time <= ?", multi.Sale, startTime, endTime)
+	if len(collectionAddrs) > 0 {
+		db = db.Where("collection_address in (?)", collectionAddrs)
+	}
+	if err := db.Group("collection_address").Find(&stats).Error; err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// mergeTradeStats 组装当前周期的统计结果, 并计算相对上一周期的交易额及地板价变化率
+func mergeTradeStats(currentStats, prevStats []collectionTradeStats) []*CollectionTrade {
+	// 构建上一周期的 Map 索引, 方便快速查找
+	prevStatsMap := make(map[string]collectionTradeStats)
 	for _, stat := range prevStats {
 		prevStatsMap[stat.CollectionAddress] = stat
 	}
 
-	// 6. 组装最终结果, 计算变化率
 	var result []*CollectionTrade
 	for _, curr := range currentStats {
 		trade := &CollectionTrade{
@@ -227,7 +249,7 @@ func (d *Dao) GetCollectionRankingByActivity(chain, period string) ([]*Collectio
 		result = append(result, trade)
 	}
 
-	return result, nil
+	return result
 }
 
 // GetCollectionVolume 获取指定 Collection 的历史总交易额
@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newMockDao(t *testing.T) (*Dao, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	mr := miniredis.RunT(t)
+	kv := xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+	return New(context.Background(), db, kv), mock
+}
+
+func TestMergeTradeStats(t *testing.T) {
+	current := []collectionTradeStats{
+		{CollectionAddress: "0xa", ItemCount: 3, Volume: decimal.NewFromInt(300), FloorPrice: decimal.NewFromInt(90)},
+		{CollectionAddress: "0xb", ItemCount: 1, Volume: decimal.NewFromInt(10), FloorPrice: decimal.NewFromInt(10)},
+	}
+	prev := []collectionTradeStats{
+		{CollectionAddress: "0xa", ItemCount: 2, Volume: decimal.NewFromInt(200), FloorPrice: decimal.NewFromInt(100)},
+	}
+
+	trades := mergeTradeStats(current, prev)
+	require.Len(t, trades, 2)
+
+	assert.Equal(t, "0xa", trades[0].ContractAddress)
+	assert.Equal(t, int64(3), trades[0].ItemCount)
+	assert.Equal(t, 50, trades[0].VolumeChange)
+	assert.Equal(t, -10, trades[0].FloorChange)
+	assert.True(t, trades[0].PreFloorPrice.Equal(decimal.NewFromInt(100)))
+
+	// 上一周期没有成交时变化率为 0
+	assert.Equal(t, "0xb", trades[1].ContractAddress)
+	assert.Equal(t, 0, trades[1].VolumeChange)
+	assert.Equal(t, 0, trades[1].FloorChange)
+	assert.True(t, trades[1].PreFloorPrice.IsZero())
+}
+
+func TestFloorChangeRates(t *testing.T) {
+	prices := []multi.CollectionFloorPrice{
+		{CollectionAddress: "0xa", Price: decimal.NewFromInt(110), EventTime: 200},
+		{CollectionAddress: "0xa", Price: decimal.NewFromInt(100), EventTime: 100},
+		{CollectionAddress: "0xb", Price: decimal.NewFromInt(5), EventTime: 200},
+		{CollectionAddress: "0xc", Price: decimal.NewFromInt(5), EventTime: 200},
+		{CollectionAddress: "0xc", Price: decimal.Zero, EventTime: 100},
+	}
+
+	changes := floorChangeRates(prices)
+	assert.InDelta(t, 0.1, changes["0xa"], 1e-9)
+	assert.Equal(t, 0.0, changes["0xb"])
+	assert.Equal(t, 0.0, changes["0xc"])
+	assert.Len(t, changes, 3)
+}
+
+func TestGetCollectionsTradeInfoFiltersAddresses(t *testing.T) {
+	d, mock := newMockDao(t)
+	addrs := []string{"0xa", "0xb"}
+
+	query := regexp.QuoteMeta("FROM `ob_activity_sepolia` WHERE (activity_type = ? AND event_time >= ? AND event_time <= ?) AND collection_address in (?,?) GROUP BY `collection_address`")
+	mock.ExpectQuery(query).
+		WithArgs(multi.Sale, sqlmock.AnyArg(), sqlmock.AnyArg(), "0xa", "0xb").
+		WillReturnRows(sqlmock.NewRows([]string{"collection_address", "item_count", "volume", "floor_price"}).
+			AddRow("0xa", 2, "20", "10"))
+	mock.ExpectQuery(query).
+		WithArgs(multi.Sale, sqlmock.AnyArg(), sqlmock.AnyArg(), "0xa", "0xb").
+		WillReturnRows(sqlmock.NewRows([]string{"collection_address", "item_count", "volume", "floor_price"}).
+			AddRow("0xa", 1, "10", "5"))
+
+	trades, err := d.GetCollectionsTradeInfo("sepolia", "1d", addrs)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	assert.Equal(t, 100, trades[0].VolumeChange)
+	assert.Equal(t, 100, trades[0].FloorChange)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// 没有关注的集合时不查询
+	trades, err = d.GetCollectionsTradeInfo("sepolia", "1d", nil)
+	require.NoError(t, err)
+	assert.Empty(t, trades)
+}
+
+func TestQueryCollectionsFloorChangeFiltersAddresses(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	mock.ExpectQuery(`WHERE collection_address in \(\?\)\s+GROUP BY collection_address.*WHERE event_time <= UNIX_TIMESTAMP\(\) - \? AND collection_address in \(\?\)`).
+		WithArgs("0xa", int64(86400), "0xa").
+		WillReturnRows(sqlmock.NewRows([]string{"collection_address", "price", "event_time"}).
+			AddRow("0xa", "120", 200).
+			AddRow("0xa", "100", 100))
+
+	changes, err := d.QueryCollectionsFloorChange("sepolia", 86400, []string{"0xa"})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.2, changes["0xa"], 1e-9)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestIncrItemViewsThrottle(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_item_sepolia` SET `views`=COALESCE(views, 0) + 1")).
+		WithArgs("0xa", "1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	counted, err := d.IncrItemViews(context.Background(), "sepolia", "0xa", "1", "0xuser")
+	require.NoError(t, err)
+	assert.True(t, counted)
+
+	// 同一用户在限流时间内重复浏览不再计数
+	counted, err = d.IncrItemViews(context.Background(), "sepolia", "0xa", "1", "0xuser")
+	require.NoError(t, err)
+	assert.False(t, counted)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
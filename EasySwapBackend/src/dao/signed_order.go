@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"gorm.io/gorm/clause"
+)
+
+// CreateSignedOrder 保存链下签名订单
+// 订单 ID 即合约 OrderKey, 上链后由 sync 的 LogMake 对账; 尚未上链的订单没有链上交易, 不记录活动
+func (d *Dao) CreateSignedOrder(ctx context.Context, chain string, order *multi.Order) error {
+	result := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(order)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on create signed order")
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("order already exists")
+	}
+	return nil
+}
+
+// QuerySignedOrder 查询尚未上链的链下签名订单
+func (d *Dao) QuerySignedOrder(ctx context.Context, chain string, orderID string) (*multi.Order, error) {
+	var order multi.Order
+	if err := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
+		Where("order_id = ? and signature != ''", orderID).
+		First(&order).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get signed order")
+	}
+	return &order, nil
+}
+
+// CancelSignedOrder 取消链下签名订单
+// 仅 Pending 状态 (尚未上链) 的订单可以在链下取消, 已上链的订单需调用合约 cancelOrders
+func (d *Dao) CancelSignedOrder(ctx context.Context, chain string, order *multi.Order) error {
+	result := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
+		Where("order_id = ? and signature != '' and order_status = ?", order.OrderID, multi.OrderStatusPending).
+		Update("order_status", multi.OrderStatusCancelled)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on cancel signed order")
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("signed order is not pending")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSignedOrderDuplicate(t *testing.T) {
+	d, mock := newMockDao(t)
+	order := &multi.Order{OrderID: "0x01", OrderStatus: multi.OrderStatusPending, Signature: "0xsig"}
+	insert := regexp.QuoteMeta("INSERT INTO `ob_order_sepolia`")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(insert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, d.CreateSignedOrder(context.Background(), "sepolia", order))
+
+	// 同一 OrderKey 的订单已存在时不覆盖
+	mock.ExpectBegin()
+	mock.ExpectExec(insert).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	assert.EqualError(t, d.CreateSignedOrder(context.Background(), "sepolia", order), "order already exists")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQuerySignedOrderOnChain(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	// LogMake 对账后签名被清空, 不再作为链下签名订单返回
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `ob_order_sepolia` WHERE order_id = ? and signature != ''")).
+		WithArgs("0x01").
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "signature"}))
+
+	_, err := d.QuerySignedOrder(context.Background(), "sepolia", "0x01")
+	assert.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelSignedOrderNotPending(t *testing.T) {
+	d, mock := newMockDao(t)
+	order := &multi.Order{OrderID: "0x01"}
+	update := regexp.QuoteMeta("UPDATE `ob_order_sepolia` SET `order_status`=? WHERE order_id = ? and signature != '' and order_status = ?")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(update).
+		WithArgs(multi.OrderStatusCancelled, "0x01", multi.OrderStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, d.CancelSignedOrder(context.Background(), "sepolia", order))
+
+	// 查询后订单已被 LogMake 转为链上订单
+	mock.ExpectBegin()
+	mock.ExpectExec(update).
+		WithArgs(multi.OrderStatusCancelled, "0x01", multi.OrderStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	assert.EqualError(t, d.CancelSignedOrder(context.Background(), "sepolia", order), "signed order is not pending")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
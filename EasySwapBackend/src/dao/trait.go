@@ -65,3 +65,30 @@ func (d *Dao) QueryCollectionTraits(ctx context.Context, chain string, collectio
 
 	return traitCounts, nil
 }
+
+// QueryTraitItemCount 查询集合中拥有指定属性的 Item 数量
+// 用途: 创建属性出价前校验属性是否存在
+func (d *Dao) QueryTraitItemCount(ctx context.Context, chain string, collectionAddr string, trait, traitValue string) (int64, error) {
+	var count int64
+	if err := d.DB.WithContext(ctx).Table(multi.ItemTraitTableName(chain)).
+		Where("collection_address = ? and trait = ? and trait_value = ?", collectionAddr, trait, traitValue).
+		Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on count trait items")
+	}
+
+	return count, nil
+}
+
+// QueryItemHasTrait 查询 Item 是否拥有指定属性
+// 用途: 撮合属性出价前校验 Item 符合出价条件
+func (d *Dao) QueryItemHasTrait(ctx context.Context, chain string, collectionAddr, tokenID string, trait, traitValue string) (bool, error) {
+	var count int64
+	if err := d.DB.WithContext(ctx).Table(multi.ItemTraitTableName(chain)).
+		Where("collection_address = ? and token_id = ? and trait = ? and trait_value = ?",
+			collectionAddr, tokenID, trait, traitValue).
+		Count(&count).Error; err != nil {
+		return false, errors.Wrap(err, "failed on query item trait")
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,127 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm/clause"
+)
+
+// CreateTraitBid 创建属性出价订单
+// 属性出价为链下订单, 没有对应的链上交易, 不记录活动
+func (d *Dao) CreateTraitBid(ctx context.Context, chain string, order *multi.Order) error {
+	result := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(order)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on create trait bid")
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("trait bid already exists")
+	}
+	return nil
+}
+
+// QueryTraitBid 查询属性出价订单
+func (d *Dao) QueryTraitBid(ctx context.Context, chain string, orderID string) (*multi.Order, error) {
+	var order multi.Order
+	if err := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
+		Where("order_id = ? and order_type = ?", orderID, multi.TraitBidOrder).
+		First(&order).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get trait bid")
+	}
+	return &order, nil
+}
+
+// CancelTraitBid 取消属性出价订单
+// 仅 Active 状态的订单可以取消
+func (d *Dao) CancelTraitBid(ctx context.Context, chain string, order *multi.Order) error {
+	result := d.DB.WithContext(ctx).Table(multi.OrderTableName(chain)).
+		Where("order_id = ? and order_type = ? and order_status = ?",
+			order.OrderID, multi.TraitBidOrder, multi.OrderStatusActive).
+		Update("order_status", multi.OrderStatusCancelled)
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on cancel trait bid")
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("trait bid is not active")
+	}
+	return nil
+}
+
+// QueryItemsBestTraitBids 查询适用于指定 Items 的属性出价
+// 功能: 属性出价适用于拥有该 (trait, trait_value) 的任意 Item
+// 返回: 每个 (token_id, order) 组合一行, token_id 为被匹配的 Item
+// 调用方负责为每个 Item 选出最高价
+func (d *Dao) QueryItemsBestTraitBids(ctx context.Context, chain string, userAddr string,
+	collectionAddr string, tokenIds []string) ([]multi.Order, error) {
+	var bids []multi.Order
+
+	db := d.DB.WithContext(ctx).Table(fmt.Sprintf("%s as co", multi.OrderTableName(chain))).
+		Select("co.order_id as order_id, cit.token_id as token_id, co.event_time as event_time, "+
+			"co.price as price, co.salt as salt, co.expire_time as expire_time, co.maker as maker, "+
+			"co.order_type as order_type, co.quantity_remaining as quantity_remaining, co.size as size, "+
+			"co.trait as trait, co.trait_value as trait_value").
+		Joins(fmt.Sprintf("join %s cit on cit.collection_address = co.collection_address "+
+			"and cit.trait = co.trait and cit.trait_value = co.trait_value", multi.ItemTraitTableName(chain))).
+		Where("co.collection_address = ? and cit.token_id in (?) and co.order_type = ? and co.order_status = ? "+
+			"and co.expire_time > ? and co.quantity_remaining > 0",
+			collectionAddr, tokenIds, multi.TraitBidOrder, multi.OrderStatusActive, time.Now().Unix())
+
+	// 排除用户自己的出价
+	if userAddr != "" {
+		db.Where("co.maker != ?", userAddr)
+	}
+
+	if err := db.Scan(&bids).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get items trait bids")
+	}
+
+	return bids, nil
+}
+
+// QueryTraitBidListing 查询属性出价可成交的 Item 挂单
+// 条件: 已上链的有效挂单 (maker 为当前持有者、未过期), 价格不高于出价, 且不是出价人自己的挂单
+// 返回: 最低价挂单, 没有符合条件的挂单时返回 nil
+func (d *Dao) QueryTraitBidListing(ctx context.Context, chain string, collectionAddr, tokenID string,
+	maxPrice decimal.Decimal, bidder string) (*multi.Order, error) {
+	var listings []multi.Order
+	if err := d.DB.WithContext(ctx).Table(fmt.Sprintf("%s as co", multi.OrderTableName(chain))).
+		Select("co.*").
+		Joins(fmt.Sprintf("join %s ci on ci.collection_address = co.collection_address and ci.token_id = co.token_id",
+			multi.ItemTableName(chain))).
+		Where("co.collection_address = ? and co.token_id = ? and co.order_type = ? and co.order_status = ? "+
+			"and co.signature = '' and co.maker = ci.owner and co.maker != ? and co.price <= ? "+
+			"and (co.expire_time = 0 or co.expire_time > ?)",
+			collectionAddr, tokenID, multi.ListingOrder, multi.OrderStatusActive, bidder, maxPrice, time.Now().Unix()).
+		Order("co.price asc").
+		Limit(1).
+		Find(&listings).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query trait bid listing")
+	}
+	if len(listings) == 0 {
+		return nil, nil
+	}
+	return &listings[0], nil
+}
+
+// QueryItemTraitFloorPrices 查询单个 Item 所有属性的地板价
+// 地板价由 ordermanager 维护, 0 表示该属性当前无挂单
+func (d *Dao) QueryItemTraitFloorPrices(ctx context.Context, chain string, collectionAddr, tokenID string) ([]multi.TraitFloorPrice, error) {
+	var floorPrices []multi.TraitFloorPrice
+	if err := d.DB.WithContext(ctx).Table(fmt.Sprintf("%s as tfp", multi.TraitFloorPriceTableName(chain))).
+		Select("tfp.collection_address as collection_address, tfp.trait as trait, "+
+			"tfp.trait_value as trait_value, tfp.price as price").
+		Joins(fmt.Sprintf("join %s cit on cit.collection_address = tfp.collection_address "+
+			"and cit.trait = tfp.trait and cit.trait_value = tfp.trait_value", multi.ItemTraitTableName(chain))).
+		Where("tfp.collection_address = ? and cit.token_id = ?", collectionAddr, tokenID).
+		Scan(&floorPrices).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query item trait floor prices")
+	}
+
+	return floorPrices, nil
+}
@@ -0,0 +1,136 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTraitBidDuplicate(t *testing.T) {
+	d, mock := newMockDao(t)
+	order := &multi.Order{OrderID: "0x01", OrderType: multi.TraitBidOrder, Trait: "Background", TraitValue: "Red"}
+	insert := regexp.QuoteMeta("INSERT INTO `ob_order_sepolia`")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(insert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, d.CreateTraitBid(context.Background(), "sepolia", order))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(insert).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	assert.EqualError(t, d.CreateTraitBid(context.Background(), "sepolia", order), "trait bid already exists")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelTraitBidNotActive(t *testing.T) {
+	d, mock := newMockDao(t)
+	order := &multi.Order{OrderID: "0x01"}
+	update := regexp.QuoteMeta("UPDATE `ob_order_sepolia` SET `order_status`=? WHERE order_id = ? and order_type = ? and order_status = ?")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(update).
+		WithArgs(multi.OrderStatusCancelled, "0x01", multi.TraitBidOrder, multi.OrderStatusActive).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, d.CancelTraitBid(context.Background(), "sepolia", order))
+
+	// 已成交或已取消的出价不能再取消
+	mock.ExpectBegin()
+	mock.ExpectExec(update).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	assert.EqualError(t, d.CancelTraitBid(context.Background(), "sepolia", order), "trait bid is not active")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueryItemsBestTraitBids(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	// 出价通过属性关联到 Item, 排除用户自己的出价
+	mock.ExpectQuery(regexp.QuoteMeta("FROM ob_order_sepolia as co join ob_item_trait_sepolia cit on cit.collection_address = co.collection_address "+
+		"and cit.trait = co.trait and cit.trait_value = co.trait_value "+
+		"WHERE (co.collection_address = ? and cit.token_id in (?,?) and co.order_type = ? and co.order_status = ? "+
+		"and co.expire_time > ? and co.quantity_remaining > 0) AND co.maker != ?")).
+		WithArgs("0xa", "1", "2", multi.TraitBidOrder, multi.OrderStatusActive, sqlmock.AnyArg(), "0xuser").
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "token_id", "price", "trait", "trait_value"}).
+			AddRow("0x01", "1", "100", "Background", "Red").
+			AddRow("0x02", "2", "90", "Background", "Red"))
+
+	bids, err := d.QueryItemsBestTraitBids(context.Background(), "sepolia", "0xuser", "0xa", []string{"1", "2"})
+	require.NoError(t, err)
+	require.Len(t, bids, 2)
+	assert.Equal(t, "1", bids[0].TokenId)
+	assert.Equal(t, "Background", bids[0].Trait)
+	assert.True(t, bids[1].Price.Equal(decimal.NewFromInt(90)))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueryItemTraitFloorPrices(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT tfp.collection_address as collection_address, tfp.trait as trait, "+
+		"tfp.trait_value as trait_value, tfp.price as price FROM ob_trait_floor_price_sepolia as tfp "+
+		"join ob_item_trait_sepolia cit on cit.collection_address = tfp.collection_address "+
+		"and cit.trait = tfp.trait and cit.trait_value = tfp.trait_value WHERE tfp.collection_address = ? and cit.token_id = ?")).
+		WithArgs("0xa", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"collection_address", "trait", "trait_value", "price"}).
+			AddRow("0xa", "Background", "Red", "100").
+			AddRow("0xa", "Hat", "Cap", "0"))
+
+	floorPrices, err := d.QueryItemTraitFloorPrices(context.Background(), "sepolia", "0xa", "1")
+	require.NoError(t, err)
+	require.Len(t, floorPrices, 2)
+	assert.True(t, floorPrices[0].Price.Equal(decimal.NewFromInt(100)))
+	// 属性当前无挂单时地板价为 0
+	assert.True(t, floorPrices[1].Price.IsZero())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueryItemHasTrait(t *testing.T) {
+	d, mock := newMockDao(t)
+	query := regexp.QuoteMeta("SELECT count(*) FROM `ob_item_trait_sepolia` WHERE collection_address = ? and token_id = ? and trait = ? and trait_value = ?")
+
+	mock.ExpectQuery(query).WithArgs("0xa", "1", "Background", "Red").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	ok, err := d.QueryItemHasTrait(context.Background(), "sepolia", "0xa", "1", "Background", "Red")
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	mock.ExpectQuery(query).WithArgs("0xa", "2", "Background", "Red").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	ok, err = d.QueryItemHasTrait(context.Background(), "sepolia", "0xa", "2", "Background", "Red")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueryTraitBidListing(t *testing.T) {
+	d, mock := newMockDao(t)
+	query := regexp.QuoteMeta("SELECT co.* FROM ob_order_sepolia as co " +
+		"join ob_item_sepolia ci on ci.collection_address = co.collection_address and ci.token_id = co.token_id " +
+		"WHERE co.collection_address = ? and co.token_id = ? and co.order_type = ? and co.order_status = ? " +
+		"and co.signature = '' and co.maker = ci.owner and co.maker != ? and co.price <= ? " +
+		"and (co.expire_time = 0 or co.expire_time > ?) ORDER BY co.price asc LIMIT 1")
+
+	// 取不高于出价的最低价有效挂单
+	mock.ExpectQuery(query).
+		WithArgs("0xa", "1", multi.ListingOrder, multi.OrderStatusActive, "0xbidder", decimal.NewFromInt(100), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "price", "maker"}).AddRow("0x01", "80", "0xseller"))
+	listing, err := d.QueryTraitBidListing(context.Background(), "sepolia", "0xa", "1", decimal.NewFromInt(100), "0xbidder")
+	require.NoError(t, err)
+	require.NotNil(t, listing)
+	assert.Equal(t, "0x01", listing.OrderID)
+	assert.True(t, listing.Price.Equal(decimal.NewFromInt(80)))
+
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"order_id", "price", "maker"}))
+	listing, err = d.QueryTraitBidListing(context.Background(), "sepolia", "0xa", "2", decimal.NewFromInt(100), "0xbidder")
+	require.NoError(t, err)
+	assert.Nil(t, listing)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
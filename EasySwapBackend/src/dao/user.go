@@ -57,3 +57,23 @@ func (d *Dao) QueryUserBids(ctx context.Context, chain string, userAddrs []strin
 
 	return userBids, nil
 }
+
+// QueryUserEscrowOrders 查询用户在 Vault 中仍托管资产的订单
+// 托管资产由订单簿事件推导, 已失效 (Inactive) 或已过期但未取消的链上订单资产仍锁定在 Vault 中, 不按订单状态过滤
+func (d *Dao) QueryUserEscrowOrders(ctx context.Context, chain string, userAddrs []string, contractAddrs []string) ([]multi.Order, error) {
+	var orders []multi.Order
+	db := d.DB.WithContext(ctx).
+		Table(multi.OrderTableName(chain)).
+		Select("collection_address, token_id, order_id, order_type, order_status, maker, "+
+			"price, quantity_remaining, expire_time, escrow_eth, escrow_nft").
+		Where("maker in (?) and marketplace_id = ? and (escrow_eth > 0 or escrow_nft = ?)",
+			userAddrs, multi.MarketOrderBook, true)
+	if len(contractAddrs) != 0 {
+		db.Where("collection_address in (?)", contractAddrs)
+	}
+
+	if err := db.Order("id desc").Scan(&orders).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get user escrow orders")
+	}
+	return orders, nil
+}
@@ -0,0 +1,77 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+)
+
+// QueryUserHoldings 查询用户当前持有的全部 Item
+func (d *Dao) QueryUserHoldings(ctx context.Context, chain string, userAddrs []string) ([]multi.Item, error) {
+	var items []multi.Item
+	if err := d.DB.WithContext(ctx).
+		Table(multi.ItemTableName(chain)).
+		Select("collection_address, token_id, owner").
+		Where("owner in (?)", userAddrs).
+		Scan(&items).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get user holdings")
+	}
+	return items, nil
+}
+
+// QueryUserFills 查询用户作为成交订单 Maker 的成交记录, 按成交时间升序
+// order_type 为 Listing 的记录是用户卖出, 其余 (Bid) 是用户买入
+func (d *Dao) QueryUserFills(ctx context.Context, chain string, userAddrs []string) ([]multi.Fill, error) {
+	var fills []multi.Fill
+	if err := d.DB.WithContext(ctx).
+		Table(multi.FillTableName(chain)).
+		Select("order_id, order_type, collection_address, token_id, amount, price, maker, tx_hash, log_index, block_number, event_time").
+		Where("maker in (?)", userAddrs).
+		Order("block_number asc, log_index asc").
+		Scan(&fills).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get user fills")
+	}
+	return fills, nil
+}
+
+// QueryCollectionsFloorPriceHistory 查询集合在 [start, end] 内按 step (秒) 采样的地板价快照
+// 地板价快照每 10 秒写入一次, 因此按采样区间 (start + (k-1)*step, start + k*step] 分组, 每组只返回最后一条快照,
+// start 之前的快照归入第 0 组, 即 start 时刻的地板价; 返回结果按集合地址及时间升序
+func (d *Dao) QueryCollectionsFloorPriceHistory(ctx context.Context, chain string, collectionAddrs []string, start, end, step int64) ([]multi.CollectionFloorPrice, error) {
+	var collectionPrices []multi.CollectionFloorPrice
+	if len(collectionAddrs) == 0 {
+		return collectionPrices, nil
+	}
+
+	rawSql := fmt.Sprintf(`SELECT f.collection_address, f.price, f.event_time
+		FROM %s f
+		JOIN (
+			SELECT collection_address, MAX(event_time) AS event_time
+			FROM %s
+			WHERE collection_address in (?) and event_time <= ?
+			GROUP BY collection_address, GREATEST(CEIL((event_time - ?) / ?), 0)
+		) b ON f.collection_address = b.collection_address AND f.event_time = b.event_time
+		ORDER BY f.collection_address, f.event_time`,
+		multi.CollectionFloorPriceTableName(chain),
+		multi.CollectionFloorPriceTableName(chain))
+	if err := d.DB.WithContext(ctx).Raw(rawSql, collectionAddrs, end, start, step).Scan(&collectionPrices).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get collections floor price history")
+	}
+	return collectionPrices, nil
+}
+
+// QueryProtocolShares 查询协议手续费率变更记录, 按区块号及日志序号升序
+func (d *Dao) QueryProtocolShares(ctx context.Context, chain string) ([]multi.ProtocolShare, error) {
+	var shares []multi.ProtocolShare
+	if err := d.DB.WithContext(ctx).
+		Table(multi.ProtocolShareTableName(chain)).
+		Select("share, block_number, log_index, event_time").
+		Order("block_number asc, log_index asc").
+		Scan(&shares).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get protocol shares")
+	}
+	return shares, nil
+}
@@ -0,0 +1,30 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCollectionsFloorPriceHistory(t *testing.T) {
+	d, mock := newMockDao(t)
+
+	mock.ExpectQuery(`GROUP BY collection_address, GREATEST\(CEIL\(\(event_time - \?\) / \?\), 0\)`).
+		WithArgs("0xa", "0xb", int64(1000), int64(100), int64(300)).
+		WillReturnRows(sqlmock.NewRows([]string{"collection_address", "price", "event_time"}).
+			AddRow("0xa", "10", 90).
+			AddRow("0xa", "12", 390))
+
+	prices, err := d.QueryCollectionsFloorPriceHistory(context.Background(), "sepolia", []string{"0xa", "0xb"}, 100, 1000, 300)
+	require.NoError(t, err)
+	require.Len(t, prices, 2)
+	assert.Equal(t, int64(390), prices[1].EventTime)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	prices, err = d.QueryCollectionsFloorPriceHistory(context.Background(), "sepolia", nil, 100, 1000, 300)
+	require.NoError(t, err)
+	assert.Empty(t, prices)
+}
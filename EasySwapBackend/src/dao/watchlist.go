@@ -0,0 +1,162 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// ItemViewThrottleSeconds 同一用户在该时间内重复浏览同一 Item 只计一次浏览量
+const ItemViewThrottleSeconds = 3600
+
+// CreateFavoriteItem 收藏 Item, 已收藏时不做任何处理
+func (d *Dao) CreateFavoriteItem(ctx context.Context, favorite *base.UserFavoriteItem) error {
+	if err := d.DB.WithContext(ctx).Table(base.UserFavoriteItemTableName()).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(favorite).Error; err != nil {
+		return errors.Wrap(err, "failed on create favorite item")
+	}
+	return nil
+}
+
+// DeleteFavoriteItem 取消收藏 Item, 未收藏时返回 gorm.ErrRecordNotFound
+func (d *Dao) DeleteFavoriteItem(ctx context.Context, userAddr string, chainID int, collectionAddr, tokenID string) error {
+	result := d.DB.WithContext(ctx).Table(base.UserFavoriteItemTableName()).
+		Where("user_address = ? and chain_id = ? and collection_address = ? and token_id = ?", userAddr, chainID, collectionAddr, tokenID).
+		Delete(&base.UserFavoriteItem{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on delete favorite item")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// QueryUserFavoriteItems 分页查询用户收藏的 Item, 按收藏时间倒序
+func (d *Dao) QueryUserFavoriteItems(ctx context.Context, userAddr string, chainIDs []int, page, pageSize int) ([]base.UserFavoriteItem, int64, error) {
+	db := d.DB.WithContext(ctx).Table(base.UserFavoriteItemTableName()).
+		Where("user_address = ?", userAddr)
+	if len(chainIDs) > 0 {
+		db.Where("chain_id in (?)", chainIDs)
+	}
+
+	var count int64
+	if err := db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on count favorite items")
+	}
+
+	var favorites []base.UserFavoriteItem
+	if err := db.Order("id desc").
+		Offset((page - 1) * pageSize).
+		Limit(pageSize).
+		Scan(&favorites).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed on get favorite items")
+	}
+	return favorites, count, nil
+}
+
+// CountUserFavoriteItems 查询用户收藏的 Item 数
+func (d *Dao) CountUserFavoriteItems(ctx context.Context, userAddr string) (int64, error) {
+	var count int64
+	if err := d.DB.WithContext(ctx).Table(base.UserFavoriteItemTableName()).
+		Where("user_address = ?", userAddr).
+		Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on count favorite items")
+	}
+	return count, nil
+}
+
+// QueryItemFavoriteCount 查询 Item 被收藏的次数
+func (d *Dao) QueryItemFavoriteCount(ctx context.Context, chainID int, collectionAddr, tokenID string) (int64, error) {
+	var count int64
+	if err := d.DB.WithContext(ctx).Table(base.UserFavoriteItemTableName()).
+		Where("chain_id = ? and collection_address = ? and token_id = ?", chainID, collectionAddr, tokenID).
+		Count(&count).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on count item favorites")
+	}
+	return count, nil
+}
+
+// QueryItemsInfo 按 (集合地址, Token ID) 批量查询 Item 基础信息
+func (d *Dao) QueryItemsInfo(ctx context.Context, chain string, itemInfos []types.ItemInfo) ([]multi.Item, error) {
+	var conditions []clause.Expr
+	for _, info := range itemInfos {
+		conditions = append(conditions, gorm.Expr("(?, ?)", info.CollectionAddress, info.TokenID))
+	}
+
+	var items []multi.Item
+	if err := d.DB.WithContext(ctx).Table(multi.ItemTableName(chain)).
+		Select("collection_address, token_id, name, owner, status").
+		Where("(collection_address,token_id) in (?)", conditions).
+		Scan(&items).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query items info")
+	}
+	return items, nil
+}
+
+// CreateWatchCollection 关注集合, 已关注时不做任何处理
+func (d *Dao) CreateWatchCollection(ctx context.Context, watch *base.UserWatchCollection) error {
+	if err := d.DB.WithContext(ctx).Table(base.UserWatchCollectionTableName()).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(watch).Error; err != nil {
+		return errors.Wrap(err, "failed on create watch collection")
+	}
+	return nil
+}
+
+// DeleteWatchCollection 取消关注集合, 未关注时返回 gorm.ErrRecordNotFound
+func (d *Dao) DeleteWatchCollection(ctx context.Context, userAddr string, chainID int, collectionAddr string) error {
+	result := d.DB.WithContext(ctx).Table(base.UserWatchCollectionTableName()).
+		Where("user_address = ? and chain_id = ? and collection_address = ?", userAddr, chainID, collectionAddr).
+		Delete(&base.UserWatchCollection{})
+	if result.Error != nil {
+		return errors.Wrap(result.Error, "failed on delete watch collection")
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// QueryUserWatchCollections 查询用户关注的集合, 按关注时间倒序
+func (d *Dao) QueryUserWatchCollections(ctx context.Context, userAddr string) ([]base.UserWatchCollection, error) {
+	var watches []base.UserWatchCollection
+	if err := d.DB.WithContext(ctx).Table(base.UserWatchCollectionTableName()).
+		Where("user_address = ?", userAddr).
+		Order("id desc").
+		Find(&watches).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query watch collections")
+	}
+	return watches, nil
+}
+
+// GenItemViewKey 访问者浏览 Item 的限流 key
+func GenItemViewKey(chain, collectionAddr, tokenID, viewer string) string {
+	return fmt.Sprintf("cache:es:item:view:%s:%s:%s:%s", chain, collectionAddr, tokenID, viewer)
+}
+
+// IncrItemViews 增加 Item 浏览量, 同一用户 ItemViewThrottleSeconds 内只计一次, 返回是否计数
+func (d *Dao) IncrItemViews(ctx context.Context, chain, collectionAddr, tokenID, viewer string) (bool, error) {
+	ok, err := d.KvStore.SetnxEx(GenItemViewKey(chain, collectionAddr, tokenID, viewer), "1", ItemViewThrottleSeconds)
+	if err != nil {
+		return false, errors.Wrap(err, "failed on throttle item view")
+	}
+	if !ok {
+		return false, nil
+	}
+
+	if err := d.DB.WithContext(ctx).Table(multi.ItemTableName(chain)).
+		Where("collection_address = ? and token_id = ?", collectionAddr, tokenID).
+		Update("views", gorm.Expr("COALESCE(views, 0) + 1")).Error; err != nil {
+		return false, errors.Wrap(err, "failed on incr item views")
+	}
+	return true, nil
+}
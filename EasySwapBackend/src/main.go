@@ -1,8 +1,17 @@
 package main
 
 import (
+	"context"
 	"flag"
 	_ "net/http/pprof"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
+	"go.uber.org/zap"
 
 	"github.com/ProjectsTask/EasySwapBackend/src/api/router"
 	"github.com/ProjectsTask/EasySwapBackend/src/app"
@@ -14,6 +23,8 @@ const (
 	// port       = ":9000"
 	repoRoot          = ""
 	defaultConfigPath = "./config/config.toml"
+	// shutdownTimeout 收到退出信号后等待处理中的请求及后台任务完成的最长时间
+	shutdownTimeout = 25 * time.Second
 )
 
 func main() {
@@ -33,6 +44,16 @@ func main() {
 		}
 	}
 
+	// 初始化链路追踪，未配置 exporter 时不开启
+	if c.Trace != nil && c.Trace.ServiceName == "" {
+		c.Trace.ServiceName = c.Log.ServiceName
+	}
+	shutdownTrace, err := xtrace.SetUp(c.Trace)
+	if err != nil {
+		panic(err)
+	}
+	defer shutdownTrace(context.Background())
+
 	// 初始化服务上下文 (Context)，包含DB, Redis等连接
 	serverCtx, err := svc.NewServiceContext(c)
 	if err != nil {
@@ -40,12 +61,31 @@ func main() {
 	}
 	// Initialize router
 	// 初始化 Gin 路由实例
-	r := router.NewRouter(serverCtx)
+	r, err := router.NewRouter(serverCtx)
+	if err != nil {
+		panic(err)
+	}
 	// 创建应用程序实例，并将路由和服务上下文注入
 	app, err := app.NewPlatform(c, r, serverCtx)
 	if err != nil {
 		panic(err)
 	}
-	// 启动 HTTP 服务
+	// 收到退出信号后停止服务, 等待处理中的请求完成并归还正在执行的导出任务
+	onSignal := make(chan os.Signal, 1)
+	signal.Notify(onSignal, syscall.SIGINT, syscall.SIGTERM)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		sig := <-onSignal
+		xzap.WithContext(context.Background()).Info("Exit by signal", zap.String("signal", sig.String()))
+		stopCtx, stopCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer stopCancel()
+		if err := app.Stop(stopCtx); err != nil {
+			xzap.WithContext(context.Background()).Error("Failed to stop server gracefully", zap.Error(err))
+		}
+	}()
+
+	// 启动 HTTP 服务, Stop 后返回; 等待后台任务退出后再结束进程
 	app.Start()
+	<-stopped
 }
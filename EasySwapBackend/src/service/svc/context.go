@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/ProjectsTask/EasySwapBase/chain/nftchainservice"
+	"github.com/ProjectsTask/EasySwapBase/health"
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
 	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
@@ -13,6 +14,7 @@ import (
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"gorm.io/gorm"
 
+	"github.com/ProjectsTask/EasySwapBackend/src/common/session"
 	"github.com/ProjectsTask/EasySwapBackend/src/config"
 	"github.com/ProjectsTask/EasySwapBackend/src/dao"
 )
@@ -25,6 +27,7 @@ type ServerCtx struct {
 	KvStore  *xkv.Store
 	RankKey  string
 	NodeSrvs map[int64]*nftchainservice.Service
+	Sessions *session.Manager
 }
 
 // NewServiceContext 初始化服务上下文
@@ -79,10 +82,16 @@ func NewServiceContext(c *config.Config) (*ServerCtx, error) {
 		}
 	}
 
-	// 6. 初始化数据访问层 (DAO)
+	// 6. 初始化登录会话管理 (JWT access/refresh token)
+	sessions, err := session.NewManager(store, c.Session)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on create session manager")
+	}
+
+	// 7. 初始化数据访问层 (DAO)
 	dao := dao.New(context.Background(), db, store)
 
-	// 7. 组装 ServerCtx 对象
+	// 8. 组装 ServerCtx 对象
 	serverCtx := NewServerCtx(
 		WithDB(db),
 		WithKv(store),
@@ -92,6 +101,20 @@ func NewServiceContext(c *config.Config) (*ServerCtx, error) {
 	serverCtx.C = c
 
 	serverCtx.NodeSrvs = nodeSrvs
+	serverCtx.Sessions = sessions
 
 	return serverCtx, nil
 }
+
+// HealthChecker 返回依赖就绪检查器 (MySQL、Redis 及各链节点 RPC)
+func (s *ServerCtx) HealthChecker() *health.Checker {
+	checker := health.NewChecker(0).
+		Add("mysql", health.DBCheck(s.DB)).
+		Add("redis", health.RedisCheck(s.KvStore.Redis))
+	for _, chain := range s.C.ChainSupported {
+		if nodeSrv, ok := s.NodeSrvs[int64(chain.ChainID)]; ok {
+			checker.Add("rpc:"+chain.Name, health.ChainCheck(nodeSrv.NodeClient))
+		}
+	}
+	return checker
+}
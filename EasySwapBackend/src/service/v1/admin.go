@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/rarity"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/mq"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// 审计日志中的管理操作类型
+const (
+	AdminActionCollectionAuth    = "collection_auth"
+	AdminActionCollectionStatus  = "collection_status"
+	AdminActionCollectionSocials = "collection_socials"
+	AdminActionCollectionImport  = "collection_import"
+	AdminActionCollectionRefresh = "collection_refresh_metadata"
+	AdminActionItemStatus        = "item_status"
+	AdminActionUserAllowed       = "user_allowed"
+)
+
+// SetCollectionAuth 设置集合认证状态 (认证/取消认证)
+func SetCollectionAuth(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, chain, collectionAddr string, auth int) error {
+	if auth < 0 || auth > 2 {
+		return errcode.ErrInvalidParams
+	}
+	collectionAddr = strings.ToLower(collectionAddr)
+
+	fields := map[string]interface{}{
+		"auth":        auth,
+		"update_time": time.Now().UnixMilli(),
+	}
+	log := newAdminAuditLog(op, AdminActionCollectionAuth, chain, base.AuditTargetCollection, collectionAddr, map[string]interface{}{"auth": auth})
+	return handleAdminUpdateErr(ctx, svcCtx.Dao.UpdateCollectionByAdmin(ctx, chain, collectionAddr, fields, log), "collection not found")
+}
+
+// SetCollectionStatus 设置集合展示状态 (正常/隐藏/封禁)
+func SetCollectionStatus(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, chain, collectionAddr string, status int) error {
+	if !validDisplayStatus(status) {
+		return errcode.ErrInvalidParams
+	}
+	collectionAddr = strings.ToLower(collectionAddr)
+
+	fields := map[string]interface{}{
+		"status":      status,
+		"update_time": time.Now().UnixMilli(),
+	}
+	log := newAdminAuditLog(op, AdminActionCollectionStatus, chain, base.AuditTargetCollection, collectionAddr, map[string]interface{}{"status": status})
+	return handleAdminUpdateErr(ctx, svcCtx.Dao.UpdateCollectionByAdmin(ctx, chain, collectionAddr, fields, log), "collection not found")
+}
+
+// UpdateCollectionSocials 编辑集合社交信息, 仅更新请求中传入的字段
+func UpdateCollectionSocials(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, chain, collectionAddr string, req *types.AdminCollectionSocialsReq) error {
+	collectionAddr = strings.ToLower(collectionAddr)
+
+	fields := make(map[string]interface{})
+	for column, value := range map[string]*string{
+		"website":    req.Website,
+		"twitter":    req.Twitter,
+		"discord":    req.Discord,
+		"instagram":  req.Instagram,
+		"banner_uri": req.BannerUri,
+	} {
+		if value != nil {
+			fields[column] = strings.TrimSpace(*value)
+		}
+	}
+	if len(fields) == 0 {
+		return errcode.ErrInvalidParams
+	}
+
+	log := newAdminAuditLog(op, AdminActionCollectionSocials, chain, base.AuditTargetCollection, collectionAddr, fields)
+	fields["update_time"] = time.Now().UnixMilli()
+	return handleAdminUpdateErr(ctx, svcCtx.Dao.UpdateCollectionByAdmin(ctx, chain, collectionAddr, fields, log), "collection not found")
+}
+
+// SetItemStatus 设置 Item 展示状态 (正常/隐藏/封禁)
+func SetItemStatus(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, chain, collectionAddr, tokenID string, status int) error {
+	if !validDisplayStatus(status) {
+		return errcode.ErrInvalidParams
+	}
+	collectionAddr = strings.ToLower(collectionAddr)
+
+	target := fmt.Sprintf("%s:%s", collectionAddr, tokenID)
+	log := newAdminAuditLog(op, AdminActionItemStatus, chain, base.AuditTargetItem, target, map[string]interface{}{"status": status})
+	return handleAdminUpdateErr(ctx, svcCtx.Dao.UpdateItemStatusByAdmin(ctx, chain, collectionAddr, tokenID, status, log), "item not found")
+}
+
+// SetUserAllowed 设置用户是否允许访问
+// 封禁用户时同时撤销其所有会话, 已签发的 token 立即失效, 封禁期间不能登录及刷新 token
+// 先更新 Redis 中的封禁标记再写入用户记录及审计日志: Redis 失败时数据库不变, 重试时只记录一条审计日志;
+// 数据库失败时封禁标记已生效, 重复执行 Block/Unblock 没有副作用
+func SetUserAllowed(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, userAddr string, allowed bool) error {
+	userAddr = strings.ToLower(userAddr)
+
+	if !allowed {
+		if err := svcCtx.Sessions.Block(userAddr); err != nil {
+			xzap.WithContext(ctx).Error("failed on block user sessions", zap.Error(err), zap.String("address", userAddr))
+			return errcode.ErrUnexpected
+		}
+	} else if err := svcCtx.Sessions.Unblock(userAddr); err != nil {
+		xzap.WithContext(ctx).Error("failed on unblock user sessions", zap.Error(err), zap.String("address", userAddr))
+		return errcode.ErrUnexpected
+	}
+
+	log := newAdminAuditLog(op, AdminActionUserAllowed, "", base.AuditTargetUser, userAddr, map[string]interface{}{"allowed": allowed})
+	if err := svcCtx.Dao.UpdateUserAllowedByAdmin(ctx, userAddr, allowed, log); err != nil {
+		xzap.WithContext(ctx).Error("failed on update user allowed", zap.Error(err), zap.String("address", userAddr))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// ImportCollection 将集合加入导入任务, 由导入服务异步抓取集合及 Item 信息
+func ImportCollection(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, chain, collectionAddr string, tokenStandard int64) error {
+	if tokenStandard != 1 && tokenStandard != 2 {
+		return errcode.ErrInvalidParams
+	}
+	collectionAddr = strings.ToLower(collectionAddr)
+
+	log := newAdminAuditLog(op, AdminActionCollectionImport, chain, base.AuditTargetCollection, collectionAddr, map[string]interface{}{"token_standard": tokenStandard})
+	if err := svcCtx.Dao.CreateCollectionImportByAdmin(ctx, chain, collectionAddr, tokenStandard, log); err != nil {
+		xzap.WithContext(ctx).Error("failed on import collection", zap.Error(err), zap.String("collection_address", collectionAddr))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// RefreshCollectionMetadata 全量刷新集合元数据
+// 将集合下所有 Item 加入元数据刷新队列, 刷新完成后重新计算集合稀有度, 返回加入队列的 Item 数量
+func RefreshCollectionMetadata(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, chain string, chainID int64, collectionAddr string) (int, error) {
+	collectionAddr = strings.ToLower(collectionAddr)
+
+	tokenIDs, err := svcCtx.Dao.QueryCollectionTokenIDs(ctx, chain, collectionAddr)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on get collection token ids", zap.Error(err), zap.String("collection_address", collectionAddr))
+		return 0, errcode.ErrUnexpected
+	}
+	if len(tokenIDs) == 0 {
+		return 0, errcode.NewCustomErr("collection has no items")
+	}
+
+	// 元数据刷新完成 (或超时) 后由 sync 服务重新计算集合稀有度, 避免按旧属性计算
+	requestTime := time.Now().UnixMilli()
+	for _, tokenID := range tokenIDs {
+		if err := mq.AddSingleItemToRefreshMetadataQueue(svcCtx.KvStore, svcCtx.C.ProjectCfg.Name, chain, chainID, collectionAddr, tokenID); err != nil {
+			xzap.WithContext(ctx).Error("failed on add item to refresh queue", zap.Error(err), zap.String("collection_address", collectionAddr), zap.String("token_id", tokenID))
+			return 0, errcode.ErrUnexpected
+		}
+		if err := rarity.AddItemPendingMetadataRefresh(svcCtx.KvStore, chain, collectionAddr, tokenID, requestTime); err != nil {
+			xzap.WithContext(ctx).Error("failed on add item to pending rarity refresh", zap.Error(err), zap.String("collection_address", collectionAddr), zap.String("token_id", tokenID))
+		}
+	}
+
+	log := newAdminAuditLog(op, AdminActionCollectionRefresh, chain, base.AuditTargetCollection, collectionAddr, map[string]interface{}{"items": len(tokenIDs)})
+	if err := svcCtx.Dao.CreateAdminAuditLog(ctx, log); err != nil {
+		xzap.WithContext(ctx).Error("failed on create admin audit log", zap.Error(err), zap.String("collection_address", collectionAddr))
+		return 0, errcode.ErrUnexpected
+	}
+	return len(tokenIDs), nil
+}
+
+// GetAdminAuditLogs 分页查询管理操作审计日志
+func GetAdminAuditLogs(ctx context.Context, svcCtx *svc.ServerCtx, filter types.AdminAuditLogFilterParams) (*types.AdminAuditLogsResp, error) {
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	logs, count, err := svcCtx.Dao.QueryAdminAuditLogs(ctx, strings.ToLower(filter.AdminAddress), filter.TargetType, filter.Target, filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get admin audit logs")
+	}
+	return &types.AdminAuditLogsResp{Result: logs, Count: count}, nil
+}
+
+// newAdminAuditLog 构造审计日志, detail 记录操作参数
+func newAdminAuditLog(op types.AdminOperator, action, chain, targetType, target string, detail interface{}) *base.AdminAuditLog {
+	raw, _ := json.Marshal(detail)
+	return &base.AdminAuditLog{
+		AdminAddress: strings.ToLower(op.Address),
+		Action:       action,
+		Chain:        chain,
+		TargetType:   targetType,
+		Target:       target,
+		Detail:       string(raw),
+		Ip:           op.IP,
+	}
+}
+
+// handleAdminUpdateErr 将更新错误转换为接口错误码, 目标不存在时返回 notFoundMsg
+func handleAdminUpdateErr(ctx context.Context, err error, notFoundMsg string) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errcode.NewCustomErr(notFoundMsg)
+	}
+	xzap.WithContext(ctx).Error("failed on admin update", zap.Error(err))
+	return errcode.ErrUnexpected
+}
+
+func validDisplayStatus(status int) bool {
+	return status == multi.CollectionStatusNormal ||
+		status == multi.CollectionStatusHidden ||
+		status == multi.CollectionStatusBanned
+}
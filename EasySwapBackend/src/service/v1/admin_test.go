@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/rarity"
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/common/session"
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+var (
+	userAllowedUpsert = regexp.QuoteMeta("INSERT INTO `ob_user`")
+	auditLogInsert    = regexp.QuoteMeta("INSERT INTO `ob_admin_audit_log`")
+	testAdmin         = types.AdminOperator{Address: "0xadmin", IP: "127.0.0.1"}
+)
+
+// newMockServerCtxWithRedis 在 newMockServerCtx 的基础上使用 miniredis 初始化缓存及会话管理
+func newMockServerCtxWithRedis(t *testing.T) (*svc.ServerCtx, sqlmock.Sqlmock, *miniredis.Miniredis) {
+	svcCtx, mock := newMockServerCtx(t)
+	mr := miniredis.RunT(t)
+	kv := xkv.NewStore(cache.CacheConf{{
+		RedisConf: redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType},
+		Weight:    100,
+	}})
+	sessions, err := session.NewManager(kv, &config.Session{
+		ActiveKid: "k1",
+		Keys:      []*config.SessionKey{{Kid: "k1", Secret: "0123456789abcdef0123456789abcdef"}},
+	})
+	require.NoError(t, err)
+
+	svcCtx.C.ProjectCfg = &config.ProjectCfg{Name: "easyswap"}
+	svcCtx.KvStore = kv
+	svcCtx.Sessions = sessions
+	svcCtx.Dao = dao.New(context.Background(), svcCtx.DB, kv)
+	return svcCtx, mock, mr
+}
+
+func expectUserAllowedUpdate(mock sqlmock.Sqlmock) *sqlmock.ExpectedExec {
+	mock.ExpectBegin()
+	return mock.ExpectExec(userAllowedUpsert)
+}
+
+func TestSetUserAllowedBlocksSessions(t *testing.T) {
+	svcCtx, mock, _ := newMockServerCtxWithRedis(t)
+	pair, err := svcCtx.Sessions.Create("0xuser", "ua", "127.0.0.1")
+	require.NoError(t, err)
+
+	expectUserAllowedUpdate(mock).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(auditLogInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, SetUserAllowed(context.Background(), svcCtx, testAdmin, "0xUSER", false))
+	_, err = svcCtx.Sessions.Verify(pair.AccessToken)
+	assert.ErrorIs(t, err, session.ErrUserBlocked)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetUserAllowedRedisFailure(t *testing.T) {
+	svcCtx, mock, mr := newMockServerCtxWithRedis(t)
+
+	// 封禁标记写入失败时不修改数据库, 也不记录审计日志
+	mr.Close()
+	err := SetUserAllowed(context.Background(), svcCtx, testAdmin, "0xuser", false)
+	assert.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetUserAllowedRetryAfterDBFailure(t *testing.T) {
+	svcCtx, mock, _ := newMockServerCtxWithRedis(t)
+	pair, err := svcCtx.Sessions.Create("0xuser", "ua", "127.0.0.1")
+	require.NoError(t, err)
+
+	// 数据库写入失败时会话已被撤销, 不会留下仍可使用的会话
+	expectUserAllowedUpdate(mock).WillReturnError(assert.AnError)
+	mock.ExpectRollback()
+	err = SetUserAllowed(context.Background(), svcCtx, testAdmin, "0xuser", false)
+	assert.Error(t, err)
+	_, err = svcCtx.Sessions.Verify(pair.AccessToken)
+	assert.ErrorIs(t, err, session.ErrUserBlocked)
+
+	// 重试时只记录一条审计日志
+	expectUserAllowedUpdate(mock).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(auditLogInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	require.NoError(t, SetUserAllowed(context.Background(), svcCtx, testAdmin, "0xuser", false))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetUserAllowedUnblocks(t *testing.T) {
+	svcCtx, mock, _ := newMockServerCtxWithRedis(t)
+	require.NoError(t, svcCtx.Sessions.Block("0xuser"))
+
+	expectUserAllowedUpdate(mock).WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectExec(auditLogInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, SetUserAllowed(context.Background(), svcCtx, testAdmin, "0xuser", true))
+	_, err := svcCtx.Sessions.Create("0xuser", "ua", "127.0.0.1")
+	assert.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshCollectionMetadataDefersRarity(t *testing.T) {
+	svcCtx, mock, mr := newMockServerCtxWithRedis(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, token_id FROM `ob_item_sepolia` WHERE collection_address = ? and id > ?")).
+		WithArgs("0xa", 0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "token_id"}).AddRow(1, "1").AddRow(2, "2"))
+	mock.ExpectBegin()
+	mock.ExpectExec(auditLogInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	count, err := RefreshCollectionMetadata(context.Background(), svcCtx, testAdmin, "sepolia", 11155111, "0xA")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// 每个 Item 登记为等待元数据刷新, 集合不直接加入稀有度重算队列
+	pending, err := rarity.ListPendingMetadataRefresh(svcCtx.KvStore, "sepolia")
+	require.NoError(t, err)
+	tokenIDs := make([]string, 0, len(pending))
+	for _, item := range pending {
+		assert.Equal(t, "0xa", item.CollectionAddr)
+		tokenIDs = append(tokenIDs, item.TokenId)
+	}
+	assert.ElementsMatch(t, []string{"1", "2"}, tokenIDs)
+	assert.False(t, mr.Exists(rarity.GenRarityRefreshCacheKey("sepolia")))
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+const (
+	AdminActionApiKeyIssue  = "api_key_issue"
+	AdminActionApiKeyRevoke = "api_key_revoke"
+
+	apiKeyPrefix       = "es_"
+	apiKeyDisplayChars = 10 // 展示用前缀长度, 包含 "es_"
+	maxApiKeyUsageDays = 90
+)
+
+// IssueApiKey 为合作方签发 API key, 明文仅在响应中返回一次
+func IssueApiKey(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, req *types.AdminApiKeyCreateReq) (*types.AdminApiKeyIssued, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" || req.RateLimit < 0 {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, errors.Wrap(err, "failed on generate api key")
+	}
+	key := apiKeyPrefix + hex.EncodeToString(raw)
+
+	apiKey := base.ApiKey{
+		Name:      name,
+		Owner:     strings.ToLower(req.Owner),
+		KeyPrefix: key[:apiKeyDisplayChars],
+		KeyHash:   dao.HashApiKey(key),
+		RateLimit: req.RateLimit,
+		Status:    base.ApiKeyStatusActive,
+	}
+	log := newAdminAuditLog(op, AdminActionApiKeyIssue, "", base.AuditTargetApiKey, "", map[string]interface{}{
+		"name":       apiKey.Name,
+		"owner":      apiKey.Owner,
+		"key_prefix": apiKey.KeyPrefix,
+		"rate_limit": apiKey.RateLimit,
+	})
+	if err := svcCtx.Dao.CreateApiKeyByAdmin(ctx, &apiKey, log); err != nil {
+		xzap.WithContext(ctx).Error("failed on issue api key", zap.Error(err), zap.String("name", name))
+		return nil, errcode.ErrUnexpected
+	}
+
+	return &types.AdminApiKeyIssued{ApiKey: apiKey, Key: key}, nil
+}
+
+// RevokeApiKey 撤销 API key, 立即生效
+func RevokeApiKey(ctx context.Context, svcCtx *svc.ServerCtx, op types.AdminOperator, id int64) error {
+	log := newAdminAuditLog(op, AdminActionApiKeyRevoke, "", base.AuditTargetApiKey, strconv.FormatInt(id, 10), map[string]interface{}{"id": id})
+	return handleAdminUpdateErr(ctx, svcCtx.Dao.RevokeApiKeyByAdmin(ctx, id, log), "api key not found")
+}
+
+// GetApiKeys 查询所有 API key
+func GetApiKeys(ctx context.Context, svcCtx *svc.ServerCtx) (*types.AdminApiKeysResp, error) {
+	apiKeys, err := svcCtx.Dao.QueryApiKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get api keys")
+	}
+	return &types.AdminApiKeysResp{Result: apiKeys}, nil
+}
+
+// GetApiKeyUsage 查询 API key 最近 days 天的用量
+func GetApiKeyUsage(ctx context.Context, svcCtx *svc.ServerCtx, id int64, days int) (*types.AdminApiKeyUsageResp, error) {
+	if days <= 0 || days > maxApiKeyUsageDays {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	usage, err := svcCtx.Dao.QueryApiKeyUsage(id, days)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get api key usage")
+	}
+
+	result := make([]types.ApiKeyDailyUsage, 0, len(usage))
+	for date, fields := range usage {
+		daily := types.ApiKeyDailyUsage{Date: date, Routes: make(map[string]int64)}
+		for field, value := range fields {
+			count, _ := strconv.ParseInt(value, 10, 64)
+			switch field {
+			case dao.ApiKeyUsageTotal:
+				daily.Total = count
+			case dao.ApiKeyUsageRejected:
+				daily.Rejected = count
+			default:
+				daily.Routes[field] = count
+			}
+		}
+		result = append(result, daily)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Date > result[j].Date
+	})
+	return &types.AdminApiKeyUsageResp{Result: result}, nil
+}
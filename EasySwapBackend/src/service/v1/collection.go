@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ProjectsTask/EasySwapBase/errcode"
 	"github.com/ProjectsTask/EasySwapBase/evm/eip"
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
 	"github.com/ProjectsTask/EasySwapBase/ordermanager"
+	"github.com/ProjectsTask/EasySwapBase/rarity"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
@@ -219,6 +221,9 @@ func GetItems(ctx context.Context, svcCtx *svc.ServerCtx, chain string, filter t
 			OwnerAddress:      item.Owner,
 			ListPrice:         item.ListPrice,
 			MarketID:          item.MarketID,
+			RarityScore:       item.RarityScore,
+			RarityRank:        item.RarityRank,
+			TraitCountRank:    item.TraitCountRank,
 			// 默认使用 Collection Best Bid
 			BidOrderID:    collectionBestBid.OrderID,
 			BidExpireTime: collectionBestBid.ExpireTime,
@@ -411,12 +416,57 @@ func GetItem(ctx context.Context, svcCtx *svc.ServerCtx, chain string, chainID i
 		collectionBestBid = bid
 	}()
 
+	// 8. [并发任务 8] 查询 Item 各属性的地板价 (Trait Floor Price)
+	var traitFloorPrices []multi.TraitFloorPrice
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		prices, err := svcCtx.Dao.QueryItemTraitFloorPrices(ctx, chain, collectionAddr, tokenID)
+		if err != nil {
+			queryErr = errors.Wrap(err, "failed on get item trait floor prices")
+			return
+		}
+		traitFloorPrices = prices
+	}()
+
+	// 9. [并发任务 9] 查询适用于该 Item 的最高属性出价 (Trait Bid)
+	// 属性出价由 backend 撮合, 不参与下方 Item/Collection 最佳出价的比较
+	var traitBid *types.ItemBid
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		bid, err := getItemBestTraitBid(ctx, svcCtx, chain, collectionAddr, tokenID)
+		if err != nil {
+			queryErr = err
+			return
+		}
+		traitBid = bid
+	}()
+
+	// 10. [并发任务 10] 查询 Item 收藏数
+	var favoriteCount int64
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		count, err := svcCtx.Dao.QueryItemFavoriteCount(ctx, chainID, strings.ToLower(collectionAddr), tokenID)
+		if err != nil {
+			queryErr = errors.Wrap(err, "failed on get item favorite count")
+			return
+		}
+		favoriteCount = count
+	}()
+
 	// 等待所有查询完成
 	wg.Wait()
 	if queryErr != nil {
 		return nil, errors.Wrap(queryErr, "failed on get items info")
 	}
 
+	// 集合或 Item 被管理员封禁后不再对外展示
+	if collection.Status == multi.CollectionStatusBanned || item.Status == multi.ItemStatusBanned {
+		return nil, errcode.NewCustomErr("item is banned")
+	}
+
 	// 组装返回数据
 	var itemDetail types.ItemDetailInfo
 	itemDetail.ChainID = chainID
@@ -427,6 +477,7 @@ func GetItem(ctx context.Context, svcCtx *svc.ServerCtx, chain string, chainID i
 		itemDetail.CollectionAddress = item.CollectionAddress
 		itemDetail.TokenID = item.TokenId
 		itemDetail.OwnerAddress = item.Owner
+		itemDetail.Views = item.Views
 		// 默认填充 Collection 级别的最高出价信息
 		itemDetail.BidOrderID = collectionBestBid.OrderID
 		itemDetail.BidExpireTime = collectionBestBid.ExpireTime
@@ -466,6 +517,18 @@ func GetItem(ctx context.Context, svcCtx *svc.ServerCtx, chain string, chainID i
 		itemDetail.ListExpireTime = itemListInfo.ListExpireTime
 		itemDetail.ListSalt = itemListInfo.ListSalt
 		itemDetail.ListMaker = itemListInfo.ListMaker
+
+		lineage, err := svcCtx.Dao.QueryOrderLineage(ctx, chain, itemListInfo.OrderID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get listing price history")
+		}
+		for _, order := range lineage {
+			itemDetail.ListPriceHistory = append(itemDetail.ListPriceHistory, types.OrderPrice{
+				OrderID:   order.OrderID,
+				Price:     order.Price,
+				EventTime: order.EventTime,
+			})
+		}
 	}
 
 	// 4. 设置 Collection 信息
@@ -504,6 +567,20 @@ func GetItem(ctx context.Context, svcCtx *svc.ServerCtx, chain string, chainID i
 		}
 	}
 
+	// 7. 设置属性地板价及属性出价
+	itemDetail.TraitFloorPrices = make([]types.TraitPrice, 0, len(traitFloorPrices))
+	for _, fp := range traitFloorPrices {
+		itemDetail.TraitFloorPrices = append(itemDetail.TraitFloorPrices, types.TraitPrice{
+			CollectionAddress: fp.CollectionAddress,
+			TokenID:           tokenID,
+			Trait:             fp.Trait,
+			TraitValue:        fp.TraitValue,
+			Price:             fp.Price,
+		})
+	}
+	itemDetail.TraitOffer = traitBid
+	itemDetail.FavoriteCount = favoriteCount
+
 	return &types.ItemDetailInfoResp{
 		Result: itemDetail,
 	}, nil
@@ -754,6 +831,9 @@ func GetCollectionDetail(ctx context.Context, svcCtx *svc.ServerCtx, chain strin
 	if err != nil {
 		return nil, errors.Wrap(err, "failed on get collection info")
 	}
+	if collection.Status == multi.CollectionStatusBanned {
+		return nil, errcode.NewCustomErr("collection is banned")
+	}
 
 	// 2. 获取集合 24小时 交易统计信息 (Volume, Sales)
 	tradeInfos, err := svcCtx.Dao.GetTradeInfoByCollection(chain, collectionAddr, "1d")
@@ -846,6 +926,11 @@ func RefreshItemMetadata(ctx context.Context, svcCtx *svc.ServerCtx, chainName s
 		return errcode.ErrUnexpected
 	}
 
+	// 元数据刷新后属性可能变化, 由 sync 服务在刷新完成后重新计算集合稀有度
+	if err := rarity.AddItemPendingMetadataRefresh(svcCtx.KvStore, chainName, collectionAddress, tokenId, time.Now().UnixMilli()); err != nil {
+		xzap.WithContext(ctx).Error("failed on add item to pending rarity refresh", zap.Error(err), zap.String("collection address: ", collectionAddress))
+	}
+
 	return nil
 
 }
@@ -0,0 +1,599 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+const (
+	ExportKindActivity = "activity"
+
+	maxActiveExportJobs  = 3               // 每个用户同时等待或执行中的导出任务数
+	exportJobLease       = 300             // 执行中的任务超过该时长 (秒) 没有进度时可被重新领取
+	exportCleanupBatch   = 100             // 每轮清理的过期文件数
+	exportChunkSize      = 1 << 20         // 导出文件分块大小 (字节)
+	exportReleaseTimeout = 5 * time.Second // 服务退出时归还任务的超时时间
+
+	defaultExportInterval    = 5
+	defaultExportBatchSize   = 1000
+	defaultExportSyncMaxRows = 10000
+	defaultExportFileTTL     = 7 * DaySeconds
+	defaultExportMaxAttempts = 3
+)
+
+// activityExportHeader CSV 表头, 与 ActivityExportRow 的 json 字段一致
+var activityExportHeader = []string{
+	"chain_id", "chain", "event_type", "collection_address", "token_id", "maker", "taker", "marketplace_id",
+	"currency", "price_eth", "fee_eth", "net_proceeds_eth", "tx_hash", "block_number", "block_time", "block_time_utc",
+}
+
+// exportConfig 返回填充默认值后的导出配置
+func exportConfig(svcCtx *svc.ServerCtx) config.Export {
+	var cfg config.Export
+	if svcCtx.C.Export != nil {
+		cfg = *svcCtx.C.Export
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = defaultExportInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultExportBatchSize
+	}
+	if cfg.SyncMaxRows <= 0 {
+		cfg.SyncMaxRows = defaultExportSyncMaxRows
+	}
+	if cfg.FileTTL <= 0 {
+		cfg.FileTTL = defaultExportFileTTL
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultExportMaxAttempts
+	}
+	return cfg
+}
+
+// ActivityExport 校验后的活动导出请求
+type ActivityExport struct {
+	svcCtx     *svc.ServerCtx
+	format     string
+	chainIDs   []int
+	chainNames []string
+	filter     *dao.ActivityExportFilter
+	batchSize  int
+}
+
+// newActivityExport 校验导出参数, 链为空时导出全部支持的链
+func newActivityExport(svcCtx *svc.ServerCtx, params *types.ActivityExportFilterParams) (*ActivityExport, error) {
+	e := &ActivityExport{
+		svcCtx:    svcCtx,
+		format:    params.Format,
+		batchSize: exportConfig(svcCtx).BatchSize,
+		filter: &dao.ActivityExportFilter{
+			TokenID:   params.TokenID,
+			StartTime: params.StartTime,
+			EndTime:   params.EndTime,
+		},
+	}
+	if e.format == "" {
+		e.format = types.ExportFormatCSV
+	}
+	if e.format != types.ExportFormatCSV && e.format != types.ExportFormatNDJSON {
+		return nil, errcode.NewCustomErr("unsupported export format")
+	}
+	if params.StartTime < 0 || params.EndTime < 0 || (params.EndTime > 0 && params.EndTime < params.StartTime) {
+		return nil, errcode.NewCustomErr("invalid time range")
+	}
+
+	for _, chain := range svcCtx.C.ChainSupported {
+		if len(params.ChainID) == 0 {
+			e.chainIDs = append(e.chainIDs, chain.ChainID)
+			e.chainNames = append(e.chainNames, chain.Name)
+			continue
+		}
+		for _, chainID := range params.ChainID {
+			if chainID == chain.ChainID {
+				e.chainIDs = append(e.chainIDs, chain.ChainID)
+				e.chainNames = append(e.chainNames, chain.Name)
+				break
+			}
+		}
+	}
+	if len(e.chainIDs) == 0 || (len(params.ChainID) > 0 && len(e.chainIDs) != len(params.ChainID)) {
+		return nil, errcode.ErrInvalidParams
+	}
+
+	for _, addr := range params.CollectionAddresses {
+		e.filter.CollectionAddrs = append(e.filter.CollectionAddrs, strings.ToLower(addr))
+	}
+	for _, addr := range params.UserAddresses {
+		e.filter.UserAddrs = append(e.filter.UserAddrs, strings.ToLower(addr))
+	}
+	for _, eventType := range params.EventTypes {
+		id, ok := dao.ActivityEventTypeID(eventType)
+		if !ok {
+			return nil, errcode.NewCustomErr("unknown event type: " + eventType)
+		}
+		e.filter.EventTypes = append(e.filter.EventTypes, id)
+	}
+	return e, nil
+}
+
+// PrepareActivityExport 校验同步导出请求, 满足条件的行数超过 sync_max_rows 时需改为创建导出任务
+func PrepareActivityExport(ctx context.Context, svcCtx *svc.ServerCtx, params *types.ActivityExportFilterParams) (*ActivityExport, error) {
+	e, err := newActivityExport(svcCtx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var total int64
+	for _, chain := range e.chainNames {
+		count, err := svcCtx.Dao.CountExportActivities(ctx, chain, e.filter)
+		if err != nil {
+			xzap.WithContext(ctx).Error("failed on count export activities", zap.Error(err), zap.String("chain", chain))
+			return nil, errcode.ErrUnexpected
+		}
+		total += count
+	}
+	if total > exportConfig(svcCtx).SyncMaxRows {
+		return nil, errcode.NewCustomErr("too many rows to export, please create an export job")
+	}
+	return e, nil
+}
+
+// ContentType 导出文件的 MIME 类型
+func (e *ActivityExport) ContentType() string {
+	return ExportContentType(e.format)
+}
+
+// ExportContentType 导出格式对应的 MIME 类型
+func ExportContentType(format string) string {
+	if format == types.ExportFormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+// FileName 导出文件的默认文件名
+func (e *ActivityExport) FileName() string {
+	return fmt.Sprintf("activities-%d.%s", time.Now().Unix(), e.format)
+}
+
+// Write 按链依次分批查询活动并写入 w, 每条链内按活动 id 升序
+// 每写完一批回调 progress (可为 nil) 并在 w 支持时 flush, 返回写入的行数
+func (e *ActivityExport) Write(ctx context.Context, w io.Writer, progress func(rows int64) error) (int64, error) {
+	var csvWriter *csv.Writer
+	var jsonEncoder *json.Encoder
+	if e.format == types.ExportFormatCSV {
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(activityExportHeader); err != nil {
+			return 0, errors.Wrap(err, "failed on write export header")
+		}
+	} else {
+		jsonEncoder = json.NewEncoder(w)
+	}
+
+	var rows int64
+	for i, chain := range e.chainNames {
+		fees, err := loadFeeSchedule(ctx, e.svcCtx, chain)
+		if err != nil {
+			return rows, errors.Wrap(err, "failed on get protocol shares")
+		}
+
+		var afterID int64
+		for {
+			activities, err := e.svcCtx.Dao.QueryExportActivities(ctx, chain, e.filter, afterID, e.batchSize)
+			if err != nil {
+				return rows, errors.Wrap(err, "failed on get export activities")
+			}
+			for _, activity := range activities {
+				row := e.row(e.chainIDs[i], chain, &activity, fees)
+				if csvWriter != nil {
+					err = csvWriter.Write(activityExportRecord(&row))
+				} else {
+					err = jsonEncoder.Encode(&row)
+				}
+				if err != nil {
+					return rows, errors.Wrap(err, "failed on write export row")
+				}
+				rows++
+			}
+
+			if csvWriter != nil {
+				csvWriter.Flush()
+				if err := csvWriter.Error(); err != nil {
+					return rows, errors.Wrap(err, "failed on flush export rows")
+				}
+			}
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+			if progress != nil {
+				if err := progress(rows); err != nil {
+					return rows, err
+				}
+			}
+
+			if len(activities) < e.batchSize {
+				break
+			}
+			afterID = activities[len(activities)-1].Id
+		}
+	}
+	return rows, nil
+}
+
+// row 将活动转换为导出行, 订单簿成交按成交时的协议手续费率计算手续费及卖方净收入
+func (e *ActivityExport) row(chainID int, chain string, activity *multi.Activity, fees feeSchedule) types.ActivityExportRow {
+	row := types.ActivityExportRow{
+		ChainID:           chainID,
+		Chain:             chain,
+		EventType:         dao.ActivityEventTypeName(activity.ActivityType),
+		CollectionAddress: activity.CollectionAddress,
+		TokenID:           activity.TokenId,
+		Maker:             activity.Maker,
+		Taker:             activity.Taker,
+		MarketplaceID:     activity.MarketplaceID,
+		Currency:          activity.CurrencyAddress,
+		PriceEth:          activity.Price.Shift(-18).String(),
+		TxHash:            activity.TxHash,
+		BlockNumber:       activity.BlockNumber,
+		BlockTime:         activity.EventTime,
+		BlockTimeUTC:      time.Unix(activity.EventTime, 0).UTC().Format(time.RFC3339),
+	}
+	if activity.ActivityType == multi.Sale && activity.MarketplaceID == multi.MarketOrderBook {
+		// 活动记录没有日志序号, 同一区块内的费率变更视为在成交之前生效
+		fee := shareFee(activity.Price, fees.shareAt(activity.BlockNumber, math.MaxInt64))
+		row.FeeEth = fee.Shift(-18).String()
+		row.NetProceedsEth = activity.Price.Sub(fee).Shift(-18).String()
+	}
+	return row
+}
+
+func activityExportRecord(row *types.ActivityExportRow) []string {
+	return []string{
+		strconv.Itoa(row.ChainID), row.Chain, row.EventType, row.CollectionAddress, row.TokenID, row.Maker, row.Taker,
+		strconv.Itoa(row.MarketplaceID), row.Currency, row.PriceEth, row.FeeEth, row.NetProceedsEth, row.TxHash,
+		strconv.FormatInt(row.BlockNumber, 10), strconv.FormatInt(row.BlockTime, 10), row.BlockTimeUTC,
+	}
+}
+
+// CreateActivityExportJob 创建异步活动导出任务, 由后台任务分批导出到文件
+func CreateActivityExportJob(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, params *types.ActivityExportFilterParams) (*types.ExportJobResp, error) {
+	if svcCtx.C.Export == nil || !svcCtx.C.Export.Enabled {
+		return nil, errcode.NewCustomErr("export job is not enabled")
+	}
+	e, err := newActivityExport(svcCtx, params)
+	if err != nil {
+		return nil, err
+	}
+	params.Format = e.format
+
+	userAddr = strings.ToLower(userAddr)
+	count, err := svcCtx.Dao.CountUserActiveExportJobs(ctx, userAddr)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on count active export jobs", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+	if count >= maxActiveExportJobs {
+		return nil, errcode.NewCustomErr("too many export jobs in progress")
+	}
+
+	raw, err := json.Marshal(params)
+	if err != nil {
+		return nil, errcode.ErrInvalidParams
+	}
+	job := &base.ExportJob{
+		UserAddress: userAddr,
+		Kind:        ExportKindActivity,
+		Format:      e.format,
+		Params:      string(raw),
+		Status:      base.ExportJobStatusPending,
+	}
+	if err := svcCtx.Dao.CreateExportJob(ctx, job); err != nil {
+		xzap.WithContext(ctx).Error("failed on create export job", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+	return &types.ExportJobResp{Result: exportJobInfo(job)}, nil
+}
+
+// GetExportJobs 分页查询用户的导出任务
+func GetExportJobs(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, filter types.ExportJobFilterParams) (*types.ExportJobsResp, error) {
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	jobs, count, err := svcCtx.Dao.QueryUserExportJobs(ctx, strings.ToLower(userAddr), filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get export jobs")
+	}
+	result := make([]types.ExportJobInfo, 0, len(jobs))
+	for i := range jobs {
+		result = append(result, *exportJobInfo(&jobs[i]))
+	}
+	return &types.ExportJobsResp{Result: result, Count: count}, nil
+}
+
+// GetExportJob 查询用户的指定导出任务
+func GetExportJob(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, id int64) (*types.ExportJobResp, error) {
+	job, err := svcCtx.Dao.QueryUserExportJob(ctx, strings.ToLower(userAddr), id)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on get export job", zap.Error(err), zap.Int64("id", id))
+		return nil, errcode.ErrUnexpected
+	}
+	if job == nil {
+		return nil, errcode.NewCustomErr("export job not found")
+	}
+	return &types.ExportJobResp{Result: exportJobInfo(job)}, nil
+}
+
+// exportJobInfo 填充已完成且未过期任务的下载链接
+func exportJobInfo(job *base.ExportJob) *types.ExportJobInfo {
+	info := &types.ExportJobInfo{ExportJob: *job}
+	if job.Status == base.ExportJobStatusDone && job.ExpireTime > time.Now().Unix() {
+		info.DownloadURL = "/api/v1/export-files/" + job.DownloadToken
+	}
+	return info
+}
+
+// GetExportFile 按下载凭证查询可下载的导出任务
+func GetExportFile(ctx context.Context, svcCtx *svc.ServerCtx, token string) (*base.ExportJob, error) {
+	if token == "" {
+		return nil, errcode.NewCustomErr("export file not found")
+	}
+	job, err := svcCtx.Dao.QueryExportJobByToken(ctx, token)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on get export job by token", zap.Error(err))
+		return nil, errcode.ErrUnexpected
+	}
+	if job == nil || job.ExpireTime <= time.Now().Unix() {
+		return nil, errcode.NewCustomErr("export file not found")
+	}
+	return job, nil
+}
+
+// WriteExportFile 按分块顺序将导出文件写入 w, 每次只读取一个分块
+func WriteExportFile(ctx context.Context, svcCtx *svc.ServerCtx, job *base.ExportJob, w io.Writer) (int64, error) {
+	var written int64
+	for seq := 0; ; seq++ {
+		chunk, err := svcCtx.Dao.QueryExportFileChunk(ctx, job.Id, job.Attempts, seq)
+		if err != nil {
+			return written, err
+		}
+		if chunk == nil {
+			break
+		}
+		n, err := w.Write(chunk.Data)
+		written += int64(n)
+		if err != nil {
+			return written, errors.Wrap(err, "failed on write export file")
+		}
+	}
+	if written != job.FileSize {
+		return written, errors.Errorf("export file size mismatch, expected %d, got %d", job.FileSize, written)
+	}
+	return written, nil
+}
+
+// RunExportJobs 后台执行导出任务, 阻塞直到 ctx 结束
+// 每轮先清理过期文件及超过领取次数的任务, 再依次领取并执行待处理的任务; ctx 结束时归还正在执行的任务
+func RunExportJobs(ctx context.Context, svcCtx *svc.ServerCtx) {
+	cfg := exportConfig(svcCtx)
+	ticker := time.NewTicker(time.Duration(cfg.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		cleanupExportFiles(ctx, svcCtx)
+		if count, err := svcCtx.Dao.FailExhaustedExportJobs(ctx, exportJobLease, cfg.MaxAttempts); err != nil {
+			xzap.WithContext(ctx).Error("failed on fail exhausted export jobs", zap.Error(err))
+		} else if count > 0 {
+			xzap.WithContext(ctx).Warn("export jobs exceeded max attempts", zap.Int64("count", count))
+		}
+		for ctx.Err() == nil {
+			job, err := svcCtx.Dao.ClaimExportJob(ctx, exportJobLease, cfg.MaxAttempts)
+			if err != nil {
+				xzap.WithContext(ctx).Error("failed on claim export job", zap.Error(err))
+				break
+			}
+			if job == nil {
+				break
+			}
+			runExportJob(ctx, svcCtx, cfg, job)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runExportJob 执行单个导出任务: 分块写入数据库, 完成后生成下载凭证
+// 服务退出时归还任务, 任务已被其他实例重新领取时直接放弃, 其余错误将任务标记为失败
+func runExportJob(ctx context.Context, svcCtx *svc.ServerCtx, cfg config.Export, job *base.ExportJob) {
+	// 清理之前执行 (租约过期被重新领取) 遗留的分块
+	err := svcCtx.Dao.DeleteExportFileChunks(ctx, job.Id, job.Attempts-1)
+	var rows, size int64
+	if err == nil {
+		rows, size, err = writeExportFile(ctx, svcCtx, job)
+	}
+	if err != nil {
+		if errors.Is(err, dao.ErrExportJobLost) {
+			xzap.WithContext(ctx).Warn("export job claimed by another worker", zap.Int64("id", job.Id))
+			return
+		}
+		if ctx.Err() != nil {
+			releaseExportJob(svcCtx, job)
+			return
+		}
+
+		xzap.WithContext(ctx).Error("failed on run export job", zap.Error(err), zap.Int64("id", job.Id))
+		if err := svcCtx.Dao.DeleteExportFileChunks(ctx, job.Id, job.Attempts); err != nil {
+			xzap.WithContext(ctx).Error("failed on delete export file chunks", zap.Error(err), zap.Int64("id", job.Id))
+		}
+		lastError := err.Error()
+		if len(lastError) > 512 {
+			lastError = lastError[:512]
+		}
+		if err := svcCtx.Dao.UpdateClaimedExportJob(ctx, job, map[string]interface{}{
+			"status":     base.ExportJobStatusFailed,
+			"last_error": lastError,
+		}); err != nil {
+			xzap.WithContext(ctx).Error("failed on update export job", zap.Error(err), zap.Int64("id", job.Id))
+		}
+		return
+	}
+
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		xzap.WithContext(ctx).Error("failed on generate download token", zap.Error(err))
+		return
+	}
+	if err := svcCtx.Dao.UpdateClaimedExportJob(ctx, job, map[string]interface{}{
+		"status":         base.ExportJobStatusDone,
+		"rows":           rows,
+		"file_name":      fmt.Sprintf("%s-%d.%s", job.Kind, job.Id, job.Format),
+		"file_size":      size,
+		"download_token": hex.EncodeToString(raw),
+		"expire_time":    time.Now().Unix() + cfg.FileTTL,
+	}); err != nil {
+		xzap.WithContext(ctx).Error("failed on update export job", zap.Error(err), zap.Int64("id", job.Id))
+	}
+}
+
+// releaseExportJob 服务退出时删除本次执行的分块并归还任务, ctx 已取消, 使用独立的超时 context
+func releaseExportJob(svcCtx *svc.ServerCtx, job *base.ExportJob) {
+	ctx, cancel := context.WithTimeout(context.Background(), exportReleaseTimeout)
+	defer cancel()
+	if err := svcCtx.Dao.DeleteExportFileChunks(ctx, job.Id, job.Attempts); err != nil {
+		xzap.WithContext(ctx).Error("failed on delete export file chunks", zap.Error(err), zap.Int64("id", job.Id))
+	}
+	if err := svcCtx.Dao.ReleaseExportJob(ctx, job); err != nil {
+		xzap.WithContext(ctx).Error("failed on release export job", zap.Error(err), zap.Int64("id", job.Id))
+		return
+	}
+	xzap.WithContext(ctx).Info("export job released", zap.Int64("id", job.Id))
+}
+
+func writeExportFile(ctx context.Context, svcCtx *svc.ServerCtx, job *base.ExportJob) (int64, int64, error) {
+	if job.Kind != ExportKindActivity {
+		return 0, 0, errors.Errorf("unsupported export kind: %s", job.Kind)
+	}
+	var params types.ActivityExportFilterParams
+	if err := json.Unmarshal([]byte(job.Params), &params); err != nil {
+		return 0, 0, errors.Wrap(err, "failed on unmarshal export params")
+	}
+	e, err := newActivityExport(svcCtx, &params)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "invalid export params")
+	}
+
+	// 每批写入后更新进度, 同时续期任务租约
+	w := newExportChunkWriter(ctx, svcCtx, job, exportChunkSize)
+	rows, err := e.Write(ctx, w, func(rows int64) error {
+		return svcCtx.Dao.UpdateClaimedExportJob(ctx, job, map[string]interface{}{"rows": rows})
+	})
+	if err != nil {
+		return rows, 0, err
+	}
+	if err := w.Close(); err != nil {
+		return rows, 0, err
+	}
+	return rows, w.written, nil
+}
+
+// exportChunkWriter 将导出文件按固定大小分块写入数据库, Close 时写入最后不足一块的数据
+type exportChunkWriter struct {
+	ctx       context.Context
+	svcCtx    *svc.ServerCtx
+	job       *base.ExportJob
+	chunkSize int
+	buf       []byte
+	seq       int
+	written   int64
+}
+
+func newExportChunkWriter(ctx context.Context, svcCtx *svc.ServerCtx, job *base.ExportJob, chunkSize int) *exportChunkWriter {
+	return &exportChunkWriter{ctx: ctx, svcCtx: svcCtx, job: job, chunkSize: chunkSize}
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= w.chunkSize {
+		if err := w.flush(w.buf[:w.chunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[w.chunkSize:]...)
+	}
+	w.written += int64(len(p))
+	return len(p), nil
+}
+
+// Close 写入剩余数据
+func (w *exportChunkWriter) Close() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	if err := w.flush(w.buf); err != nil {
+		return err
+	}
+	w.buf = w.buf[:0]
+	return nil
+}
+
+func (w *exportChunkWriter) flush(data []byte) error {
+	if err := w.svcCtx.Dao.CreateExportFileChunk(w.ctx, &base.ExportFileChunk{
+		JobId:   w.job.Id,
+		Attempt: w.job.Attempts,
+		Seq:     w.seq,
+		Data:    data,
+	}); err != nil {
+		return err
+	}
+	w.seq++
+	return nil
+}
+
+// cleanupExportFiles 删除已过期的导出文件并将任务标记为已过期
+func cleanupExportFiles(ctx context.Context, svcCtx *svc.ServerCtx) {
+	jobs, err := svcCtx.Dao.QueryExpiredExportJobs(ctx, time.Now().Unix(), exportCleanupBatch)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on get expired export jobs", zap.Error(err))
+		return
+	}
+	for _, job := range jobs {
+		if err := svcCtx.Dao.DeleteExportFileChunks(ctx, job.Id, job.Attempts); err != nil {
+			xzap.WithContext(ctx).Warn("failed on remove export file", zap.Error(err), zap.Int64("id", job.Id))
+			continue
+		}
+		if err := svcCtx.Dao.UpdateExportJob(ctx, job.Id, map[string]interface{}{
+			"status":         base.ExportJobStatusExpired,
+			"download_token": "",
+		}); err != nil {
+			xzap.WithContext(ctx).Error("failed on update export job", zap.Error(err), zap.Int64("id", job.Id))
+		}
+	}
+}
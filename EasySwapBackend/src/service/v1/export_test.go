@@ -0,0 +1,285 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/dao"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+var (
+	protocolShareQuery  = regexp.QuoteMeta("FROM `ob_protocol_share_sepolia`")
+	exportActivityQuery = regexp.QuoteMeta("FROM `ob_activity_sepolia` WHERE id > ? ORDER BY id asc LIMIT 1000")
+	chunkInsert         = regexp.QuoteMeta("INSERT INTO `ob_export_file_chunk`")
+	chunkDelete         = regexp.QuoteMeta("DELETE FROM `ob_export_file_chunk` WHERE job_id = ? and attempt <= ?")
+	claimedJobUpdate    = regexp.QuoteMeta("UPDATE `ob_export_job` SET")
+	activityColumns     = []string{"id", "activity_type", "maker", "taker", "marketplace_id", "collection_address",
+		"token_id", "currency_address", "price", "block_number", "tx_hash", "event_time"}
+)
+
+func TestMain(m *testing.M) {
+	if _, err := xzap.SetUp(logging.LogConf{ServiceName: "service_test", Mode: "console", Path: os.TempDir(), Level: "error"}); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func newMockServerCtx(t *testing.T) (*svc.ServerCtx, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	c := &config.Config{
+		ChainSupported: []*config.ChainSupported{{Name: "sepolia", ChainID: 11155111}},
+		Export:         &config.Export{Enabled: true},
+	}
+	return &svc.ServerCtx{C: c, DB: db, Dao: dao.New(context.Background(), db, nil)}, mock
+}
+
+// expectActivities 协议费率 2%, 一笔订单簿成交及一笔挂单
+func expectActivities(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(protocolShareQuery).
+		WillReturnRows(sqlmock.NewRows([]string{"share", "block_number", "log_index", "event_time"}).AddRow(200, 1, 0, 1))
+	mock.ExpectQuery(exportActivityQuery).
+		WithArgs(int64(0)).
+		WillReturnRows(sqlmock.NewRows(activityColumns).
+			AddRow(1, multi.Sale, "0xmaker", "0xtaker", multi.MarketOrderBook, "0xa", "1", "0x0", "1000000000000000000", 10, "0xtx1", 1700000000).
+			AddRow(2, multi.Listing, "0xmaker", "", multi.MarketOrderBook, "0xa", "2", "0x0", "500000000000000000", 11, "0xtx2", 1700000060))
+}
+
+func TestActivityExportWriteCSV(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	expectActivities(mock)
+
+	e, err := newActivityExport(svcCtx, &types.ActivityExportFilterParams{})
+	require.NoError(t, err)
+	assert.Equal(t, "text/csv", e.ContentType())
+
+	var buf bytes.Buffer
+	var progress []int64
+	rows, err := e.Write(context.Background(), &buf, func(rows int64) error {
+		progress = append(progress, rows)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rows)
+	assert.Equal(t, []int64{2}, progress)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t, strings.Join(activityExportHeader, ","), lines[0])
+	assert.Equal(t, "11155111,sepolia,sale,0xa,1,0xmaker,0xtaker,0,0x0,1,0.02,0.98,0xtx1,10,1700000000,2023-11-14T22:13:20Z", lines[1])
+	// 挂单没有手续费及净收入
+	assert.Equal(t, "11155111,sepolia,list,0xa,2,0xmaker,,0,0x0,0.5,,,0xtx2,11,1700000060,2023-11-14T22:14:20Z", lines[2])
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestActivityExportWriteNDJSON(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	expectActivities(mock)
+
+	e, err := newActivityExport(svcCtx, &types.ActivityExportFilterParams{Format: types.ExportFormatNDJSON})
+	require.NoError(t, err)
+	assert.Equal(t, "application/x-ndjson", e.ContentType())
+
+	var buf bytes.Buffer
+	rows, err := e.Write(context.Background(), &buf, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), rows)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+	var row types.ActivityExportRow
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &row))
+	assert.Equal(t, "sale", row.EventType)
+	assert.Equal(t, "0.02", row.FeeEth)
+	assert.Equal(t, "0.98", row.NetProceedsEth)
+	assert.Equal(t, int64(1700000000), row.BlockTime)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewActivityExportInvalidParams(t *testing.T) {
+	svcCtx, _ := newMockServerCtx(t)
+
+	_, err := newActivityExport(svcCtx, &types.ActivityExportFilterParams{Format: "xlsx"})
+	assert.Error(t, err)
+	_, err = newActivityExport(svcCtx, &types.ActivityExportFilterParams{StartTime: 200, EndTime: 100})
+	assert.Error(t, err)
+	_, err = newActivityExport(svcCtx, &types.ActivityExportFilterParams{ChainID: []int{1}})
+	assert.Error(t, err)
+	_, err = newActivityExport(svcCtx, &types.ActivityExportFilterParams{EventTypes: []string{"unknown"}})
+	assert.Error(t, err)
+}
+
+func TestExportChunkWriter(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	job := &base.ExportJob{Id: 7, Attempts: 2}
+
+	for seq, data := range []string{"abcd", "efgh", "ij"} {
+		mock.ExpectBegin()
+		mock.ExpectExec(chunkInsert).
+			WithArgs(int64(7), 2, seq, []byte(data), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(int64(seq+1), 1))
+		mock.ExpectCommit()
+	}
+
+	w := newExportChunkWriter(context.Background(), svcCtx, job, 4)
+	for _, p := range []string{"ab", "cdefg", "hij"} {
+		n, err := w.Write([]byte(p))
+		require.NoError(t, err)
+		assert.Equal(t, len(p), n)
+	}
+	require.NoError(t, w.Close())
+	assert.Equal(t, int64(10), w.written)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWriteExportFile(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	job := &base.ExportJob{Id: 7, Attempts: 2, FileSize: 6}
+	chunkQuery := regexp.QuoteMeta("SELECT * FROM `ob_export_file_chunk` WHERE job_id = ? and attempt = ? and seq = ? LIMIT 1")
+
+	mock.ExpectQuery(chunkQuery).WithArgs(int64(7), 2, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "data"}).AddRow(0, []byte("abcd")))
+	mock.ExpectQuery(chunkQuery).WithArgs(int64(7), 2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "data"}).AddRow(1, []byte("ef")))
+	mock.ExpectQuery(chunkQuery).WithArgs(int64(7), 2, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "data"}))
+
+	var buf bytes.Buffer
+	n, err := WriteExportFile(context.Background(), svcCtx, job, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), n)
+	assert.Equal(t, "abcdef", buf.String())
+
+	// 分块缺失时文件不完整
+	mock.ExpectQuery(chunkQuery).WithArgs(int64(7), 2, 0).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "data"}).AddRow(0, []byte("abcd")))
+	mock.ExpectQuery(chunkQuery).WithArgs(int64(7), 2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "data"}))
+	_, err = WriteExportFile(context.Background(), svcCtx, job, &bytes.Buffer{})
+	assert.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func newTestExportJob(t *testing.T) *base.ExportJob {
+	params, err := json.Marshal(&types.ActivityExportFilterParams{Format: types.ExportFormatCSV})
+	require.NoError(t, err)
+	return &base.ExportJob{Id: 7, Kind: ExportKindActivity, Format: types.ExportFormatCSV, Params: string(params),
+		Status: base.ExportJobStatusRunning, Attempts: 2}
+}
+
+func TestRunExportJobDone(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	job := newTestExportJob(t)
+
+	// 删除上一次执行遗留的分块
+	mock.ExpectBegin()
+	mock.ExpectExec(chunkDelete).WithArgs(int64(7), 1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	expectActivities(mock)
+	// 进度更新
+	mock.ExpectBegin()
+	mock.ExpectExec(claimedJobUpdate+".*"+regexp.QuoteMeta("WHERE id = ? and status = ? and attempts = ?")).
+		WithArgs(int64(2), sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(chunkInsert).
+		WithArgs(int64(7), 2, 0, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(claimedJobUpdate+".*`file_name`=\\?.*`status`=\\?").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), "activity-7.csv", sqlmock.AnyArg(), int64(2), base.ExportJobStatusDone,
+			sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	runExportJob(context.Background(), svcCtx, exportConfig(svcCtx), job)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunExportJobFailed(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	job := newTestExportJob(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(chunkDelete).WithArgs(int64(7), 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(protocolShareQuery).WillReturnError(assert.AnError)
+	// 删除本次执行的分块并标记为失败
+	mock.ExpectBegin()
+	mock.ExpectExec(chunkDelete).WithArgs(int64(7), 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(claimedJobUpdate+".*`last_error`=\\?,`status`=\\?").
+		WithArgs(sqlmock.AnyArg(), base.ExportJobStatusFailed, sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	runExportJob(context.Background(), svcCtx, exportConfig(svcCtx), job)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunExportJobReleasedOnShutdown(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	job := newTestExportJob(t)
+
+	// 服务退出时删除本次执行的分块并归还任务, 不标记为失败
+	mock.ExpectBegin()
+	mock.ExpectExec(chunkDelete).WithArgs(int64(7), 2).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(claimedJobUpdate+".*`attempts`=attempts - 1").
+		WithArgs(0, base.ExportJobStatusPending, sqlmock.AnyArg(), int64(7), base.ExportJobStatusRunning, 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	runExportJob(ctx, svcCtx, exportConfig(svcCtx), job)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRunExportJobLost(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	job := newTestExportJob(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(chunkDelete).WithArgs(int64(7), 1).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	expectActivities(mock)
+	// 任务已被其他实例重新领取, 放弃执行且不修改任务
+	mock.ExpectBegin()
+	mock.ExpectExec(claimedJobUpdate).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `ob_export_job`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+
+	runExportJob(context.Background(), svcCtx, exportConfig(svcCtx), job)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/mail"
+	"strings"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/notify"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+const (
+	maxFloorAlerts       = 50  // 每个用户最多设置的地板价提醒数
+	maxNotificationReads = 100 // 每次最多按 ID 标记的通知数
+)
+
+// GetNotifications 分页查询用户站内通知及未读数
+func GetNotifications(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, filter types.NotificationFilterParams) (*types.NotificationsResp, error) {
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	userAddr = strings.ToLower(userAddr)
+	notifications, count, err := svcCtx.Dao.QueryNotifications(ctx, userAddr, filter.UnreadOnly, filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get notifications")
+	}
+	unread, err := svcCtx.Dao.CountUnreadNotifications(ctx, userAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get unread notifications")
+	}
+	return &types.NotificationsResp{Result: notifications, Count: count, Unread: unread}, nil
+}
+
+// ReadNotifications 将指定通知或全部通知标记为已读
+func ReadNotifications(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, req *types.NotificationReadReq) (*types.NotificationReadResp, error) {
+	if (!req.All && len(req.Ids) == 0) || len(req.Ids) > maxNotificationReads {
+		return nil, errcode.ErrInvalidParams
+	}
+	var ids []int64
+	if !req.All {
+		ids = req.Ids
+	}
+
+	n, err := svcCtx.Dao.MarkNotificationsRead(ctx, strings.ToLower(userAddr), ids)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on read notifications", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+	return &types.NotificationReadResp{Result: n}, nil
+}
+
+// GetNotificationPreference 查询用户通知偏好, 没有设置时返回默认偏好 (接收全部站内通知)
+func GetNotificationPreference(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string) (*types.NotificationPreference, error) {
+	pref, err := svcCtx.Dao.QueryNotificationPreference(ctx, strings.ToLower(userAddr))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get notification preference")
+	}
+	return toNotificationPreference(pref), nil
+}
+
+// UpdateNotificationPreference 更新用户通知偏好
+// 首次开启 webhook 或请求重新生成时生成签名密钥, 密钥仅在本次响应中返回
+func UpdateNotificationPreference(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, req *types.NotificationPreferenceReq) (*types.NotificationPreference, error) {
+	muted, ok := normalizeNotificationKinds(req.MutedKinds)
+	if !ok {
+		return nil, errcode.NewCustomErr("unknown notification kind")
+	}
+	webhookURL := strings.TrimSpace(req.WebhookURL)
+	if webhookURL != "" {
+		if err := notify.ValidateWebhookURL(webhookURL); err != nil {
+			return nil, errcode.NewCustomErr("invalid webhook url: " + err.Error())
+		}
+	}
+	if req.WebhookEnabled && webhookURL == "" {
+		return nil, errcode.NewCustomErr("webhook url is required")
+	}
+	email := strings.TrimSpace(req.Email)
+	if email != "" {
+		addr, err := mail.ParseAddress(email)
+		if err != nil {
+			return nil, errcode.NewCustomErr("invalid email")
+		}
+		email = addr.Address
+	}
+	if req.EmailEnabled && email == "" {
+		return nil, errcode.NewCustomErr("email is required")
+	}
+
+	userAddr = strings.ToLower(userAddr)
+	existing, err := svcCtx.Dao.QueryNotificationPreference(ctx, userAddr)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query notification preference", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+
+	pref := base.NotificationPreference{
+		UserAddress:    userAddr,
+		MutedKinds:     strings.Join(muted, ","),
+		WebhookEnabled: req.WebhookEnabled,
+		WebhookURL:     webhookURL,
+		EmailEnabled:   req.EmailEnabled,
+		Email:          email,
+	}
+	if existing != nil {
+		pref.WebhookSecret = existing.WebhookSecret
+	}
+	var secret string
+	if req.RotateWebhookSecret || (webhookURL != "" && pref.WebhookSecret == "") {
+		raw := make([]byte, 32)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, errors.Wrap(err, "failed on generate webhook secret")
+		}
+		secret = hex.EncodeToString(raw)
+		pref.WebhookSecret = secret
+	}
+
+	if err := svcCtx.Dao.UpsertNotificationPreference(ctx, &pref); err != nil {
+		xzap.WithContext(ctx).Error("failed on update notification preference", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+
+	res := toNotificationPreference(&pref)
+	res.WebhookSecret = secret
+	return res, nil
+}
+
+// GetFloorAlerts 查询用户的地板价提醒
+func GetFloorAlerts(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string) ([]base.NotificationFloorAlert, error) {
+	alerts, err := svcCtx.Dao.QueryFloorAlerts(ctx, strings.ToLower(userAddr))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get floor alerts")
+	}
+	return alerts, nil
+}
+
+// SetFloorAlert 创建或更新集合地板价提醒
+func SetFloorAlert(ctx context.Context, svcCtx *svc.ServerCtx, chain string, userAddr string, req *types.FloorAlertReq) (*base.NotificationFloorAlert, error) {
+	if !req.Threshold.IsPositive() || req.CollectionAddress == "" {
+		return nil, errcode.ErrInvalidParams
+	}
+	userAddr = strings.ToLower(userAddr)
+	collectionAddr := strings.ToLower(req.CollectionAddress)
+
+	if _, err := svcCtx.Dao.QueryCollectionInfo(ctx, chain, collectionAddr); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errcode.NewCustomErr("collection not found")
+		}
+		xzap.WithContext(ctx).Error("failed on query collection", zap.Error(err), zap.String("collection", collectionAddr))
+		return nil, errcode.ErrUnexpected
+	}
+
+	alerts, err := svcCtx.Dao.QueryFloorAlerts(ctx, userAddr)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query floor alerts", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+	exists := false
+	for _, alert := range alerts {
+		if alert.ChainId == int64(req.ChainID) && alert.CollectionAddress == collectionAddr {
+			exists = true
+			break
+		}
+	}
+	if !exists && len(alerts) >= maxFloorAlerts {
+		return nil, errcode.NewCustomErr("too many floor alerts")
+	}
+
+	alert := base.NotificationFloorAlert{
+		UserAddress:       userAddr,
+		ChainId:           int64(req.ChainID),
+		CollectionAddress: collectionAddr,
+		Threshold:         req.Threshold,
+	}
+	if err := svcCtx.Dao.UpsertFloorAlert(ctx, &alert); err != nil {
+		xzap.WithContext(ctx).Error("failed on set floor alert", zap.Error(err), zap.String("user", userAddr))
+		return nil, errcode.ErrUnexpected
+	}
+	return &alert, nil
+}
+
+// DeleteFloorAlert 删除用户的地板价提醒
+func DeleteFloorAlert(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, id int64) error {
+	if err := svcCtx.Dao.DeleteFloorAlert(ctx, strings.ToLower(userAddr), id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.NewCustomErr("floor alert not found")
+		}
+		xzap.WithContext(ctx).Error("failed on delete floor alert", zap.Error(err), zap.Int64("id", id))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// GetNotificationDeliveries 分页查询用户通知的 webhook / 邮件投递记录
+func GetNotificationDeliveries(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, filter types.NotificationDeliveryFilterParams) (*types.NotificationDeliveriesResp, error) {
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	deliveries, count, err := svcCtx.Dao.QueryNotificationDeliveries(ctx, strings.ToLower(userAddr), filter.Channel, filter.Status, filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get notification deliveries")
+	}
+	return &types.NotificationDeliveriesResp{Result: deliveries, Count: count}, nil
+}
+
+// toNotificationPreference 转换为接口返回的通知偏好, 不包含 webhook 签名密钥
+func toNotificationPreference(pref *base.NotificationPreference) *types.NotificationPreference {
+	res := &types.NotificationPreference{Kinds: base.NotificationKinds, MutedKinds: []string{}}
+	if pref == nil {
+		return res
+	}
+	if pref.MutedKinds != "" {
+		res.MutedKinds = strings.Split(pref.MutedKinds, ",")
+	}
+	res.WebhookEnabled = pref.WebhookEnabled
+	res.WebhookURL = pref.WebhookURL
+	res.EmailEnabled = pref.EmailEnabled
+	res.Email = pref.Email
+	return res
+}
+
+// normalizeNotificationKinds 校验并去重通知类型, 存在未知类型时返回 false
+func normalizeNotificationKinds(kinds []string) ([]string, bool) {
+	known := make(map[string]bool, len(base.NotificationKinds))
+	for _, kind := range base.NotificationKinds {
+		known[kind] = true
+	}
+
+	seen := make(map[string]bool, len(kinds))
+	var res []string
+	for _, kind := range kinds {
+		kind = strings.TrimSpace(kind)
+		if !known[kind] {
+			return nil, false
+		}
+		if seen[kind] {
+			continue
+		}
+		seen[kind] = true
+		res = append(res, kind)
+	}
+	return res, true
+}
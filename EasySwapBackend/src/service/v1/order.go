@@ -98,6 +98,7 @@ func processBids(tokenIds []string, itemsBestBids map[string]multi.Order, collec
 					Salt:              collectionBids[cBidIndex].Salt,
 					BidSize:           collectionBids[cBidIndex].Size,
 					BidUnfilled:       collectionBids[cBidIndex].QuantityRemaining,
+					BidFilled:         collectionBids[cBidIndex].Size - collectionBids[cBidIndex].QuantityRemaining,
 					Bidder:            collectionBids[cBidIndex].Maker,
 					OrderType:         getBidType(collectionBids[cBidIndex].OrderType),
 				})
@@ -122,6 +123,7 @@ func processBids(tokenIds []string, itemsBestBids map[string]multi.Order, collec
 				Salt:              itemBid.Salt,
 				BidSize:           itemBid.Size,
 				BidUnfilled:       itemBid.QuantityRemaining,
+				BidFilled:         itemBid.Size - itemBid.QuantityRemaining,
 				Bidder:            itemBid.Maker,
 				OrderType:         getBidType(itemBid.OrderType),
 			})
@@ -144,6 +146,7 @@ func processBids(tokenIds []string, itemsBestBids map[string]multi.Order, collec
 					Salt:              cBid.Salt,
 					BidSize:           cBid.Size,
 					BidUnfilled:       cBid.QuantityRemaining,
+					BidFilled:         cBid.Size - cBid.QuantityRemaining,
 					Bidder:            cBid.Maker,
 					OrderType:         getBidType(cBid.OrderType),
 				})
@@ -162,6 +165,7 @@ func processBids(tokenIds []string, itemsBestBids map[string]multi.Order, collec
 					Salt:              itemBid.Salt,
 					BidSize:           itemBid.Size,
 					BidUnfilled:       itemBid.QuantityRemaining,
+					BidFilled:         itemBid.Size - itemBid.QuantityRemaining,
 					Bidder:            itemBid.Maker,
 					OrderType:         getBidType(itemBid.OrderType),
 				})
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// takerOrderTTL 吃单时构造的对手单有效期, 交易需在此时间内上链
+const takerOrderTTL = 30 * time.Minute
+
+// BuildMakeOrdersTx 构造 makeOrders 待签名交易
+// 预检: 订单参数合法; 卖单 Maker 持有 NFT 且已授权 Vault; 买单 Maker 余额足够锁定 price * amount
+func BuildMakeOrdersTx(ctx context.Context, svcCtx *svc.ServerCtx, req *types.MakeOrdersTxReq) (*types.UnsignedTxResp, error) {
+	if len(req.Orders) == 0 {
+		return nil, errcode.ErrInvalidParams
+	}
+	market, err := marketConfig(svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	client, err := nodeEthClient(svcCtx, req.ChainID)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	var maker common.Address
+	orders := make([]liborder.Order, 0, len(req.Orders))
+	orderIDs := make([]string, 0, len(req.Orders))
+	value := big.NewInt(0)
+	for i := range req.Orders {
+		order, err := parseOrderParam(&req.Orders[i])
+		if err != nil {
+			return nil, errcode.NewCustomErr(err.Error())
+		}
+		if i == 0 {
+			maker = order.Maker
+		} else if order.Maker != maker {
+			return nil, errcode.NewCustomErr("orders must have the same maker")
+		}
+
+		if order.Side == liborder.SideList {
+			if err := checkListingApproval(ctx, client, common.HexToAddress(market.Vault), order); err != nil {
+				return nil, errcode.NewCustomErr(err.Error())
+			}
+		}
+		value.Add(value, order.BidValue())
+		orders = append(orders, *order)
+		orderIDs = append(orderIDs, order.KeyHex())
+	}
+	if err := checkBalance(ctx, client, maker, value); err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	data, err := liborder.PackMakeOrders(orders)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	return buildUnsignedTx(ctx, svcCtx, req.ChainID, maker, data, value, orderIDs)
+}
+
+// BuildCancelOrdersTx 构造 cancelOrders 待签名交易
+// 预检: 订单为 Active 的链上订单且 Maker 相同
+func BuildCancelOrdersTx(ctx context.Context, svcCtx *svc.ServerCtx, chain string, req *types.CancelOrdersTxReq) (*types.UnsignedTxResp, error) {
+	orders, err := queryOnChainActiveOrders(ctx, svcCtx, chain, req.OrderIDs, false)
+	if err != nil {
+		return nil, err
+	}
+	maker, err := sameMaker(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]common.Hash, 0, len(orders))
+	for _, order := range orders {
+		keys = append(keys, common.HexToHash(order.OrderID))
+	}
+	data, err := liborder.PackCancelOrders(keys)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	return buildUnsignedTx(ctx, svcCtx, req.ChainID, maker, data, big.NewInt(0), req.OrderIDs)
+}
+
+// BuildEditOrdersTx 构造 editOrders 待签名交易
+// 新订单沿用原订单的方向/类型/Maker/NFT, 数量为原订单剩余数量;
+// 买单加价时需补足差额: newPrice * remaining - oldPrice * remaining
+func BuildEditOrdersTx(ctx context.Context, svcCtx *svc.ServerCtx, chain string, req *types.EditOrdersTxReq) (*types.UnsignedTxResp, error) {
+	orderIDs := make([]string, 0, len(req.Edits))
+	for _, edit := range req.Edits {
+		orderIDs = append(orderIDs, edit.OrderID)
+	}
+	orders, err := queryOnChainActiveOrders(ctx, svcCtx, chain, orderIDs, true)
+	if err != nil {
+		return nil, err
+	}
+	maker, err := sameMaker(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]liborder.EditDetail, 0, len(req.Edits))
+	newOrderIDs := make([]string, 0, len(req.Edits))
+	value := big.NewInt(0)
+	for i, edit := range req.Edits {
+		newPrice, ok := new(big.Int).SetString(edit.Price, 10)
+		if !ok {
+			return nil, errcode.NewCustomErr("invalid price")
+		}
+		newOrder, err := liborder.FromModel(&orders[i])
+		if err != nil {
+			return nil, errcode.NewCustomErr(err.Error())
+		}
+		remaining := big.NewInt(orders[i].QuantityRemaining)
+		newOrder.Nft.Amount = remaining
+		newOrder.Price = newPrice
+		newOrder.Expiry = edit.Expiry
+		newOrder.Salt = edit.Salt
+		if err := newOrder.Validate(uint64(time.Now().Unix()), false); err != nil {
+			return nil, errcode.NewCustomErr(err.Error())
+		}
+
+		if newOrder.Side == liborder.SideBid {
+			delta := new(big.Int).Sub(newOrder.BidValue(), new(big.Int).Mul(orders[i].Price.BigInt(), remaining))
+			if delta.Sign() > 0 {
+				value.Add(value, delta)
+			}
+		}
+		details = append(details, liborder.EditDetail{
+			OldOrderKey: common.HexToHash(orders[i].OrderID),
+			NewOrder:    *newOrder,
+		})
+		newOrderIDs = append(newOrderIDs, newOrder.KeyHex())
+	}
+
+	client, err := nodeEthClient(svcCtx, req.ChainID)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	if err := checkBalance(ctx, client, maker, value); err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	data, err := liborder.PackEditOrders(details)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	return buildUnsignedTx(ctx, svcCtx, req.ChainID, maker, data, value, newOrderIDs)
+}
+
+// BuildMatchOrdersTx 构造吃单待签名交易, 单个订单使用 matchOrder, 多个订单使用 matchOrders
+// 吃卖单: 构造买单, 需附带卖单价格的 ETH, 多余部分由合约退回
+// 接受出价: 构造卖单, Taker 需持有 NFT 且已授权 Vault
+// 预检: 挂单为 Active 的链上订单、未过期、价格与前端展示一致
+func BuildMatchOrdersTx(ctx context.Context, svcCtx *svc.ServerCtx, chain string, req *types.MatchOrdersTxReq) (*types.UnsignedTxResp, error) {
+	if !common.IsHexAddress(req.Taker) {
+		return nil, errcode.NewCustomErr("invalid taker")
+	}
+	taker := common.HexToAddress(req.Taker)
+
+	orderIDs := make([]string, 0, len(req.Orders))
+	for _, param := range req.Orders {
+		orderIDs = append(orderIDs, param.OrderID)
+	}
+	orders, err := queryOnChainActiveOrders(ctx, svcCtx, chain, orderIDs, true)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := marketConfig(svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	client, err := nodeEthClient(svcCtx, req.ChainID)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	expiry := uint64(time.Now().Add(takerOrderTTL).Unix())
+	details := make([]liborder.MatchDetail, 0, len(req.Orders))
+	value := big.NewInt(0)
+	for i, param := range req.Orders {
+		makeOrder, err := liborder.FromModel(&orders[i])
+		if err != nil {
+			return nil, errcode.NewCustomErr(err.Error())
+		}
+		if makeOrder.Maker == taker {
+			return nil, errcode.NewCustomErr("cannot match own order")
+		}
+		expectedPrice, ok := new(big.Int).SetString(param.Price, 10)
+		if !ok || expectedPrice.Cmp(makeOrder.Price) != 0 {
+			return nil, errcode.NewCustomErr("order price changed")
+		}
+		salt, err := randomSalt()
+		if err != nil {
+			return nil, errcode.ErrUnexpected
+		}
+
+		takeOrder := liborder.Order{
+			SaleKind: liborder.SaleKindFixedPriceForItem,
+			Maker:    taker,
+			Nft: liborder.Asset{
+				TokenId:    makeOrder.Nft.TokenId,
+				Collection: makeOrder.Nft.Collection,
+				Amount:     big.NewInt(1),
+			},
+			Price:  makeOrder.Price,
+			Expiry: expiry,
+			Salt:   salt,
+		}
+
+		if makeOrder.Side == liborder.SideList {
+			// 吃卖单: 按卖单价格支付
+			takeOrder.Side = liborder.SideBid
+			value.Add(value, makeOrder.Price)
+			details = append(details, liborder.MatchDetail{SellOrder: *makeOrder, BuyOrder: takeOrder})
+			continue
+		}
+
+		// 接受出价: 集合出价需指定卖出的 Token ID
+		takeOrder.Side = liborder.SideList
+		if makeOrder.SaleKind == liborder.SaleKindFixedPriceForCollection {
+			tokenID, ok := new(big.Int).SetString(param.TokenId, 10)
+			if !ok {
+				return nil, errcode.NewCustomErr("invalid token id")
+			}
+			takeOrder.Nft.TokenId = tokenID
+		}
+		if err := checkListingApproval(ctx, client, common.HexToAddress(market.Vault), &takeOrder); err != nil {
+			return nil, errcode.NewCustomErr(err.Error())
+		}
+		details = append(details, liborder.MatchDetail{SellOrder: takeOrder, BuyOrder: *makeOrder})
+	}
+	if err := checkBalance(ctx, client, taker, value); err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	var data []byte
+	if len(details) == 1 {
+		data, err = liborder.PackMatchOrder(details[0].SellOrder, details[0].BuyOrder)
+	} else {
+		data, err = liborder.PackMatchOrders(details)
+	}
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	return buildUnsignedTx(ctx, svcCtx, req.ChainID, taker, data, value, orderIDs)
+}
+
+// queryOnChainActiveOrders 按请求顺序查询订单, 要求订单存在、已上链 (非链下签名订单) 且为 Active
+// checkExpiry 为 true 时同时要求订单未过期
+func queryOnChainActiveOrders(ctx context.Context, svcCtx *svc.ServerCtx, chain string, orderIDs []string, checkExpiry bool) ([]multi.Order, error) {
+	if len(orderIDs) == 0 {
+		return nil, errcode.ErrInvalidParams
+	}
+	for i := range orderIDs {
+		orderIDs[i] = strings.ToLower(orderIDs[i])
+	}
+
+	orders, err := svcCtx.Dao.QueryOrdersByIDs(ctx, chain, orderIDs)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query orders", zap.Error(err))
+		return nil, errcode.ErrUnexpected
+	}
+	orderMap := make(map[string]multi.Order, len(orders))
+	for _, order := range orders {
+		orderMap[strings.ToLower(order.OrderID)] = order
+	}
+
+	now := time.Now().Unix()
+	result := make([]multi.Order, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		order, ok := orderMap[orderID]
+		if !ok {
+			return nil, errcode.NewCustomErr("order not found: " + orderID)
+		}
+		if order.Signature != "" {
+			return nil, errcode.NewCustomErr("order is not on chain: " + orderID)
+		}
+		if order.OrderStatus != multi.OrderStatusActive {
+			return nil, errcode.NewCustomErr("order is not active: " + orderID)
+		}
+		if checkExpiry && order.ExpireTime != 0 && order.ExpireTime <= now {
+			return nil, errcode.NewCustomErr("order is expired: " + orderID)
+		}
+		result = append(result, order)
+	}
+	return result, nil
+}
+
+// sameMaker 校验订单 Maker 相同并返回该 Maker
+func sameMaker(orders []multi.Order) (common.Address, error) {
+	for _, order := range orders[1:] {
+		if !strings.EqualFold(order.Maker, orders[0].Maker) {
+			return common.Address{}, errcode.NewCustomErr("orders must have the same maker")
+		}
+	}
+	return common.HexToAddress(orders[0].Maker), nil
+}
+
+// buildUnsignedTx 组装待签名交易并预估 gas, 预估失败说明交易会 revert
+func buildUnsignedTx(ctx context.Context, svcCtx *svc.ServerCtx, chainID int, from common.Address, data []byte, value *big.Int, orderIDs []string) (*types.UnsignedTxResp, error) {
+	market, err := marketConfig(svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	client, err := nodeEthClient(svcCtx, chainID)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	to := common.HexToAddress(market.Contract)
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:  from,
+		To:    &to,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		return nil, errcode.NewCustomErr(errors.Wrap(err, "failed on estimate gas").Error())
+	}
+
+	return &types.UnsignedTxResp{
+		ChainID:  chainID,
+		From:     strings.ToLower(from.String()),
+		To:       strings.ToLower(to.String()),
+		Data:     hexutil.Encode(data),
+		Value:    value.String(),
+		Gas:      gas,
+		OrderIDs: orderIDs,
+	}, nil
+}
+
+// marketConfig 获取订单簿合约配置
+func marketConfig(svcCtx *svc.ServerCtx) (*config.EasySwapMarket, error) {
+	market := svcCtx.C.EasySwapMarket
+	if market == nil || !common.IsHexAddress(market.Contract) {
+		return nil, errcode.NewCustomErr("order book contract is not configured")
+	}
+	return market, nil
+}
+
+// randomSalt 生成非零随机盐值
+func randomSalt() (uint64, error) {
+	var buf [8]byte
+	for {
+		if _, err := rand.Read(buf[:]); err != nil {
+			return 0, errors.Wrap(err, "failed on generate salt")
+		}
+		if salt := binary.BigEndian.Uint64(buf[:]); salt != 0 {
+			return salt, nil
+		}
+	}
+}
@@ -745,8 +745,9 @@ func GetMultiChainUserBids(ctx context.Context, svcCtx *svc.ServerCtx, chainID [
 				ExpireTime:        bid.ExpireTime,
 				//BidType:           getBidType(bid.OrderType), // BidType 似乎在 Struct 中被移除了或者重构了? (需确认 Types 定义)
 				// 临时 note: 保持原有逻辑
-				BidType:   getBidType(bid.OrderType),
-				OrderSize: bid.QuantityRemaining,
+				BidType:    getBidType(bid.OrderType),
+				OrderSize:  bid.QuantityRemaining,
+				FilledSize: bid.Size - bid.QuantityRemaining,
 				BidInfos: []types.BidInfo{
 					{
 						BidOrderID:    bid.OrderID,
@@ -756,6 +757,7 @@ func GetMultiChainUserBids(ctx context.Context, svcCtx *svc.ServerCtx, chainID [
 						BidSalt:       bid.Salt,
 						BidSize:       bid.Size,
 						BidUnfilled:   bid.QuantityRemaining,
+						BidFilled:     bid.Size - bid.QuantityRemaining,
 					},
 				},
 			}
@@ -764,6 +766,7 @@ func GetMultiChainUserBids(ctx context.Context, svcCtx *svc.ServerCtx, chainID [
 
 		// 如果 key 存在, 累加数量并追加详细 BidInfo
 		userBid.OrderSize += bid.QuantityRemaining
+		userBid.FilledSize += bid.Size - bid.QuantityRemaining
 		userBid.BidInfos = append(userBid.BidInfos, types.BidInfo{
 			BidOrderID:    bid.OrderID,
 			BidTime:       bid.EventTime,
@@ -772,6 +775,7 @@ func GetMultiChainUserBids(ctx context.Context, svcCtx *svc.ServerCtx, chainID [
 			BidSalt:       bid.Salt,
 			BidSize:       bid.Size,
 			BidUnfilled:   bid.QuantityRemaining,
+			BidFilled:     bid.Size - bid.QuantityRemaining,
 		})
 		bidsMap[key] = userBid
 	}
@@ -815,6 +819,87 @@ func GetMultiChainUserBids(ctx context.Context, svcCtx *svc.ServerCtx, chainID [
 	}, nil
 }
 
+// GetMultiChainUserEscrow 查询用户在各链 Vault 中托管的资产, 按订单及集合汇总
+func GetMultiChainUserEscrow(ctx context.Context, svcCtx *svc.ServerCtx, chainID []int, chainNames []string, userAddrs []string, contractAddrs []string) (*types.UserEscrowResp, error) {
+	result := &types.UserEscrow{
+		Collections: []types.EscrowCollection{},
+		Orders:      []types.EscrowOrder{},
+	}
+	collectionsMap := make(map[string]*types.EscrowCollection)
+	for i, chain := range chainNames {
+		orders, err := svcCtx.Dao.QueryUserEscrowOrders(ctx, chain, userAddrs, contractAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get user escrow orders")
+		}
+
+		var collectionAddrs []string
+		for _, order := range orders {
+			collectionAddr := strings.ToLower(order.CollectionAddress)
+			result.Orders = append(result.Orders, types.EscrowOrder{
+				ChainID:           chainID[i],
+				CollectionAddress: collectionAddr,
+				TokenID:           order.TokenId,
+				OrderID:           order.OrderID,
+				OrderType:         order.OrderType,
+				OrderStatus:       order.OrderStatus,
+				Maker:             order.Maker,
+				Price:             order.Price,
+				QuantityRemaining: order.QuantityRemaining,
+				ExpireTime:        order.ExpireTime,
+				EscrowEth:         order.EscrowEth,
+				EscrowNft:         order.EscrowNft,
+			})
+
+			key := fmt.Sprintf("%d:%s", chainID[i], collectionAddr)
+			collection, ok := collectionsMap[key]
+			if !ok {
+				collection = &types.EscrowCollection{ChainID: chainID[i], CollectionAddress: collectionAddr}
+				collectionsMap[key] = collection
+				collectionAddrs = append(collectionAddrs, collectionAddr)
+			}
+			if order.OrderType == multi.ListingOrder {
+				if order.EscrowNft {
+					collection.LockedNfts++
+					result.TotalLockedNfts++
+				}
+				collection.ListingCount++
+			} else {
+				collection.LockedEth = collection.LockedEth.Add(order.EscrowEth)
+				result.TotalLockedEth = result.TotalLockedEth.Add(order.EscrowEth)
+				collection.BidCount++
+			}
+		}
+		if len(collectionAddrs) == 0 {
+			continue
+		}
+
+		// 填充 Collection 名称和图片
+		cs, err := svcCtx.Dao.QueryCollectionsInfo(ctx, chain, collectionAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get collections info")
+		}
+		for _, c := range cs {
+			if collection, ok := collectionsMap[fmt.Sprintf("%d:%s", chainID[i], strings.ToLower(c.Address))]; ok {
+				collection.CollectionName = c.Name
+				collection.ImageURI = c.ImageUri
+			}
+		}
+	}
+
+	for _, collection := range collectionsMap {
+		result.Collections = append(result.Collections, *collection)
+	}
+	// 按锁定 ETH 降序, 其次按托管 NFT 数量降序
+	sort.SliceStable(result.Collections, func(i, j int) bool {
+		if cmp := result.Collections[i].LockedEth.Cmp(result.Collections[j].LockedEth); cmp != 0 {
+			return cmp > 0
+		}
+		return result.Collections[i].LockedNfts > result.Collections[j].LockedNfts
+	})
+
+	return &types.UserEscrowResp{Result: result}, nil
+}
+
 func removeRepeatedElement(arr []string) (newArr []string) {
 	newArr = make([]string, 0)
 	for i := 0; i < len(arr); i++ {
@@ -102,6 +102,11 @@ func GetTopRanking(ctx context.Context, svcCtx *svc.ServerCtx, chain string, per
 	// 构建返回结果
 	var respInfos []*types.CollectionRankingInfo
 	for _, collection := range allCollections {
+		// 隐藏或封禁的集合不参与排行
+		if collection.Status != multi.CollectionStatusNormal {
+			continue
+		}
+
 		var priceChange float64
 		var volume decimal.Decimal
 		var sellPrice decimal.Decimal
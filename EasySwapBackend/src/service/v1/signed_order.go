@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/chain/nftchainservice"
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// CreateSignedOrder 接收链下 EIP-712 签名订单
+// 功能:
+// 1. 校验订单参数 (与合约 OrderValidator 规则一致)
+// 2. 校验签名者为订单 Maker
+// 3. 链上校验: 卖单需持有 NFT 且已授权给 Vault, 买单需 ETH 余额足够
+// 4. 以合约 OrderKey 作为订单 ID 保存为 Pending 订单
+// 合约只能成交链上订单, Pending 订单不计入地板价、挂单及最高出价, 由 Maker 提交上链后 sync 收到 LogMake 再转为 Active
+func CreateSignedOrder(ctx context.Context, svcCtx *svc.ServerCtx, chain string, req *types.SignedOrderReq) (*types.SignedOrderResp, error) {
+	// 合约中 expiry 为 0 表示永不过期, 链下订单要求必须设置过期时间
+	if req.Expiry == 0 {
+		return nil, errcode.NewCustomErr("expiry is required")
+	}
+	order, err := parseOrderParam(&req.OrderParam)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	market := svcCtx.C.EasySwapMarket
+	if market == nil {
+		return nil, errcode.NewCustomErr("signed order is not supported")
+	}
+
+	// 1. 校验签名
+	if err := verifyOrderSignature(market, req.ChainID, order, req.Signature); err != nil {
+		return nil, errcode.NewCustomErr("invalid signature")
+	}
+
+	// 2. 链上校验持有权/授权/余额
+	if err := checkSignedOrderOnChain(ctx, svcCtx, req.ChainID, common.HexToAddress(market.Vault), order); err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	// 3. 保存订单
+	newOrder := multi.Order{
+		MarketplaceId:     multi.MarketOrderBook,
+		CollectionAddress: strings.ToLower(order.Nft.Collection.String()),
+		TokenId:           order.Nft.TokenId.String(),
+		OrderID:           order.KeyHex(),
+		OrderStatus:       multi.OrderStatusPending,
+		EventTime:         time.Now().Unix(),
+		ExpireTime:        int64(order.Expiry),
+		CurrencyAddress:   EthCurrencyAddress,
+		Price:             decimal.NewFromBigInt(order.Price, 0),
+		Maker:             strings.ToLower(order.Maker.String()),
+		Taker:             EthCurrencyAddress,
+		QuantityRemaining: order.Nft.Amount.Int64(),
+		Size:              order.Nft.Amount.Int64(),
+		OrderType:         order.OrderType(),
+		Salt:              int64(order.Salt),
+		Signature:         req.Signature,
+	}
+	if err := svcCtx.Dao.CreateSignedOrder(ctx, chain, &newOrder); err != nil {
+		xzap.WithContext(ctx).Error("failed on create signed order", zap.Error(err), zap.String("order_id", newOrder.OrderID))
+		return nil, errcode.NewCustomErr("failed on create signed order")
+	}
+
+	return &types.SignedOrderResp{
+		Result: newOrder,
+	}, nil
+}
+
+// CancelSignedOrder 取消尚未上链的链下签名订单, 仅订单 Maker 可以取消
+func CancelSignedOrder(ctx context.Context, svcCtx *svc.ServerCtx, chain string, maker string, orderID string) error {
+	order, err := svcCtx.Dao.QuerySignedOrder(ctx, chain, orderID)
+	if err != nil {
+		return errcode.NewCustomErr("signed order not found")
+	}
+
+	if !strings.EqualFold(order.Maker, maker) {
+		return errcode.NewCustomErr("only maker can cancel signed order")
+	}
+	if order.OrderStatus != multi.OrderStatusPending {
+		return errcode.NewCustomErr("signed order is not pending")
+	}
+
+	if err := svcCtx.Dao.CancelSignedOrder(ctx, chain, order); err != nil {
+		xzap.WithContext(ctx).Error("failed on cancel signed order", zap.Error(err), zap.String("order_id", orderID))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// parseOrderParam 解析请求为合约订单并校验, 规则见 liborder.Order.Validate
+func parseOrderParam(req *types.OrderParam) (*liborder.Order, error) {
+	if !common.IsHexAddress(req.Maker) || !common.IsHexAddress(req.Nft.Collection) {
+		return nil, errors.New("invalid address")
+	}
+	tokenID, ok := new(big.Int).SetString(req.Nft.TokenId, 10)
+	if !ok {
+		return nil, errors.New("invalid token id")
+	}
+	price, ok := new(big.Int).SetString(req.Price, 10)
+	if !ok || price.Sign() <= 0 {
+		return nil, errors.New("invalid price")
+	}
+
+	order := &liborder.Order{
+		Side:     req.Side,
+		SaleKind: req.SaleKind,
+		Maker:    common.HexToAddress(req.Maker),
+		Nft: liborder.Asset{
+			TokenId:    tokenID,
+			Collection: common.HexToAddress(req.Nft.Collection),
+			Amount:     big.NewInt(req.Nft.Amount),
+		},
+		Price:  price,
+		Expiry: req.Expiry,
+		Salt:   req.Salt,
+	}
+	if err := order.Validate(uint64(time.Now().Unix()), false); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// verifyOrderSignature 校验订单签名者为 Maker, 签名域为订单簿合约
+func verifyOrderSignature(market *config.EasySwapMarket, chainID int, order *liborder.Order, signature string) error {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return errors.Wrap(err, "failed on decode signature")
+	}
+	return order.VerifySignature(liborder.Domain{
+		Name:              market.Name,
+		Version:           market.Version,
+		ChainId:           int64(chainID),
+		VerifyingContract: common.HexToAddress(market.Contract),
+	}, sig)
+}
+
+// checkSignedOrderOnChain 链上校验订单可执行性
+// 卖单: Maker 持有 NFT, 且已对 Vault 授权 (setApprovalForAll 或 approve)
+// 买单: Maker 的 ETH 余额不少于 price * amount
+func checkSignedOrderOnChain(ctx context.Context, svcCtx *svc.ServerCtx, chainID int, vault common.Address, order *liborder.Order) error {
+	client, err := nodeEthClient(svcCtx, chainID)
+	if err != nil {
+		return err
+	}
+
+	if order.Side == liborder.SideBid {
+		return checkBalance(ctx, client, order.Maker, order.BidValue())
+	}
+	return checkListingApproval(ctx, client, vault, order)
+}
+
+// nodeEthClient 获取指定链的 RPC 客户端
+func nodeEthClient(svcCtx *svc.ServerCtx, chainID int) (*ethclient.Client, error) {
+	nodeSrv, ok := svcCtx.NodeSrvs[int64(chainID)]
+	if !ok {
+		return nil, errors.New("unsupported chain")
+	}
+	client, ok := nodeSrv.NodeClient.Client().(*ethclient.Client)
+	if !ok {
+		return nil, errors.New("unsupported chain client")
+	}
+	return client, nil
+}
+
+// checkBalance 校验账户 ETH 余额不少于 value
+func checkBalance(ctx context.Context, client *ethclient.Client, account common.Address, value *big.Int) error {
+	balance, err := client.BalanceAt(ctx, account, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed on get balance")
+	}
+	if balance.Cmp(value) < 0 {
+		return errors.New("insufficient balance")
+	}
+	return nil
+}
+
+// checkListingApproval 校验卖单 Maker 持有 NFT 且已对 Vault 授权 (setApprovalForAll 或 approve)
+func checkListingApproval(ctx context.Context, client *ethclient.Client, vault common.Address, order *liborder.Order) error {
+	if vault == (common.Address{}) {
+		return errors.New("vault is not configured")
+	}
+	nft, err := nftchainservice.NewNftContractCaller(order.Nft.Collection, client)
+	if err != nil {
+		return errors.Wrap(err, "failed on create nft contract caller")
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	owner, err := nft.OwnerOf(opts, order.Nft.TokenId)
+	if err != nil {
+		return errors.Wrap(err, "failed on get nft owner")
+	}
+	if owner != order.Maker {
+		return errors.New("maker is not the owner")
+	}
+
+	approvedForAll, err := nft.IsApprovedForAll(opts, order.Maker, vault)
+	if err != nil {
+		return errors.Wrap(err, "failed on get approval")
+	}
+	if approvedForAll {
+		return nil
+	}
+	approved, err := nft.GetApproved(opts, order.Nft.TokenId)
+	if err != nil {
+		return errors.Wrap(err, "failed on get approval")
+	}
+	if approved != vault {
+		return errors.New("nft is not approved to vault")
+	}
+	return nil
+}
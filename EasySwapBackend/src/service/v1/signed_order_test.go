@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/chain/chainclient"
+	"github.com/ProjectsTask/EasySwapBase/chain/nftchainservice"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+const (
+	testChainID  = 11155111
+	testMakerKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+	testVault    = "0x5fbdb2315678afecb367f032d93f642f64180aa3"
+)
+
+var (
+	signedOrderInsert = regexp.QuoteMeta("INSERT INTO `ob_order_sepolia`")
+	signedOrderQuery  = regexp.QuoteMeta("SELECT * FROM `ob_order_sepolia` WHERE order_id = ? and signature != ''")
+)
+
+// fakeNode 模拟节点 JSON-RPC, 返回 NFT 持有者、授权状态、账户余额及 gas 预估
+type fakeNode struct {
+	owner          common.Address
+	approvedForAll bool
+	approved       common.Address
+	balance        *big.Int
+}
+
+func (n *fakeNode) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		ID     json.RawMessage   `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var result interface{}
+	switch req.Method {
+	case "eth_getBalance":
+		result = hexutil.EncodeBig(n.balance)
+	case "eth_estimateGas":
+		result = hexutil.EncodeUint64(21000)
+	case "eth_call":
+		var msg struct {
+			Data hexutil.Bytes `json:"data"`
+		}
+		_ = json.Unmarshal(req.Params[0], &msg)
+		switch hexutil.Encode(msg.Data[:4]) {
+		case "0x6352211e": // ownerOf(uint256)
+			result = hexutil.Encode(common.LeftPadBytes(n.owner.Bytes(), 32))
+		case "0xe985e9c5": // isApprovedForAll(address,address)
+			value := big.NewInt(0)
+			if n.approvedForAll {
+				value = big.NewInt(1)
+			}
+			result = hexutil.Encode(common.LeftPadBytes(value.Bytes(), 32))
+		case "0x081812fc": // getApproved(uint256)
+			result = hexutil.Encode(common.LeftPadBytes(n.approved.Bytes(), 32))
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": result})
+}
+
+// newSignedOrderServerCtx 配置订单簿合约及节点, 节点由 fakeNode 模拟
+func newSignedOrderServerCtx(t *testing.T, node *fakeNode) (*svc.ServerCtx, sqlmock.Sqlmock) {
+	svcCtx, mock := newMockServerCtx(t)
+	server := httptest.NewServer(node)
+	t.Cleanup(server.Close)
+
+	client, err := chainclient.New(testChainID, server.URL)
+	require.NoError(t, err)
+	svcCtx.NodeSrvs = map[int64]*nftchainservice.Service{testChainID: {NodeClient: client}}
+	svcCtx.C.EasySwapMarket = &config.EasySwapMarket{
+		Name:     "EasySwapOrderBook",
+		Version:  "1",
+		Contract: "0x9fe46736679d2d9a65f0992f2272de9f3c7fa6e0",
+		Vault:    testVault,
+	}
+	return svcCtx, mock
+}
+
+// signedOrderReq 构造由 key 签名的订单请求
+func signedOrderReq(t *testing.T, svcCtx *svc.ServerCtx, key string, param types.OrderParam) *types.SignedOrderReq {
+	order, err := parseOrderParam(&param)
+	require.NoError(t, err)
+	privateKey, err := crypto.HexToECDSA(key)
+	require.NoError(t, err)
+
+	market := svcCtx.C.EasySwapMarket
+	sig, err := crypto.Sign(order.Digest(liborder.Domain{
+		Name:              market.Name,
+		Version:           market.Version,
+		ChainId:           testChainID,
+		VerifyingContract: common.HexToAddress(market.Contract),
+	}).Bytes(), privateKey)
+	require.NoError(t, err)
+	return &types.SignedOrderReq{ChainID: testChainID, OrderParam: param, Signature: hexutil.Encode(sig)}
+}
+
+func testMaker(t *testing.T) common.Address {
+	privateKey, err := crypto.HexToECDSA(testMakerKey)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(privateKey.PublicKey)
+}
+
+func listingParam(maker common.Address) types.OrderParam {
+	return types.OrderParam{
+		Side:     liborder.SideList,
+		SaleKind: liborder.SaleKindFixedPriceForItem,
+		Maker:    maker.Hex(),
+		Nft:      types.SignedOrderAsset{TokenId: "1", Collection: "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512", Amount: 1},
+		Price:    "1000000000000000000",
+		Expiry:   uint64(time.Now().Add(time.Hour).Unix()),
+		Salt:     1,
+	}
+}
+
+func TestCreateSignedListing(t *testing.T) {
+	maker := testMaker(t)
+	svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{owner: maker, approved: common.HexToAddress(testVault)})
+	req := signedOrderReq(t, svcCtx, testMakerKey, listingParam(maker))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := CreateSignedOrder(context.Background(), svcCtx, "sepolia", req)
+	require.NoError(t, err)
+	order, err := parseOrderParam(&req.OrderParam)
+	require.NoError(t, err)
+	// 以合约 OrderKey 作为订单 ID, 上链前为 Pending
+	assert.Equal(t, order.KeyHex(), resp.Result.OrderID)
+	assert.Equal(t, multi.OrderStatusPending, resp.Result.OrderStatus)
+	assert.Equal(t, int64(multi.ListingOrder), int64(resp.Result.OrderType))
+	assert.Equal(t, req.Signature, resp.Result.Signature)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// 重复提交同一订单
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderInsert).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	_, err = CreateSignedOrder(context.Background(), svcCtx, "sepolia", req)
+	assert.EqualError(t, err, "failed on create signed order")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateSignedOrderRejected(t *testing.T) {
+	maker := testMaker(t)
+	other := common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8")
+	bid := types.OrderParam{
+		Side:     liborder.SideBid,
+		SaleKind: liborder.SaleKindFixedPriceForCollection,
+		Maker:    maker.Hex(),
+		Nft:      types.SignedOrderAsset{TokenId: "0", Collection: "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512", Amount: 2},
+		Price:    "1000000000000000000",
+		Expiry:   uint64(time.Now().Add(time.Hour).Unix()),
+		Salt:     2,
+	}
+	cases := []struct {
+		name  string
+		node  *fakeNode
+		key   string
+		param types.OrderParam
+		err   string
+	}{
+		{name: "signer is not maker", node: &fakeNode{owner: maker, approvedForAll: true},
+			key: "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d", param: listingParam(maker), err: "invalid signature"},
+		{name: "maker is not owner", node: &fakeNode{owner: other, approvedForAll: true},
+			key: testMakerKey, param: listingParam(maker), err: "maker is not the owner"},
+		{name: "not approved to vault", node: &fakeNode{owner: maker, approved: other},
+			key: testMakerKey, param: listingParam(maker), err: "nft is not approved to vault"},
+		// 买单需要 price * amount 的余额
+		{name: "insufficient balance", node: &fakeNode{balance: big.NewInt(1e18)},
+			key: testMakerKey, param: bid, err: "insufficient balance"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svcCtx, mock := newSignedOrderServerCtx(t, c.node)
+			_, err := CreateSignedOrder(context.Background(), svcCtx, "sepolia", signedOrderReq(t, svcCtx, c.key, c.param))
+			assert.EqualError(t, err, c.err)
+			// 校验失败时不保存订单
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	// 余额足够时买单可以提交
+	svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(2e18)})
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	resp, err := CreateSignedOrder(context.Background(), svcCtx, "sepolia", signedOrderReq(t, svcCtx, testMakerKey, bid))
+	require.NoError(t, err)
+	assert.Equal(t, int64(multi.CollectionBidOrder), int64(resp.Result.OrderType))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCancelSignedOrder(t *testing.T) {
+	svcCtx, mock := newMockServerCtx(t)
+	pendingOrder := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"order_id", "maker", "order_status", "signature"}).
+			AddRow("0x01", "0xmaker", multi.OrderStatusPending, "0xsig")
+	}
+
+	// 非 Maker 不能取消
+	mock.ExpectQuery(signedOrderQuery).WithArgs("0x01").WillReturnRows(pendingOrder())
+	err := CancelSignedOrder(context.Background(), svcCtx, "sepolia", "0xother", "0x01")
+	assert.EqualError(t, err, "only maker can cancel signed order")
+
+	// LogMake 对账后签名已清空, 需调用合约取消
+	mock.ExpectQuery(signedOrderQuery).WithArgs("0x01").
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "maker", "order_status", "signature"}))
+	err = CancelSignedOrder(context.Background(), svcCtx, "sepolia", "0xmaker", "0x01")
+	assert.EqualError(t, err, "signed order not found")
+
+	mock.ExpectQuery(signedOrderQuery).WithArgs("0x01").WillReturnRows(pendingOrder())
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_order_sepolia` SET `order_status`=?")).
+		WithArgs(multi.OrderStatusCancelled, "0x01", multi.OrderStatusPending).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, CancelSignedOrder(context.Background(), svcCtx, "sepolia", "0xMAKER", "0x01"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// GetSyncStatus 查询各链订单簿事件的索引进度及落后区块数
+func GetSyncStatus(ctx context.Context, svcCtx *svc.ServerCtx) ([]types.ChainSyncStatus, error) {
+	indexed, err := svcCtx.Dao.QueryIndexedStatus(ctx, base.TypeOrderBookEventIndex)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query indexed status", zap.Error(err))
+		return nil, errcode.ErrUnexpected
+	}
+
+	result := make([]types.ChainSyncStatus, 0, len(svcCtx.C.ChainSupported))
+	for _, chain := range svcCtx.C.ChainSupported {
+		status := types.ChainSyncStatus{
+			ChainID:          chain.ChainID,
+			Chain:            chain.Name,
+			LastIndexedBlock: indexed[chain.ChainID].LastIndexedBlock,
+			LastIndexedTime:  indexed[chain.ChainID].LastIndexedTime,
+			LagBlocks:        -1,
+		}
+
+		// 节点不可用时仍返回已索引进度
+		if nodeSrv, ok := svcCtx.NodeSrvs[int64(chain.ChainID)]; ok {
+			head, err := nodeSrv.NodeClient.BlockNumber()
+			if err != nil {
+				xzap.WithContext(ctx).Warn("failed on get chain head block", zap.String("chain", chain.Name), zap.Error(err))
+			} else {
+				status.ChainHeadBlock = int64(head)
+				status.LagBlocks = 0
+				if status.ChainHeadBlock > status.LastIndexedBlock {
+					status.LagBlocks = status.ChainHeadBlock - status.LastIndexedBlock
+				}
+			}
+		}
+		result = append(result, status)
+	}
+	return result, nil
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// EthCurrencyAddress 原生 ETH 的币种地址
+const EthCurrencyAddress = "0x0000000000000000000000000000000000000000"
+
+// CreateTraitBid 创建属性出价 (链下签名订单, 无托管, 由后端撮合)
+// 功能:
+// 1. 校验订单为集合出价, Maker 为登录用户且签名者为 Maker
+// 2. 链上校验 Maker 的 ETH 余额不少于 price * amount
+// 3. 校验集合中存在该属性
+// 4. 写入 ob_order (order_type = TraitBidOrder), 订单 ID 由签名订单的 OrderKey 与属性生成
+// 合约订单的签名不包含属性, 属性由登录会话确认
+func CreateTraitBid(ctx context.Context, svcCtx *svc.ServerCtx, chain string, maker string, req *types.TraitBidReq) (*types.TraitBidResp, error) {
+	if req.Expiry == 0 || req.Trait == "" || req.TraitValue == "" {
+		return nil, errcode.ErrInvalidParams
+	}
+	order, err := parseOrderParam(&req.OrderParam)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	if order.Side != liborder.SideBid || order.SaleKind != liborder.SaleKindFixedPriceForCollection {
+		return nil, errcode.NewCustomErr("trait bid must be a collection bid")
+	}
+	if !strings.EqualFold(order.Maker.String(), maker) {
+		return nil, errcode.NewCustomErr("maker must be the login user")
+	}
+
+	// 1. 校验签名
+	market, err := marketConfig(svcCtx)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyOrderSignature(market, req.ChainID, order, req.Signature); err != nil {
+		return nil, errcode.NewCustomErr("invalid signature")
+	}
+
+	// 2. 链上校验余额
+	client, err := nodeEthClient(svcCtx, req.ChainID)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	if err := checkBalance(ctx, client, order.Maker, order.BidValue()); err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	// 3. 校验属性
+	collectionAddr := strings.ToLower(order.Nft.Collection.String())
+	count, err := svcCtx.Dao.QueryTraitItemCount(ctx, chain, collectionAddr, req.Trait, req.TraitValue)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query trait item count", zap.Error(err))
+		return nil, errcode.ErrUnexpected
+	}
+	if count == 0 {
+		return nil, errcode.NewCustomErr("trait not found in collection")
+	}
+
+	// 4. 保存订单
+	newOrder := multi.Order{
+		MarketplaceId:     multi.MarketOrderBook,
+		CollectionAddress: collectionAddr,
+		TokenId:           order.Nft.TokenId.String(),
+		OrderID:           genTraitBidOrderID(order.KeyHex(), req.Trait, req.TraitValue),
+		OrderStatus:       multi.OrderStatusActive,
+		EventTime:         time.Now().Unix(),
+		ExpireTime:        int64(order.Expiry),
+		CurrencyAddress:   EthCurrencyAddress,
+		Price:             decimal.NewFromBigInt(order.Price, 0),
+		Maker:             strings.ToLower(order.Maker.String()),
+		Taker:             EthCurrencyAddress,
+		QuantityRemaining: order.Nft.Amount.Int64(),
+		Size:              order.Nft.Amount.Int64(),
+		OrderType:         multi.TraitBidOrder,
+		Trait:             req.Trait,
+		TraitValue:        req.TraitValue,
+		Salt:              int64(order.Salt),
+		Signature:         req.Signature,
+	}
+	if err := svcCtx.Dao.CreateTraitBid(ctx, chain, &newOrder); err != nil {
+		xzap.WithContext(ctx).Error("failed on create trait bid", zap.Error(err), zap.String("order_id", newOrder.OrderID))
+		return nil, errcode.NewCustomErr("failed on create trait bid")
+	}
+
+	return &types.TraitBidResp{
+		Result: newOrder,
+	}, nil
+}
+
+// BuildTraitBidFillTx 撮合属性出价, 构造出价人购买指定 Item 挂单的 matchOrder 待签名交易
+// 合约只能成交已上链的订单, 属性出价不在合约中, 因此由出价人作为吃单方购买符合条件的挂单:
+// 1. 属性出价为 Active、未过期且有剩余数量
+// 2. Item 拥有出价的属性, 且有不高于出价的有效挂单 (取最低价)
+// 3. 买单按挂单价格构造, 盐值沿用属性出价, sync 收到 LogMatch 后据此扣减属性出价的剩余数量
+func BuildTraitBidFillTx(ctx context.Context, svcCtx *svc.ServerCtx, chain string, maker string, orderID string, req *types.TraitBidFillTxReq) (*types.UnsignedTxResp, error) {
+	bid, err := svcCtx.Dao.QueryTraitBid(ctx, chain, strings.ToLower(orderID))
+	if err != nil {
+		return nil, errcode.NewCustomErr("trait bid not found")
+	}
+	if !strings.EqualFold(bid.Maker, maker) {
+		return nil, errcode.NewCustomErr("only maker can fill trait bid")
+	}
+	now := time.Now()
+	if bid.OrderStatus != multi.OrderStatusActive || bid.QuantityRemaining <= 0 {
+		return nil, errcode.NewCustomErr("trait bid is not active")
+	}
+	if bid.ExpireTime <= now.Unix() {
+		return nil, errcode.NewCustomErr("trait bid is expired")
+	}
+
+	// 1. 校验 Item 拥有该属性
+	if _, ok := new(big.Int).SetString(req.TokenId, 10); !ok {
+		return nil, errcode.NewCustomErr("invalid token id")
+	}
+	hasTrait, err := svcCtx.Dao.QueryItemHasTrait(ctx, chain, bid.CollectionAddress, req.TokenId, bid.Trait, bid.TraitValue)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query item trait", zap.Error(err), zap.String("order_id", bid.OrderID))
+		return nil, errcode.ErrUnexpected
+	}
+	if !hasTrait {
+		return nil, errcode.NewCustomErr("item does not have the trait")
+	}
+
+	// 2. 查询可成交的挂单
+	listing, err := svcCtx.Dao.QueryTraitBidListing(ctx, chain, bid.CollectionAddress, req.TokenId, bid.Price, bid.Maker)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query trait bid listing", zap.Error(err), zap.String("order_id", bid.OrderID))
+		return nil, errcode.ErrUnexpected
+	}
+	if listing == nil {
+		return nil, errcode.NewCustomErr("no listing within trait bid price")
+	}
+	sellOrder, err := liborder.FromModel(listing)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	// 3. 构造买单, 按挂单价格支付
+	expiry := now.Add(takerOrderTTL).Unix()
+	if bid.ExpireTime < expiry {
+		expiry = bid.ExpireTime
+	}
+	buyOrder := liborder.Order{
+		Side:     liborder.SideBid,
+		SaleKind: liborder.SaleKindFixedPriceForItem,
+		Maker:    common.HexToAddress(bid.Maker),
+		Nft: liborder.Asset{
+			TokenId:    sellOrder.Nft.TokenId,
+			Collection: sellOrder.Nft.Collection,
+			Amount:     big.NewInt(1),
+		},
+		Price:  sellOrder.Price,
+		Expiry: uint64(expiry),
+		Salt:   uint64(bid.Salt),
+	}
+
+	client, err := nodeEthClient(svcCtx, req.ChainID)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	if err := checkBalance(ctx, client, buyOrder.Maker, sellOrder.Price); err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+
+	data, err := liborder.PackMatchOrder(*sellOrder, buyOrder)
+	if err != nil {
+		return nil, errcode.NewCustomErr(err.Error())
+	}
+	return buildUnsignedTx(ctx, svcCtx, req.ChainID, buyOrder.Maker, data, sellOrder.Price, []string{listing.OrderID, bid.OrderID})
+}
+
+// CancelTraitBid 取消属性出价, 仅订单创建者可以取消
+// 属性出价只能由后端撮合, 取消后不再构造成交交易, 无需上链
+func CancelTraitBid(ctx context.Context, svcCtx *svc.ServerCtx, chain string, maker string, orderID string) error {
+	order, err := svcCtx.Dao.QueryTraitBid(ctx, chain, orderID)
+	if err != nil {
+		return errcode.NewCustomErr("trait bid not found")
+	}
+
+	if !strings.EqualFold(order.Maker, maker) {
+		return errcode.NewCustomErr("only maker can cancel trait bid")
+	}
+	if order.OrderStatus != multi.OrderStatusActive {
+		return errcode.NewCustomErr("trait bid is not active")
+	}
+
+	if err := svcCtx.Dao.CancelTraitBid(ctx, chain, order); err != nil {
+		xzap.WithContext(ctx).Error("failed on cancel trait bid", zap.Error(err), zap.String("order_id", orderID))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// getItemBestTraitBid 查询适用于单个 Item 的最高属性出价
+func getItemBestTraitBid(ctx context.Context, svcCtx *svc.ServerCtx, chain, collectionAddr, tokenID string) (*types.ItemBid, error) {
+	bids, err := svcCtx.Dao.QueryItemsBestTraitBids(ctx, chain, "", collectionAddr, []string{tokenID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get item trait bids")
+	}
+
+	var best *multi.Order
+	for i := range bids {
+		if best == nil || bids[i].Price.GreaterThan(best.Price) {
+			best = &bids[i]
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+
+	return &types.ItemBid{
+		MarketplaceId:     best.MarketplaceId,
+		CollectionAddress: collectionAddr,
+		TokenId:           tokenID,
+		OrderID:           best.OrderID,
+		EventTime:         best.EventTime,
+		ExpireTime:        best.ExpireTime,
+		Price:             best.Price,
+		Salt:              best.Salt,
+		BidSize:           best.Size,
+		BidUnfilled:       best.QuantityRemaining,
+		BidFilled:         best.Size - best.QuantityRemaining,
+		Bidder:            best.Maker,
+		OrderType:         getBidType(best.OrderType),
+		Trait:             best.Trait,
+		TraitValue:        best.TraitValue,
+	}, nil
+}
+
+// genTraitBidOrderID 生成属性出价订单 ID
+// keccak256(order_key, trait, trait_value), 与合约订单的 OrderKey 区分
+func genTraitBidOrderID(orderKey string, trait, traitValue string) string {
+	raw := fmt.Sprintf("%s:%s:%s", orderKey, trait, traitValue)
+	return crypto.Keccak256Hash([]byte(raw)).Hex()
+}
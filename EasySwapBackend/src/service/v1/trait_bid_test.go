@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+const testCollection = "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"
+
+var (
+	traitItemCountQuery = regexp.QuoteMeta("SELECT count(*) FROM `ob_item_trait_sepolia` WHERE collection_address = ? and trait = ? and trait_value = ?")
+	traitBidQuery       = regexp.QuoteMeta("SELECT * FROM `ob_order_sepolia` WHERE order_id = ? and order_type = ?")
+	itemHasTraitQuery   = regexp.QuoteMeta("SELECT count(*) FROM `ob_item_trait_sepolia` WHERE collection_address = ? and token_id = ? and trait = ? and trait_value = ?")
+	traitListingQuery   = regexp.QuoteMeta("SELECT co.* FROM ob_order_sepolia as co")
+)
+
+// traitBidReq 构造由测试 Maker 签名的属性出价请求
+func traitBidReq(t *testing.T, svcCtx *svc.ServerCtx, param types.OrderParam) *types.TraitBidReq {
+	signed := signedOrderReq(t, svcCtx, testMakerKey, param)
+	return &types.TraitBidReq{
+		ChainID:    testChainID,
+		OrderParam: param,
+		Signature:  signed.Signature,
+		Trait:      "Background",
+		TraitValue: "Red",
+	}
+}
+
+func collectionBidParam(maker common.Address) types.OrderParam {
+	return types.OrderParam{
+		Side:     liborder.SideBid,
+		SaleKind: liborder.SaleKindFixedPriceForCollection,
+		Maker:    maker.Hex(),
+		Nft:      types.SignedOrderAsset{TokenId: "0", Collection: testCollection, Amount: 2},
+		Price:    "1000000000000000000",
+		Expiry:   uint64(time.Now().Add(time.Hour).Unix()),
+		Salt:     7,
+	}
+}
+
+func TestCreateTraitBid(t *testing.T) {
+	maker := testMaker(t)
+	svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(2e18)})
+	req := traitBidReq(t, svcCtx, collectionBidParam(maker))
+
+	mock.ExpectQuery(traitItemCountQuery).WithArgs(testCollection, "Background", "Red").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderInsert).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	resp, err := CreateTraitBid(context.Background(), svcCtx, "sepolia", strings.ToLower(maker.Hex()), req)
+	require.NoError(t, err)
+	order, err := parseOrderParam(&req.OrderParam)
+	require.NoError(t, err)
+	// 订单 ID 与合约 OrderKey 区分, 避免与同参数的链上集合出价冲突
+	assert.Equal(t, genTraitBidOrderID(order.KeyHex(), "Background", "Red"), resp.Result.OrderID)
+	assert.NotEqual(t, order.KeyHex(), resp.Result.OrderID)
+	assert.Equal(t, multi.OrderStatusActive, resp.Result.OrderStatus)
+	assert.Equal(t, int64(multi.TraitBidOrder), int64(resp.Result.OrderType))
+	assert.Equal(t, int64(2), resp.Result.QuantityRemaining)
+	assert.Equal(t, int64(7), resp.Result.Salt)
+	assert.Equal(t, req.Signature, resp.Result.Signature)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateTraitBidRejected(t *testing.T) {
+	maker := testMaker(t)
+	other := "0x70997970c51812dc3a010c7d01b50e0d17dc79c8"
+	// 各用例的合约配置相同, 使用同一签名域
+	signCtx, _ := newSignedOrderServerCtx(t, &fakeNode{})
+
+	item := collectionBidParam(maker)
+	item.SaleKind = liborder.SaleKindFixedPriceForItem
+	item.Nft.TokenId = "1"
+	invalidSig := traitBidReq(t, signCtx, collectionBidParam(maker))
+	invalidSig.Salt = 8
+
+	cases := []struct {
+		name  string
+		maker string
+		req   *types.TraitBidReq
+		node  *fakeNode
+		err   string
+	}{
+		{name: "item bid", maker: maker.Hex(), req: traitBidReq(t, signCtx, item), node: &fakeNode{balance: big.NewInt(2e18)},
+			err: "trait bid must be a collection bid"},
+		{name: "maker is not login user", maker: other, req: traitBidReq(t, signCtx, collectionBidParam(maker)), node: &fakeNode{balance: big.NewInt(2e18)},
+			err: "maker must be the login user"},
+		{name: "signature does not match order", maker: maker.Hex(), req: invalidSig, node: &fakeNode{balance: big.NewInt(2e18)},
+			err: "invalid signature"},
+		// 出价需要 price * amount 的余额
+		{name: "insufficient balance", maker: maker.Hex(), req: traitBidReq(t, signCtx, collectionBidParam(maker)), node: &fakeNode{balance: big.NewInt(1e18)},
+			err: "insufficient balance"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			svcCtx, mock := newSignedOrderServerCtx(t, c.node)
+			_, err := CreateTraitBid(context.Background(), svcCtx, "sepolia", c.maker, c.req)
+			assert.EqualError(t, err, c.err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+
+	// 集合中不存在该属性
+	svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(2e18)})
+	mock.ExpectQuery(traitItemCountQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	_, err := CreateTraitBid(context.Background(), svcCtx, "sepolia", maker.Hex(), traitBidReq(t, signCtx, collectionBidParam(maker)))
+	assert.EqualError(t, err, "trait not found in collection")
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+// traitBidRows 属性出价订单, 单价 1 ETH, 剩余 1 个
+func traitBidRows(maker string, status int, expireTime int64) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"order_id", "order_type", "collection_address", "maker", "order_status",
+		"price", "size", "quantity_remaining", "expire_time", "salt", "trait", "trait_value"}).
+		AddRow("0xtrait", multi.TraitBidOrder, testCollection, maker, status,
+			"1000000000000000000", 2, 1, expireTime, 7, "Background", "Red")
+}
+
+func TestBuildTraitBidFillTx(t *testing.T) {
+	maker := testMaker(t)
+	bidder := strings.ToLower(maker.Hex())
+	seller := "0x70997970c51812dc3a010c7d01b50e0d17dc79c8"
+	bidExpiry := time.Now().Add(10 * time.Minute).Unix()
+	svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(1e18)})
+
+	mock.ExpectQuery(traitBidQuery).WithArgs("0xtrait", multi.TraitBidOrder).
+		WillReturnRows(traitBidRows(bidder, multi.OrderStatusActive, bidExpiry))
+	mock.ExpectQuery(itemHasTraitQuery).WithArgs(testCollection, "1", "Background", "Red").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(traitListingQuery).
+		WithArgs(testCollection, "1", multi.ListingOrder, multi.OrderStatusActive, bidder, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "order_type", "collection_address", "token_id", "maker",
+			"price", "size", "expire_time", "salt"}).
+			AddRow("0xlisting", multi.ListingOrder, testCollection, "1", seller, "800000000000000000", 1, 0, 3))
+
+	resp, err := BuildTraitBidFillTx(context.Background(), svcCtx, "sepolia", bidder, "0xTRAIT",
+		&types.TraitBidFillTxReq{ChainID: testChainID, TokenId: "1"})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// 出价人以挂单价格购买, 买单沿用属性出价的盐值, 有效期不超过属性出价
+	sellOrder := liborder.Order{
+		Side:     liborder.SideList,
+		SaleKind: liborder.SaleKindFixedPriceForItem,
+		Maker:    common.HexToAddress(seller),
+		Nft:      liborder.Asset{TokenId: big.NewInt(1), Collection: common.HexToAddress(testCollection), Amount: big.NewInt(1)},
+		Price:    big.NewInt(8e17),
+		Salt:     3,
+	}
+	buyOrder := liborder.Order{
+		Side:     liborder.SideBid,
+		SaleKind: liborder.SaleKindFixedPriceForItem,
+		Maker:    maker,
+		Nft:      liborder.Asset{TokenId: big.NewInt(1), Collection: common.HexToAddress(testCollection), Amount: big.NewInt(1)},
+		Price:    big.NewInt(8e17),
+		Expiry:   uint64(bidExpiry),
+		Salt:     7,
+	}
+	data, err := liborder.PackMatchOrder(sellOrder, buyOrder)
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(data), resp.Data)
+	assert.Equal(t, bidder, resp.From)
+	assert.Equal(t, "800000000000000000", resp.Value)
+	assert.Equal(t, []string{"0xlisting", "0xtrait"}, resp.OrderIDs)
+}
+
+func TestBuildTraitBidFillTxRejected(t *testing.T) {
+	bidder := strings.ToLower(testMaker(t).Hex())
+	req := &types.TraitBidFillTxReq{ChainID: testChainID, TokenId: "1"}
+	future := time.Now().Add(time.Hour).Unix()
+
+	t.Run("not maker", func(t *testing.T) {
+		svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(1e18)})
+		mock.ExpectQuery(traitBidQuery).WillReturnRows(traitBidRows(bidder, multi.OrderStatusActive, future))
+		_, err := BuildTraitBidFillTx(context.Background(), svcCtx, "sepolia", "0xother", "0xtrait", req)
+		assert.EqualError(t, err, "only maker can fill trait bid")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(1e18)})
+		mock.ExpectQuery(traitBidQuery).WillReturnRows(traitBidRows(bidder, multi.OrderStatusCancelled, future))
+		_, err := BuildTraitBidFillTx(context.Background(), svcCtx, "sepolia", bidder, "0xtrait", req)
+		assert.EqualError(t, err, "trait bid is not active")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(1e18)})
+		mock.ExpectQuery(traitBidQuery).WillReturnRows(traitBidRows(bidder, multi.OrderStatusActive, time.Now().Unix()-1))
+		_, err := BuildTraitBidFillTx(context.Background(), svcCtx, "sepolia", bidder, "0xtrait", req)
+		assert.EqualError(t, err, "trait bid is expired")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("item without trait", func(t *testing.T) {
+		svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(1e18)})
+		mock.ExpectQuery(traitBidQuery).WillReturnRows(traitBidRows(bidder, multi.OrderStatusActive, future))
+		mock.ExpectQuery(itemHasTraitQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		_, err := BuildTraitBidFillTx(context.Background(), svcCtx, "sepolia", bidder, "0xtrait", req)
+		assert.EqualError(t, err, "item does not have the trait")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("no listing within price", func(t *testing.T) {
+		svcCtx, mock := newSignedOrderServerCtx(t, &fakeNode{balance: big.NewInt(1e18)})
+		mock.ExpectQuery(traitBidQuery).WillReturnRows(traitBidRows(bidder, multi.OrderStatusActive, future))
+		mock.ExpectQuery(itemHasTraitQuery).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(traitListingQuery).WillReturnRows(sqlmock.NewRows([]string{"order_id"}))
+		_, err := BuildTraitBidFillTx(context.Background(), svcCtx, "sepolia", bidder, "0xtrait", req)
+		assert.EqualError(t, err, "no listing within trait bid price")
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -1,23 +1,23 @@
 package service
 
 import (
-	"bytes"
 	"context"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/rand"
-	"encoding/hex"
-	"fmt"
-	"io"
 	"strings"
 	"time"
 
 	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/evm/eip"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/ProjectsTask/EasySwapBackend/src/api/middleware"
+	"github.com/ProjectsTask/EasySwapBackend/src/common/session"
+	"github.com/ProjectsTask/EasySwapBackend/src/config"
 	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
 	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
 )
@@ -26,48 +26,58 @@ func getUserLoginMsgCacheKey(address string) string {
 	return middleware.CR_LOGIN_MSG_KEY + ":" + strings.ToLower(address)
 }
 
-func getUserLoginTokenCacheKey(address string) string {
-	return middleware.CR_LOGIN_KEY + ":" + strings.ToLower(address)
-}
-
 // UserLogin 用户登录接口
 // 功能:
-// 1. 验证用户签名 (Signature Verification) [注: 目前验证逻辑被注释，使用了 Mock 验证]
+// 1. 解析并校验 SIWE (EIP-4361) 消息: domain/uri/chain id/地址与配置及请求一致, 处于有效期内
 // 2. 验证 Nonce 有效性 (防止重放攻击)
-// 3. 生成并缓存 JWT/Token (用于后续接口鉴权)
-// 4. 如果用户不存在则自动注册 (Auto Register)
-func UserLogin(ctx context.Context, svcCtx *svc.ServerCtx, req types.LoginReq) (*types.UserLoginInfo, error) {
+// 3. 验证签名: EOA 使用 personal_sign 恢复签名者, 合约钱包使用 EIP-1271 isValidSignature
+// 4. 消费 Nonce (一次性), 生成并缓存 Token (用于后续接口鉴权)
+// 5. 如果用户不存在则自动注册 (Auto Register), 被封禁 (is_allowed = false) 的用户拒绝登录
+func UserLogin(ctx context.Context, svcCtx *svc.ServerCtx, req types.LoginReq, device types.LoginDevice) (*types.UserLoginInfo, error) {
 	// 返回结果容器
 	res := types.UserLoginInfo{}
 
-	// 1. 签名验证 (TODO: 生产环境必须开启)
-	//todo: add verify signature
-	//ok := verifySignature(req.Message, req.Signature, req.PublicKey)
-	//if !ok {
-	//	return nil, errors.New("invalid signature")
-	//}
+	siwe, err := siweConfig(svcCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 解析并校验登录消息
+	msg, err := eip.ParseSiweMessage(req.Message)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid login message")
+	}
+	if msg.Domain != siwe.Domain || msg.URI != siwe.URI {
+		return nil, errors.New("login message domain mismatch")
+	}
+	if msg.ChainID != int64(req.ChainID) || !strings.EqualFold(msg.Address.String(), req.Address) {
+		return nil, errors.New("login message mismatch")
+	}
+	if err := msg.Validate(time.Now()); err != nil {
+		return nil, err
+	}
 
 	// 2. 验证 Nonce (防止重放攻击)
-	// 2.1 从缓存中获取该地址对应的登录消息 UUID (Key: prefix + userAddr)
-	cachedUUID, err := svcCtx.KvStore.Get(getUserLoginMsgCacheKey(req.Address))
-	if cachedUUID == "" || err != nil {
-		// 如果缓存中没有 Nonce, 说明可能已过期或从未申请过
+	// 从缓存中获取该地址对应的登录 Nonce (Key: prefix + userAddr)
+	nonceKey := getUserLoginMsgCacheKey(req.Address)
+	cachedNonce, err := svcCtx.KvStore.Get(nonceKey)
+	if cachedNonce == "" || err != nil || cachedNonce != msg.Nonce {
+		// 如果缓存中没有 Nonce, 说明可能已过期、已使用或从未申请过
 		return nil, errcode.ErrTokenExpire
 	}
 
-	// 2.2 解析前端传递的消息以获取 Nonce
-	// 预期消息格式: "Welcome to EasySwap!\nNonce:<uuid>"
-	splits := strings.Split(req.Message, "Nonce:")
-	if len(splits) != 2 {
-		return nil, errcode.ErrTokenExpire
+	// 3. 签名验证
+	if err := verifyLoginSignature(ctx, svcCtx, req.ChainID, msg.Address, req.Message, req.Signature); err != nil {
+		return nil, err
 	}
 
-	// 2.3 比对 Nonce 是否一致
-	loginUUID := strings.Trim(splits[1], "\n")
-	if loginUUID != cachedUUID {
+	// 4. 消费 Nonce, 并发请求中仅一个能成功
+	if consumed, err := svcCtx.KvStore.GetDel(nonceKey); err != nil || consumed != msg.Nonce {
 		return nil, errcode.ErrTokenExpire
 	}
 
+	req.Address = strings.ToLower(msg.Address.String())
+
 	// 3. 查询或创建用户信息 (Auto Register)
 	var user base.User
 	db := svcCtx.DB.WithContext(ctx).Table(base.UserTableName()).
@@ -81,104 +91,170 @@ func UserLogin(ctx context.Context, svcCtx *svc.ServerCtx, req types.LoginReq) (
 	// 如果用户不存在则创建新用户
 	if user.Id == 0 {
 		now := time.Now().UnixMilli()
-		user := &base.User{
+		user = base.User{
 			Address:    req.Address,
-			IsAllowed:  false, // 默认未授权
-			IsSigned:   true,  // 标记为已签名登录
+			IsAllowed:  true, // 默认允许访问, 由管理员封禁
+			IsSigned:   true, // 标记为已签名登录
 			CreateTime: now,
 			UpdateTime: now,
 		}
 		if err := svcCtx.DB.WithContext(ctx).Table(base.UserTableName()).
-			Create(user).Error; err != nil {
-			return nil, errors.Wrap(db.Error, "failed on create new user")
+			Create(&user).Error; err != nil {
+			return nil, errors.Wrap(err, "failed on create new user")
 		}
 	}
-
-	// 4. 生成并缓存 User Token
-	// tokenKey: login_token_key + userAddress
-	tokenKey := getUserLoginTokenCacheKey(req.Address)
-
-	// 使用 AES 加密生成 Token (TODO: 建议使用标准 JWT)
-	userToken, err := AesEncryptOFB([]byte(tokenKey), []byte(middleware.CR_LOGIN_SALT))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed on get user token")
+	if !user.IsAllowed {
+		return nil, errcode.ErrPermissionDenied
 	}
 
-	// 缓存 Token (Value: UUID, TTL: 30天)
-	if err := CacheUserToken(svcCtx, tokenKey, uuid.NewString()); err != nil {
-		return nil, err
+	// 5. 创建会话并签发 access/refresh token
+	pair, err := svcCtx.Sessions.Create(req.Address, device.UserAgent, device.IP)
+	if errors.Is(err, session.ErrUserBlocked) {
+		return nil, errcode.ErrPermissionDenied
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on create session")
 	}
 
-	// 5. 设置返回结果
-	res.Token = hex.EncodeToString(userToken)
+	// 6. 设置返回结果
+	res.UserTokens = newUserTokens(pair)
 	res.IsAllowed = user.IsAllowed
 
-	return &res, err
+	return &res, nil
 }
 
-// CacheUserToken 将用户 Token 写入 Redis 缓存
-// Key: login_token_key:address
-// Value: uuid (随机值, 目前似乎仅用于占位或简单验证)
-// TTL: 30天
-func CacheUserToken(svcCtx *svc.ServerCtx, tokenKey, token string) error {
-	if err := svcCtx.KvStore.Setex(tokenKey, token, 30*24*60*60); err != nil {
-		return err
+// RefreshUserToken 使用 refresh token 换取新的 token 对
+func RefreshUserToken(ctx context.Context, svcCtx *svc.ServerCtx, refreshToken string) (*types.UserTokens, error) {
+	pair, err := svcCtx.Sessions.Refresh(refreshToken)
+	if errors.Is(err, session.ErrUserBlocked) {
+		return nil, errcode.ErrPermissionDenied
 	}
+	if err != nil {
+		xzap.WithContext(ctx).Warn("failed on refresh token", zap.Error(err))
+		return nil, errcode.ErrTokenExpire
+	}
+	tokens := newUserTokens(pair)
+	return &tokens, nil
+}
 
+// UserLogout 退出当前会话 (设备)
+func UserLogout(ctx context.Context, svcCtx *svc.ServerCtx, userAddr, sessionID string) error {
+	if err := svcCtx.Sessions.Revoke(userAddr, sessionID); err != nil {
+		return errors.Wrap(err, "failed on logout")
+	}
 	return nil
 }
 
-// AesEncryptOFB 使用 AES-OFB 模式进行加密
-// 参数:
-// - data: 待加密数据
-// - key: 加密密钥
-func AesEncryptOFB(data []byte, key []byte) ([]byte, error) {
-	// 对数据进行 PKCS7 填充，确保长度符合 AES 块大小要求
-	data = PKCS7Padding(data, aes.BlockSize)
-	// 创建 AES Cipher
-	block, _ := aes.NewCipher([]byte(key))
-	out := make([]byte, aes.BlockSize+len(data))
-	// 随机生成 IV (初始化向量)
-	iv := out[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return nil, err
+// UserLogoutAll 退出用户的所有会话
+func UserLogoutAll(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string) error {
+	if err := svcCtx.Sessions.RevokeAll(userAddr); err != nil {
+		return errors.Wrap(err, "failed on logout all")
 	}
+	return nil
+}
 
-	// 使用 OFB 模式加密
-	stream := cipher.NewOFB(block, iv)
-	stream.XORKeyStream(out[aes.BlockSize:], data)
-	return out, nil
+// GetUserSessions 查询用户的有效会话列表, 标记当前请求所属会话
+func GetUserSessions(ctx context.Context, svcCtx *svc.ServerCtx, userAddr, currentSessionID string) (*types.UserSessionsResp, error) {
+	sessions, err := svcCtx.Sessions.List(userAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get user sessions")
+	}
+
+	result := make([]types.UserSession, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, types.UserSession{
+			SessionID:     s.ID,
+			UserAgent:     s.UserAgent,
+			IP:            s.IP,
+			CreatedAt:     s.CreatedAt,
+			LastRefreshAt: s.LastRefreshAt,
+			ExpiresAt:     s.ExpiresAt,
+			Current:       s.ID == currentSessionID,
+		})
+	}
+	return &types.UserSessionsResp{Result: result}, nil
 }
 
-// PKCS7Padding 补码
-// PKCS7Padding 负责对 AES 加密数据块进行填充
-// AES加密数据块分组长度必须为128bit(byte[16])，密钥长度可以是128bit(byte[16])、192bit(byte[24])、256bit(byte[32])中的任意一个。
-func PKCS7Padding(ciphertext []byte, blocksize int) []byte {
-	padding := blocksize - len(ciphertext)%blocksize
-	// 填充 padding 个 byte(padding)
-	padtext := bytes.Repeat([]byte{byte(padding)}, padding)
-	return append(ciphertext, padtext...)
+func newUserTokens(pair *session.TokenPair) types.UserTokens {
+	return types.UserTokens{
+		SessionID:        pair.SessionID,
+		AccessToken:      pair.AccessToken,
+		AccessExpiresAt:  pair.AccessExpiresAt,
+		RefreshToken:     pair.RefreshToken,
+		RefreshExpiresAt: pair.RefreshExpiresAt,
+		TokenType:        "Bearer",
+	}
+}
+
+// verifyLoginSignature 验证登录签名
+// 先按 EOA 恢复 personal_sign 签名者, 不一致时按合约钱包通过 EIP-1271 校验
+func verifyLoginSignature(ctx context.Context, svcCtx *svc.ServerCtx, chainID int, address common.Address, message, signature string) error {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return errors.New("invalid signature")
+	}
+	if signer, err := eip.RecoverPersonalSign([]byte(message), sig); err == nil && signer == address {
+		return nil
+	}
+
+	nodeSrv, ok := svcCtx.NodeSrvs[int64(chainID)]
+	if !ok {
+		return errors.New("invalid signature")
+	}
+	valid, err := eip.IsValidSignature(ctx, nodeSrv.NodeClient, address, eip.PersonalSignHash([]byte(message)), sig)
+	if err != nil {
+		return errors.Wrap(err, "failed on verify contract wallet signature")
+	}
+	if !valid {
+		return errors.New("invalid signature")
+	}
+	return nil
 }
 
-func genLoginTemplate(nonce string) string {
-	return fmt.Sprintf("Welcome to EasySwap!\nNonce:%s", nonce)
+// siweConfig 获取登录消息配置, 未配置有效期时默认 10 分钟
+func siweConfig(svcCtx *svc.ServerCtx) (*config.Siwe, error) {
+	if svcCtx.C.Siwe == nil || svcCtx.C.Siwe.Domain == "" || svcCtx.C.Siwe.URI == "" {
+		return nil, errors.New("login is not configured")
+	}
+	siwe := *svcCtx.C.Siwe
+	if siwe.ExpireSeconds <= 0 {
+		siwe.ExpireSeconds = 10 * 60
+	}
+	return &siwe, nil
 }
 
-// GetUserLoginMsg 生成并返回用户的登录签名消息 (Nonce)
+// GetUserLoginMsg 生成并返回用户的 SIWE (EIP-4361) 登录消息
 // 功能:
-// 1. 生成一个随机的 UUID 作为 Nonce，防止重放攻击
-// 2. 将 UUID 存入 Redis，设置过期时间 (72小时)
-// 3. 返回包含 Nonce 的签名原文，供前端进行 Web3 签名
-func GetUserLoginMsg(ctx context.Context, svcCtx *svc.ServerCtx, address string) (*types.UserLoginMsgResp, error) {
-	uuid := uuid.NewString() // 生成唯一标识
-	loginMsg := genLoginTemplate(uuid)
-	// 将 UUID 存入 redis，有效期 72 小时
-	// Key: login_message_prefix + userAddress
-	if err := svcCtx.KvStore.Setex(getUserLoginMsgCacheKey(address), uuid, 72*60*60); err != nil {
+// 1. 生成随机 Nonce，防止重放攻击
+// 2. 将 Nonce 存入 Redis，有效期与消息的 Expiration Time 一致
+// 3. 返回包含 domain/chain id/nonce/签发及过期时间的签名原文，供前端 personal_sign
+func GetUserLoginMsg(ctx context.Context, svcCtx *svc.ServerCtx, address string, chainID int) (*types.UserLoginMsgResp, error) {
+	if !common.IsHexAddress(address) {
+		return nil, errors.New("invalid address")
+	}
+	siwe, err := siweConfig(svcCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	msg := eip.SiweMessage{
+		Domain:         siwe.Domain,
+		Address:        common.HexToAddress(address),
+		Statement:      siwe.Statement,
+		URI:            siwe.URI,
+		Version:        eip.SiweVersion,
+		ChainID:        int64(chainID),
+		Nonce:          strings.ReplaceAll(uuid.NewString(), "-", ""), // EIP-4361 要求 nonce 为字母数字
+		IssuedAt:       now,
+		ExpirationTime: now.Add(time.Duration(siwe.ExpireSeconds) * time.Second),
+	}
+	// Key: login_message_prefix + userAddress, 重新申请会覆盖旧 Nonce
+	if err := svcCtx.KvStore.Setex(getUserLoginMsgCacheKey(address), msg.Nonce, int(siwe.ExpireSeconds)); err != nil {
 		return nil, errors.Wrap(err, "failed on generate login msg")
 	}
 
-	return &types.UserLoginMsgResp{Address: address, Message: loginMsg}, nil
+	return &types.UserLoginMsgResp{Address: address, Message: msg.String()}, nil
 }
 
 // GetSigStatusMsg 查询用户的签名状态
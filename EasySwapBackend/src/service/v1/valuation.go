@@ -0,0 +1,352 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+// totalShare 协议手续费率的分母, 与合约 TOTAL_SHARE 一致
+const totalShare = 10000
+
+// valueHistoryPeriods 持仓价值历史支持的周期及采样间隔 (秒), 地板价快照保留 60 天
+var valueHistoryPeriods = map[string][2]int64{
+	"1d":  {DaySeconds, HourSeconds},
+	"7d":  {7 * DaySeconds, 6 * HourSeconds},
+	"30d": {30 * DaySeconds, DaySeconds},
+	"60d": {60 * DaySeconds, DaySeconds},
+}
+
+// costBasis 持仓的买入成本
+type costBasis struct {
+	price decimal.Decimal
+	time  int64
+}
+
+// feeSchedule 协议手续费率历史, 按成交所在位置取当时生效的费率计算手续费
+type feeSchedule struct {
+	shares   []multi.ProtocolShare // 按区块号及日志序号升序
+	fallback int64                 // 没有费率记录时使用配置的费率
+}
+
+// loadFeeSchedule 查询链上同步的协议手续费率历史
+func loadFeeSchedule(ctx context.Context, svcCtx *svc.ServerCtx, chain string) (feeSchedule, error) {
+	fees := feeSchedule{}
+	if svcCtx.C.EasySwapMarket != nil && svcCtx.C.EasySwapMarket.Fee > 0 {
+		fees.fallback = svcCtx.C.EasySwapMarket.Fee
+	}
+	shares, err := svcCtx.Dao.QueryProtocolShares(ctx, chain)
+	if err != nil {
+		return fees, err
+	}
+	fees.shares = shares
+	return fees, nil
+}
+
+// shareAt 返回区块 blockNumber 中第 logIndex 条日志处生效的费率
+// 早于第一条记录的成交使用最早记录的费率 (从合约部署区块开始同步时即 initialize 设置的费率)
+func (f feeSchedule) shareAt(blockNumber, logIndex int64) int64 {
+	if len(f.shares) == 0 {
+		return f.fallback
+	}
+	i := sort.Search(len(f.shares), func(i int) bool {
+		share := f.shares[i]
+		return share.BlockNumber > blockNumber || (share.BlockNumber == blockNumber && share.LogIndex > logIndex)
+	})
+	if i == 0 {
+		return f.shares[0].Share
+	}
+	return f.shares[i-1].Share
+}
+
+// current 当前生效的费率, 用于估算按地板价卖出的手续费
+func (f feeSchedule) current() int64 {
+	if len(f.shares) == 0 {
+		return f.fallback
+	}
+	return f.shares[len(f.shares)-1].Share
+}
+
+// shareFee 计算成交价对应的协议手续费, 与合约一致向下取整
+func shareFee(price decimal.Decimal, share int64) decimal.Decimal {
+	if share <= 0 {
+		return decimal.Zero
+	}
+	return price.Mul(decimal.NewFromInt(share)).Div(decimal.NewFromInt(totalShare)).Floor()
+}
+
+// replayFills 按时间顺序回放用户成交: 买入记录最近一次的成本, 卖出按成交时的费率扣除手续费后结算已实现盈亏
+// 返回仍持有 (未卖出) 的 Token 的买入成本, key 为 collection:tokenId
+func replayFills(fills []multi.Fill, fees feeSchedule, getCollection func(addr string) *types.CollectionValuation) map[string]costBasis {
+	costs := make(map[string]costBasis)
+	for _, fill := range fills {
+		collectionAddr := strings.ToLower(fill.CollectionAddress)
+		key := fmt.Sprintf("%s:%s", collectionAddr, fill.TokenId)
+		if fill.OrderType != multi.ListingOrder {
+			costs[key] = costBasis{price: fill.Price, time: fill.EventTime}
+			continue
+		}
+
+		collection := getCollection(collectionAddr)
+		fee := shareFee(fill.Price, fees.shareAt(fill.BlockNumber, fill.LogIndex))
+		proceeds := fill.Price.Sub(fee)
+		collection.SellProceeds = collection.SellProceeds.Add(proceeds)
+		collection.ProtocolFees = collection.ProtocolFees.Add(fee)
+		if cost, ok := costs[key]; ok {
+			collection.RealizedPnl = collection.RealizedPnl.Add(proceeds.Sub(cost.price))
+			delete(costs, key)
+		}
+	}
+	return costs
+}
+
+// unrealizedPnl 按地板价卖出扣除当前费率的手续费后相对买入成本的盈亏
+func unrealizedPnl(floorPrice decimal.Decimal, cost costBasis, fees feeSchedule) decimal.Decimal {
+	return floorPrice.Sub(shareFee(floorPrice, fees.current())).Sub(cost.price)
+}
+
+// GetMultiChainUserValuation 计算用户在多链上的持仓估值及盈亏
+// 功能:
+// 1. 按集合地板价及集合最高出价为每个持仓估值
+// 2. 以用户买入成交 (Listing 被用户买入或用户 Bid 成交) 的最近一次价格作为持仓成本
+// 3. 用户卖出成交按成交时的费率扣除协议手续费后, 与该 Token 之前的买入成本相减得到已实现盈亏
+func GetMultiChainUserValuation(ctx context.Context, svcCtx *svc.ServerCtx, chainIDs []int, chainNames []string, userAddrs []string) (*types.UserValuationResp, error) {
+	result := &types.PortfolioValuation{
+		Collections: []types.CollectionValuation{},
+		Items:       []types.ItemValuation{},
+	}
+	for i, chain := range chainNames {
+		holdings, err := svcCtx.Dao.QueryUserHoldings(ctx, chain, userAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get user holdings")
+		}
+		fills, err := svcCtx.Dao.QueryUserFills(ctx, chain, userAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get user fills")
+		}
+		fees, err := loadFeeSchedule(ctx, svcCtx, chain)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get protocol shares")
+		}
+
+		collectionsMap := make(map[string]*types.CollectionValuation)
+		var collectionAddrs []string
+		getCollection := func(addr string) *types.CollectionValuation {
+			collection, ok := collectionsMap[addr]
+			if !ok {
+				collection = &types.CollectionValuation{ChainID: chainIDs[i], CollectionAddress: addr}
+				collectionsMap[addr] = collection
+				collectionAddrs = append(collectionAddrs, addr)
+			}
+			return collection
+		}
+
+		// 1. 按时间顺序回放成交: 买入记录成本, 卖出结算已实现盈亏
+		costs := replayFills(fills, fees, getCollection)
+
+		for _, item := range holdings {
+			getCollection(strings.ToLower(item.CollectionAddress)).ItemCount++
+		}
+		if len(collectionAddrs) == 0 {
+			continue
+		}
+
+		// 2. 查询集合信息 (地板价) 及集合最高出价
+		cs, err := svcCtx.Dao.QueryCollectionsInfo(ctx, chain, collectionAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get collections info")
+		}
+		for _, c := range cs {
+			if collection, ok := collectionsMap[strings.ToLower(c.Address)]; ok {
+				collection.CollectionName = c.Name
+				collection.ImageURI = c.ImageUri
+				collection.FloorPrice = c.FloorPrice
+			}
+		}
+		bestBids, err := svcCtx.Dao.QueryCollectionsBestBid(ctx, chain, "", collectionAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get collections best bid")
+		}
+		for _, bid := range bestBids {
+			if collection, ok := collectionsMap[strings.ToLower(bid.CollectionAddress)]; ok {
+				collection.BestBidPrice = bid.Price
+			}
+		}
+
+		// 3. 持仓估值
+		for _, item := range holdings {
+			collection := collectionsMap[strings.ToLower(item.CollectionAddress)]
+			itemValuation := types.ItemValuation{
+				ChainID:           chainIDs[i],
+				CollectionAddress: collection.CollectionAddress,
+				TokenID:           item.TokenId,
+				FloorValue:        collection.FloorPrice,
+				BidValue:          collection.BestBidPrice,
+			}
+			if cost, ok := costs[fmt.Sprintf("%s:%s", collection.CollectionAddress, item.TokenId)]; ok {
+				itemValuation.HasCostBasis = true
+				itemValuation.CostBasis = cost.price
+				itemValuation.AcquiredTime = cost.time
+				itemValuation.UnrealizedPnl = unrealizedPnl(collection.FloorPrice, cost, fees)
+
+				collection.CostedItems++
+				collection.CostBasis = collection.CostBasis.Add(cost.price)
+				collection.UnrealizedPnl = collection.UnrealizedPnl.Add(itemValuation.UnrealizedPnl)
+			}
+			collection.FloorValue = collection.FloorValue.Add(itemValuation.FloorValue)
+			collection.BidValue = collection.BidValue.Add(itemValuation.BidValue)
+			result.Items = append(result.Items, itemValuation)
+		}
+
+		for _, collectionAddr := range collectionAddrs {
+			collection := collectionsMap[collectionAddr]
+			result.FloorValue = result.FloorValue.Add(collection.FloorValue)
+			result.BidValue = result.BidValue.Add(collection.BidValue)
+			result.CostBasis = result.CostBasis.Add(collection.CostBasis)
+			result.UnrealizedPnl = result.UnrealizedPnl.Add(collection.UnrealizedPnl)
+			result.RealizedPnl = result.RealizedPnl.Add(collection.RealizedPnl)
+			result.SellProceeds = result.SellProceeds.Add(collection.SellProceeds)
+			result.ProtocolFees = result.ProtocolFees.Add(collection.ProtocolFees)
+			result.Collections = append(result.Collections, *collection)
+		}
+	}
+
+	// 按地板价估值降序
+	sort.SliceStable(result.Collections, func(i, j int) bool {
+		return result.Collections[i].FloorValue.GreaterThan(result.Collections[j].FloorValue)
+	})
+	sort.SliceStable(result.Items, func(i, j int) bool {
+		return result.Items[i].FloorValue.GreaterThan(result.Items[j].FloorValue)
+	})
+
+	return &types.UserValuationResp{Result: result}, nil
+}
+
+// GetMultiChainUserValueHistory 查询用户持仓价值历史
+// 功能:
+// 1. 以当前持仓为起点, 按时间倒序回滚成交记录, 得到每个采样时间点各集合的持有数量
+// 2. 一次查询区间内曾持有集合的按采样点分组的地板价快照, 每个采样时间点取该时间之前最近一次的快照,
+// 持有数量 * 地板价 即为持仓价值
+func GetMultiChainUserValueHistory(ctx context.Context, svcCtx *svc.ServerCtx, chainNames []string, userAddrs []string, period string) (*types.UserValueHistoryResp, error) {
+	if period == "" {
+		period = "7d"
+	}
+	p, ok := valueHistoryPeriods[period]
+	if !ok {
+		return nil, errcode.ErrInvalidParams
+	}
+	duration, step := p[0], p[1]
+
+	now := time.Now().Unix()
+	points := make([]types.PortfolioValuePoint, duration/step+1)
+	for k := range points {
+		points[k].Time = now - duration + int64(k)*step
+		points[k].Value = decimal.Zero
+	}
+
+	for _, chain := range chainNames {
+		holdings, err := svcCtx.Dao.QueryUserHoldings(ctx, chain, userAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get user holdings")
+		}
+		fills, err := svcCtx.Dao.QueryUserFills(ctx, chain, userAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get user fills")
+		}
+
+		counts := make(map[string]int64)
+		for _, item := range holdings {
+			counts[strings.ToLower(item.CollectionAddress)]++
+		}
+		collectionAddrs := heldCollections(counts, fills, points[0].Time)
+		if len(collectionAddrs) == 0 {
+			continue
+		}
+
+		floorPrices, err := svcCtx.Dao.QueryCollectionsFloorPriceHistory(ctx, chain, collectionAddrs, points[0].Time, now, step)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get collections floor price")
+		}
+		addValueHistory(points, counts, fills, floorPrices)
+	}
+
+	return &types.UserValueHistoryResp{Result: points}, nil
+}
+
+// heldCollections 返回 since 之后曾持有的集合: 当前持有的集合及 since 之后有成交的集合
+func heldCollections(counts map[string]int64, fills []multi.Fill, since int64) []string {
+	held := make(map[string]bool)
+	var collectionAddrs []string
+	add := func(collectionAddr string) {
+		if !held[collectionAddr] {
+			held[collectionAddr] = true
+			collectionAddrs = append(collectionAddrs, collectionAddr)
+		}
+	}
+	for collectionAddr, count := range counts {
+		if count > 0 {
+			add(collectionAddr)
+		}
+	}
+	for _, fill := range fills {
+		if fill.EventTime > since {
+			add(strings.ToLower(fill.CollectionAddress))
+		}
+	}
+	sort.Strings(collectionAddrs)
+	return collectionAddrs
+}
+
+// addValueHistory 将一条链的持仓价值累加到各采样点
+// counts 为当前各集合的持有数量 (会被修改), fills 按时间升序, floorPrices 按集合地址及时间升序
+// 从最新的采样点开始, 回滚该时间点之后的成交: 买入的减去, 卖出的加回; 地板价取采样点之前最近一次快照
+func addValueHistory(points []types.PortfolioValuePoint, counts map[string]int64, fills []multi.Fill, floorPrices []multi.CollectionFloorPrice) {
+	snapshots := make(map[string][]multi.CollectionFloorPrice)
+	for _, floor := range floorPrices {
+		collectionAddr := strings.ToLower(floor.CollectionAddress)
+		snapshots[collectionAddr] = append(snapshots[collectionAddr], floor)
+	}
+	// 每个集合当前采样点可用的最后一条快照下标, 采样点倒序遍历时只需向前移动
+	cursors := make(map[string]int)
+	for collectionAddr, history := range snapshots {
+		cursors[collectionAddr] = len(history) - 1
+	}
+
+	next := len(fills) - 1
+	for k := len(points) - 1; k >= 0; k-- {
+		for ; next >= 0 && fills[next].EventTime > points[k].Time; next-- {
+			collectionAddr := strings.ToLower(fills[next].CollectionAddress)
+			if fills[next].OrderType == multi.ListingOrder {
+				counts[collectionAddr]++
+			} else if counts[collectionAddr] > 0 {
+				counts[collectionAddr]--
+			}
+		}
+
+		for collectionAddr, count := range counts {
+			if count <= 0 {
+				continue
+			}
+			points[k].ItemCount += count
+
+			history, i := snapshots[collectionAddr], cursors[collectionAddr]
+			for i >= 0 && history[i].EventTime > points[k].Time {
+				i--
+			}
+			cursors[collectionAddr] = i
+			if i >= 0 {
+				points[k].Value = points[k].Value.Add(history[i].Price.Mul(decimal.NewFromInt(count)))
+			}
+		}
+	}
+}
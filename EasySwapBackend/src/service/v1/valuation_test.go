@@ -0,0 +1,122 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+var testFees = feeSchedule{
+	shares: []multi.ProtocolShare{
+		{Share: 200, BlockNumber: 10, LogIndex: 0},
+		{Share: 100, BlockNumber: 50, LogIndex: 3},
+	},
+	fallback: 300,
+}
+
+func eth(v int64) decimal.Decimal {
+	return decimal.NewFromInt(v).Shift(18)
+}
+
+func TestFeeScheduleShareAt(t *testing.T) {
+	assert.Equal(t, int64(200), testFees.shareAt(5, 0)) // 早于第一条记录
+	assert.Equal(t, int64(200), testFees.shareAt(10, 1))
+	assert.Equal(t, int64(200), testFees.shareAt(50, 2)) // 同一区块内费率变更之前
+	assert.Equal(t, int64(100), testFees.shareAt(50, 4))
+	assert.Equal(t, int64(100), testFees.shareAt(80, 0))
+	assert.Equal(t, int64(100), testFees.current())
+
+	empty := feeSchedule{fallback: 300}
+	assert.Equal(t, int64(300), empty.shareAt(80, 0))
+	assert.Equal(t, int64(300), empty.current())
+}
+
+func TestShareFeeRoundsDown(t *testing.T) {
+	assert.True(t, shareFee(decimal.NewFromInt(999), 100).Equal(decimal.NewFromInt(9)))
+	assert.True(t, shareFee(eth(1), 0).IsZero())
+}
+
+func TestReplayFills(t *testing.T) {
+	fills := []multi.Fill{
+		{OrderType: multi.CollectionBidOrder, CollectionAddress: "0xA", TokenId: "1", Price: eth(1), BlockNumber: 20, EventTime: 100},
+		{OrderType: multi.ItemBidOrder, CollectionAddress: "0xa", TokenId: "2", Price: eth(2), BlockNumber: 30, EventTime: 200},
+		// 费率变更前卖出 Token 1: 手续费 2%
+		{OrderType: multi.ListingOrder, CollectionAddress: "0xa", TokenId: "1", Price: eth(3), BlockNumber: 40, EventTime: 300},
+		// 没有买入记录的卖出只计入卖出所得
+		{OrderType: multi.ListingOrder, CollectionAddress: "0xb", TokenId: "9", Price: eth(1), BlockNumber: 60, EventTime: 400},
+	}
+	collections := make(map[string]*types.CollectionValuation)
+	getCollection := func(addr string) *types.CollectionValuation {
+		if _, ok := collections[addr]; !ok {
+			collections[addr] = &types.CollectionValuation{CollectionAddress: addr}
+		}
+		return collections[addr]
+	}
+
+	costs := replayFills(fills, testFees, getCollection)
+	require.Len(t, costs, 1)
+	assert.True(t, costs["0xa:2"].price.Equal(eth(2)))
+	assert.Equal(t, int64(200), costs["0xa:2"].time)
+
+	a := collections["0xa"]
+	assert.True(t, a.ProtocolFees.Equal(eth(3).Mul(decimal.NewFromFloat(0.02))))
+	assert.True(t, a.SellProceeds.Equal(eth(3).Mul(decimal.NewFromFloat(0.98))))
+	assert.True(t, a.RealizedPnl.Equal(eth(3).Mul(decimal.NewFromFloat(0.98)).Sub(eth(1))))
+
+	// 费率变更后卖出: 手续费 1%, 没有买入成本不计已实现盈亏
+	b := collections["0xb"]
+	assert.True(t, b.ProtocolFees.Equal(eth(1).Mul(decimal.NewFromFloat(0.01))))
+	assert.True(t, b.RealizedPnl.IsZero())
+}
+
+func TestUnrealizedPnlUsesCurrentShare(t *testing.T) {
+	pnl := unrealizedPnl(eth(2), costBasis{price: eth(1)}, testFees)
+	assert.True(t, pnl.Equal(eth(2).Mul(decimal.NewFromFloat(0.99)).Sub(eth(1))))
+}
+
+func TestHeldCollections(t *testing.T) {
+	counts := map[string]int64{"0xb": 1, "0xc": 0}
+	fills := []multi.Fill{
+		{CollectionAddress: "0xD", EventTime: 50},  // 区间开始前的成交
+		{CollectionAddress: "0xA", EventTime: 150}, // 区间内已卖出的集合
+	}
+	assert.Equal(t, []string{"0xa", "0xb"}, heldCollections(counts, fills, 100))
+}
+
+func TestAddValueHistory(t *testing.T) {
+	points := []types.PortfolioValuePoint{
+		{Time: 100, Value: decimal.Zero},
+		{Time: 200, Value: decimal.Zero},
+		{Time: 300, Value: decimal.Zero},
+	}
+	// 当前持有 0xa 两个, 0xb 一个
+	counts := map[string]int64{"0xa": 2, "0xb": 1}
+	fills := []multi.Fill{
+		{OrderType: multi.CollectionBidOrder, CollectionAddress: "0xa", EventTime: 150}, // 150 买入 0xa
+		{OrderType: multi.ListingOrder, CollectionAddress: "0xc", EventTime: 250},       // 250 卖出 0xc
+		{OrderType: multi.CollectionBidOrder, CollectionAddress: "0xb", EventTime: 280}, // 280 买入 0xb
+	}
+	floorPrices := []multi.CollectionFloorPrice{
+		{CollectionAddress: "0xa", Price: decimal.NewFromInt(10), EventTime: 90},
+		{CollectionAddress: "0xa", Price: decimal.NewFromInt(12), EventTime: 260},
+		{CollectionAddress: "0xb", Price: decimal.NewFromInt(5), EventTime: 300},
+		{CollectionAddress: "0xc", Price: decimal.NewFromInt(7), EventTime: 180},
+	}
+
+	addValueHistory(points, counts, fills, floorPrices)
+
+	// 300: 0xa*2 (12) + 0xb*1 (5)
+	assert.Equal(t, int64(3), points[2].ItemCount)
+	assert.True(t, points[2].Value.Equal(decimal.NewFromInt(29)), points[2].Value.String())
+	// 200: 0xa*2 (10) + 0xc*1 (7)
+	assert.Equal(t, int64(3), points[1].ItemCount)
+	assert.True(t, points[1].Value.Equal(decimal.NewFromInt(27)), points[1].Value.String())
+	// 100: 0xa*1 (10) + 0xc*1 (该时间点之前没有快照, 不计价值)
+	assert.Equal(t, int64(2), points[0].ItemCount)
+	assert.True(t, points[0].Value.Equal(decimal.NewFromInt(10)), points[0].Value.String())
+}
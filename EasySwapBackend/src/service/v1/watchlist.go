@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ProjectsTask/EasySwapBase/errcode"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapBackend/src/service/svc"
+	"github.com/ProjectsTask/EasySwapBackend/src/types/v1"
+)
+
+const (
+	maxFavoriteItems    = 500 // 每个用户最多收藏的 Item 数
+	maxWatchCollections = 100 // 每个用户最多关注的集合数
+)
+
+// AddFavoriteItem 收藏 Item, 重复收藏不报错
+func AddFavoriteItem(ctx context.Context, svcCtx *svc.ServerCtx, chain string, userAddr string, req *types.FavoriteItemReq) error {
+	if req.CollectionAddress == "" || req.TokenID == "" {
+		return errcode.ErrInvalidParams
+	}
+	userAddr = strings.ToLower(userAddr)
+	collectionAddr := strings.ToLower(req.CollectionAddress)
+
+	item, err := svcCtx.Dao.QueryItemInfo(ctx, chain, collectionAddr, req.TokenID)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query item info", zap.Error(err), zap.String("collection", collectionAddr))
+		return errcode.ErrUnexpected
+	}
+	if item.TokenId == "" || item.Status == multi.ItemStatusBanned {
+		return errcode.NewCustomErr("item not found")
+	}
+
+	count, err := svcCtx.Dao.CountUserFavoriteItems(ctx, userAddr)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on count favorite items", zap.Error(err), zap.String("user", userAddr))
+		return errcode.ErrUnexpected
+	}
+	if count >= maxFavoriteItems {
+		return errcode.NewCustomErr("too many favorite items")
+	}
+
+	if err := svcCtx.Dao.CreateFavoriteItem(ctx, &base.UserFavoriteItem{
+		UserAddress:       userAddr,
+		ChainId:           int64(req.ChainID),
+		CollectionAddress: collectionAddr,
+		TokenId:           req.TokenID,
+	}); err != nil {
+		xzap.WithContext(ctx).Error("failed on add favorite item", zap.Error(err), zap.String("user", userAddr))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// RemoveFavoriteItem 取消收藏 Item
+func RemoveFavoriteItem(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, chainID int, collectionAddr, tokenID string) error {
+	err := svcCtx.Dao.DeleteFavoriteItem(ctx, strings.ToLower(userAddr), chainID, strings.ToLower(collectionAddr), tokenID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.NewCustomErr("favorite item not found")
+		}
+		xzap.WithContext(ctx).Error("failed on remove favorite item", zap.Error(err), zap.String("user", userAddr))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// GetFavoriteItems 分页查询用户收藏的 Item, 填充 Item 名称、图片、持有人及集合地板价
+func GetFavoriteItems(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, filter types.FavoriteFilterParams) (*types.FavoriteItemsResp, error) {
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 50 {
+		filter.PageSize = 20
+	}
+
+	favorites, count, err := svcCtx.Dao.QueryUserFavoriteItems(ctx, strings.ToLower(userAddr), filter.ChainID, filter.Page, filter.PageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get favorite items")
+	}
+
+	chainIDToChainName := make(map[int]string)
+	for _, chain := range svcCtx.C.ChainSupported {
+		chainIDToChainName[chain.ChainID] = chain.Name
+	}
+
+	// 按链及集合分组, 批量查询 Item 及集合信息
+	result := make([]types.FavoriteItem, 0, len(favorites))
+	itemIndex := make(map[string]int)
+	chainItems := make(map[int][]types.ItemInfo)
+	for _, favorite := range favorites {
+		chainID := int(favorite.ChainId)
+		itemIndex[favoriteItemKey(chainID, favorite.CollectionAddress, favorite.TokenId)] = len(result)
+		result = append(result, types.FavoriteItem{
+			ChainID:           chainID,
+			CollectionAddress: favorite.CollectionAddress,
+			TokenID:           favorite.TokenId,
+			FavoriteTime:      favorite.CreateTime,
+		})
+		chainItems[chainID] = append(chainItems[chainID], types.ItemInfo{
+			CollectionAddress: favorite.CollectionAddress,
+			TokenID:           favorite.TokenId,
+		})
+	}
+
+	for chainID, itemInfos := range chainItems {
+		chain, ok := chainIDToChainName[chainID]
+		if !ok {
+			continue
+		}
+
+		items, err := svcCtx.Dao.QueryItemsInfo(ctx, chain, itemInfos)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get favorite items info")
+		}
+		for _, item := range items {
+			if i, ok := itemIndex[favoriteItemKey(chainID, item.CollectionAddress, item.TokenId)]; ok {
+				result[i].Name = item.Name
+				result[i].OwnerAddress = item.Owner
+			}
+		}
+
+		collectionTokens := make(map[string][]string)
+		for _, info := range itemInfos {
+			collectionTokens[info.CollectionAddress] = append(collectionTokens[info.CollectionAddress], info.TokenID)
+		}
+		var collectionAddrs []string
+		for collectionAddr, tokenIDs := range collectionTokens {
+			collectionAddrs = append(collectionAddrs, collectionAddr)
+			externals, err := svcCtx.Dao.QueryCollectionItemsImage(ctx, chain, collectionAddr, tokenIDs)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed on get favorite items image")
+			}
+			for _, external := range externals {
+				if i, ok := itemIndex[favoriteItemKey(chainID, external.CollectionAddress, external.TokenId)]; ok {
+					result[i].ImageURI = external.ImageUri
+					if external.IsUploadedOss {
+						result[i].ImageURI = external.OssUri
+					}
+				}
+			}
+		}
+
+		collections, err := svcCtx.Dao.QueryCollectionsInfo(ctx, chain, collectionAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get favorite collections info")
+		}
+		collectionsMap := make(map[string]multi.Collection)
+		for _, collection := range collections {
+			collectionsMap[strings.ToLower(collection.Address)] = collection
+		}
+		for i := range result {
+			if result[i].ChainID != chainID {
+				continue
+			}
+			if collection, ok := collectionsMap[result[i].CollectionAddress]; ok {
+				result[i].CollectionName = collection.Name
+				result[i].CollectionImageURI = collection.ImageUri
+				result[i].FloorPrice = collection.FloorPrice
+				if result[i].Name == "" {
+					result[i].Name = fmt.Sprintf("%s #%s", collection.Name, result[i].TokenID)
+				}
+			}
+		}
+	}
+
+	return &types.FavoriteItemsResp{Result: result, Count: count}, nil
+}
+
+func favoriteItemKey(chainID int, collectionAddr, tokenID string) string {
+	return fmt.Sprintf("%d:%s:%s", chainID, strings.ToLower(collectionAddr), tokenID)
+}
+
+// WatchCollection 关注集合, 重复关注不报错
+func WatchCollection(ctx context.Context, svcCtx *svc.ServerCtx, chain string, userAddr string, req *types.WatchCollectionReq) error {
+	if req.CollectionAddress == "" {
+		return errcode.ErrInvalidParams
+	}
+	userAddr = strings.ToLower(userAddr)
+	collectionAddr := strings.ToLower(req.CollectionAddress)
+
+	collection, err := svcCtx.Dao.QueryCollectionInfo(ctx, chain, collectionAddr)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.NewCustomErr("collection not found")
+		}
+		xzap.WithContext(ctx).Error("failed on query collection", zap.Error(err), zap.String("collection", collectionAddr))
+		return errcode.ErrUnexpected
+	}
+	if collection.Status == multi.CollectionStatusBanned {
+		return errcode.NewCustomErr("collection not found")
+	}
+
+	watches, err := svcCtx.Dao.QueryUserWatchCollections(ctx, userAddr)
+	if err != nil {
+		xzap.WithContext(ctx).Error("failed on query watch collections", zap.Error(err), zap.String("user", userAddr))
+		return errcode.ErrUnexpected
+	}
+	if len(watches) >= maxWatchCollections {
+		return errcode.NewCustomErr("too many watched collections")
+	}
+
+	if err := svcCtx.Dao.CreateWatchCollection(ctx, &base.UserWatchCollection{
+		UserAddress:       userAddr,
+		ChainId:           int64(req.ChainID),
+		CollectionAddress: collectionAddr,
+	}); err != nil {
+		xzap.WithContext(ctx).Error("failed on watch collection", zap.Error(err), zap.String("user", userAddr))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// UnwatchCollection 取消关注集合
+func UnwatchCollection(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string, chainID int, collectionAddr string) error {
+	err := svcCtx.Dao.DeleteWatchCollection(ctx, strings.ToLower(userAddr), chainID, strings.ToLower(collectionAddr))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errcode.NewCustomErr("watched collection not found")
+		}
+		xzap.WithContext(ctx).Error("failed on unwatch collection", zap.Error(err), zap.String("user", userAddr))
+		return errcode.ErrUnexpected
+	}
+	return nil
+}
+
+// GetWatchlist 查询用户关注的集合及实时行情
+// 地板价取集合当前地板价, 24h 交易量、成交数及地板价涨跌幅与排行榜的 1d 统计口径一致
+func GetWatchlist(ctx context.Context, svcCtx *svc.ServerCtx, userAddr string) (*types.WatchlistResp, error) {
+	watches, err := svcCtx.Dao.QueryUserWatchCollections(ctx, strings.ToLower(userAddr))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get watch collections")
+	}
+
+	chainIDToChainName := make(map[int]string)
+	for _, chain := range svcCtx.C.ChainSupported {
+		chainIDToChainName[chain.ChainID] = chain.Name
+	}
+	chainCollections := make(map[int][]string)
+	for _, watch := range watches {
+		chainCollections[int(watch.ChainId)] = append(chainCollections[int(watch.ChainId)], watch.CollectionAddress)
+	}
+
+	result := make([]types.WatchedCollection, 0, len(watches))
+	collectionIndex := make(map[string]int)
+	for _, watch := range watches {
+		collectionIndex[fmt.Sprintf("%d:%s", watch.ChainId, watch.CollectionAddress)] = len(result)
+		result = append(result, types.WatchedCollection{
+			ChainID:     int(watch.ChainId),
+			Address:     watch.CollectionAddress,
+			FloorChange: strconv.FormatFloat(0, 'f', 4, 32),
+			WatchTime:   watch.CreateTime,
+		})
+	}
+
+	for chainID, collectionAddrs := range chainCollections {
+		chain, ok := chainIDToChainName[chainID]
+		if !ok {
+			continue
+		}
+
+		collections, err := svcCtx.Dao.QueryCollectionsInfo(ctx, chain, collectionAddrs)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed on get watch collections info")
+		}
+		for _, collection := range collections {
+			if i, ok := collectionIndex[fmt.Sprintf("%d:%s", chainID, strings.ToLower(collection.Address))]; ok {
+				result[i].Name = collection.Name
+				result[i].ImageUri = collection.ImageUri
+				result[i].FloorPrice = collection.FloorPrice
+			}
+		}
+
+		// 行情统计只聚合关注的集合, 失败时只记录日志, 仍返回关注列表
+		tradeInfos, err := svcCtx.Dao.GetCollectionsTradeInfo(chain, "1d", collectionAddrs)
+		if err != nil {
+			xzap.WithContext(ctx).Error("failed on get collection trade info", zap.Error(err), zap.String("chain", chain))
+		}
+		for _, trade := range tradeInfos {
+			if i, ok := collectionIndex[fmt.Sprintf("%d:%s", chainID, strings.ToLower(trade.ContractAddress))]; ok {
+				result[i].Volume24h = trade.Volume
+				result[i].VolumeChange24h = trade.VolumeChange
+				result[i].Sales24h = trade.ItemCount
+			}
+		}
+
+		floorChange, err := svcCtx.Dao.QueryCollectionsFloorChange(chain, DaySeconds, collectionAddrs)
+		if err != nil {
+			xzap.WithContext(ctx).Error("failed on get collection floor change", zap.Error(err), zap.String("chain", chain))
+		}
+		for _, collectionAddr := range collectionAddrs {
+			if change, ok := floorChange[collectionAddr]; ok {
+				result[collectionIndex[fmt.Sprintf("%d:%s", chainID, collectionAddr)]].FloorChange = strconv.FormatFloat(change, 'f', 4, 32)
+			}
+		}
+
+		listed, err := svcCtx.Dao.QueryCollectionsListed(ctx, chain, collectionAddrs)
+		if err != nil {
+			xzap.WithContext(ctx).Error("failed on query collection listed", zap.Error(err), zap.String("chain", chain))
+		}
+		for _, l := range listed {
+			if i, ok := collectionIndex[fmt.Sprintf("%d:%s", chainID, strings.ToLower(l.CollectionAddr))]; ok {
+				result[i].ListAmount = l.Count
+			}
+		}
+	}
+
+	return &types.WatchlistResp{Result: result}, nil
+}
+
+// RecordItemView 记录登录用户的 Item 浏览量, 同一用户一段时间内只计一次, 失败时只记录日志
+func RecordItemView(ctx context.Context, svcCtx *svc.ServerCtx, chain, collectionAddr, tokenID, userAddr string) {
+	if _, err := svcCtx.Dao.IncrItemViews(ctx, chain, strings.ToLower(collectionAddr), tokenID, strings.ToLower(userAddr)); err != nil {
+		xzap.WithContext(ctx).Warn("failed on record item view", zap.Error(err),
+			zap.String("collection", collectionAddr), zap.String("token_id", tokenID))
+	}
+}
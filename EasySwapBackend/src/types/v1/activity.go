@@ -27,6 +27,7 @@ type ActivityInfo struct {
 	ItemName           string          `json:"item_name"`            // Item 名称
 	Currency           string          `json:"currency"`             // 支付代币 (ETH/WETH)
 	Price              decimal.Decimal `json:"price"`                // 价格
+	PrevPrice          decimal.Decimal `json:"prev_price"`           // 修改前价格 (仅修改订单活动)
 	Maker              string          `json:"maker"`                // 发起方
 	Taker              string          `json:"taker"`                // 接收方 (成交时)
 	TxHash             string          `json:"tx_hash"`              // 交易哈希
@@ -35,6 +36,6 @@ type ActivityInfo struct {
 }
 
 type ActivityResp struct {
-	Result interface{} `json:"result"`
-	Count  int64       `json:"count"`
+	Result []ActivityInfo `json:"result"`
+	Count  int64          `json:"count"`
 }
@@ -0,0 +1,108 @@
+package types
+
+import "github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+
+// AdminOperator 执行管理操作的管理员信息, 记录在审计日志中
+type AdminOperator struct {
+	Address string
+	IP      string
+}
+
+// AdminCollectionAuthReq 设置集合认证状态请求
+type AdminCollectionAuthReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+	Auth    int `json:"auth"`     // 认证状态(0:未认证 1:认证通过 2:认证不通过)
+}
+
+// AdminStatusReq 设置集合/Item 展示状态请求
+type AdminStatusReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+	Status  int `json:"status"`   // 展示状态(0:正常 1:隐藏 2:封禁)
+}
+
+// AdminCollectionSocialsReq 编辑集合社交信息请求, 仅更新传入的字段
+type AdminCollectionSocialsReq struct {
+	ChainID   int     `json:"chain_id"`   // 链 ID
+	Website   *string `json:"website"`    // 项目官网地址
+	Twitter   *string `json:"twitter"`    // 项目 twitter 地址
+	Discord   *string `json:"discord"`    // 项目 discord 地址
+	Instagram *string `json:"instagram"`  // 项目 instagram 地址
+	BannerUri *string `json:"banner_uri"` // banner 图片链接
+}
+
+// AdminCollectionImportReq 触发集合导入请求
+type AdminCollectionImportReq struct {
+	ChainID       int   `json:"chain_id"`       // 链 ID
+	TokenStandard int64 `json:"token_standard"` // 合约标准(1:erc721 2:erc1155)
+}
+
+// AdminChainReq 仅携带链 ID 的管理请求
+type AdminChainReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+}
+
+// AdminUserAllowedReq 设置用户是否允许访问请求
+type AdminUserAllowedReq struct {
+	Allowed bool `json:"allowed"` // true:允许 false:封禁
+}
+
+// AdminAuditLogFilterParams 审计日志查询参数
+type AdminAuditLogFilterParams struct {
+	AdminAddress string `json:"admin_address"` // 管理员地址
+	TargetType   string `json:"target_type"`   // 操作对象类型(collection/item/user)
+	Target       string `json:"target"`        // 操作对象
+	Page         int    `json:"page"`
+	PageSize     int    `json:"page_size"`
+}
+
+// AdminRefreshMetadataResp 全量刷新元数据响应
+type AdminRefreshMetadataResp struct {
+	Result int `json:"result"` // 加入刷新队列的 Item 数量
+}
+
+// AdminAuditLogsResp 审计日志查询响应
+type AdminAuditLogsResp struct {
+	Result []base.AdminAuditLog `json:"result"`
+	Count  int64                `json:"count"`
+}
+
+// AdminApiKeyCreateReq 签发 API key 请求
+type AdminApiKeyCreateReq struct {
+	Name      string `json:"name"`       // 合作方名称
+	Owner     string `json:"owner"`      // 合作方联系地址
+	RateLimit int    `json:"rate_limit"` // 每个限流窗口的请求配额, 0 表示使用默认配额
+}
+
+// AdminApiKeyIssued 签发结果, Key 为明文, 仅返回这一次
+type AdminApiKeyIssued struct {
+	base.ApiKey
+	Key string `json:"key"`
+}
+
+// AdminApiKeyCreateResp 签发 API key 响应
+type AdminApiKeyCreateResp struct {
+	Result *AdminApiKeyIssued `json:"result"`
+}
+
+// AdminApiKeyRevokeResp 撤销 API key 响应
+type AdminApiKeyRevokeResp struct {
+	Result int64 `json:"result"` // 被撤销的 API key ID
+}
+
+// AdminApiKeysResp API key 列表响应
+type AdminApiKeysResp struct {
+	Result []base.ApiKey `json:"result"`
+}
+
+// ApiKeyDailyUsage API key 单日用量
+type ApiKeyDailyUsage struct {
+	Date     string           `json:"date"`     // 日期 (UTC, yyyymmdd)
+	Total    int64            `json:"total"`    // 请求总数
+	Rejected int64            `json:"rejected"` // 被限流拒绝的请求数
+	Routes   map[string]int64 `json:"routes"`   // 各接口放行的请求数, key 为 "METHOD 路由"
+}
+
+// AdminApiKeyUsageResp API key 用量响应, 按日期倒序
+type AdminApiKeyUsageResp struct {
+	Result []ApiKeyDailyUsage `json:"result"`
+}
@@ -1,6 +1,9 @@
 package types
 
-import "github.com/shopspring/decimal"
+import (
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/shopspring/decimal"
+)
 
 // ItemBid 单个 Item 的出价详情
 type ItemBid struct {
@@ -14,6 +17,36 @@ type ItemBid struct {
 	Salt              int64           `json:"salt"`               // 盐值
 	BidSize           int64           `json:"bid_size"`           // 想要购买的数量
 	BidUnfilled       int64           `json:"bid_unfilled"`       // 剩余未成交数量
+	BidFilled         int64           `json:"bid_filled"`         // 已成交数量
 	Bidder            string          `json:"bidder"`             // 出价人地址
 	OrderType         int64           `json:"order_type"`         // 订单类型
+	Trait             string          `json:"trait"`              // 属性出价的属性名称
+	TraitValue        string          `json:"trait_value"`        // 属性出价的属性值
+}
+
+// TraitBidReq 创建属性出价请求
+// 属性出价为 Maker 签名的集合出价 (EIP-712), 适用于集合中拥有指定 (trait, trait_value) 的任意 Item.
+// 订单只保存在链下, 没有托管; 由后端撮合: 出价人选择拥有该属性的 Item, 后端构造以不高于出价的价格购买该 Item 挂单的交易
+type TraitBidReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+	OrderParam
+	Signature  string `json:"signature"`   // EIP-712 签名 (0x 开头, 65 字节)
+	Trait      string `json:"trait"`       // 属性名称
+	TraitValue string `json:"trait_value"` // 属性值
+}
+
+// CancelTraitBidReq 取消属性出价请求
+type CancelTraitBidReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+}
+
+// TraitBidFillTxReq 属性出价成交请求
+type TraitBidFillTxReq struct {
+	ChainID int    `json:"chain_id"` // 链 ID
+	TokenId string `json:"token_id"` // 购买的 Item, 需拥有出价的属性且有不高于出价的挂单
+}
+
+// TraitBidResp 属性出价响应
+type TraitBidResp struct {
+	Result multi.Order `json:"result"`
 }
@@ -6,14 +6,23 @@ import (
 
 // CollectionItemFilterParams 集合 Item 列表查询过滤参数
 type CollectionItemFilterParams struct {
-	Sort        int    `json:"sort"`         // 排序方式: 1-价格升序 2-挂单时间降序 3-成交价降序
-	Status      []int  `json:"status"`       // 状态过滤: 1-一口价(BuyNow) 2-有出价(HasOffer) 3-全选
-	Markets     []int  `json:"markets"`      // 市场过滤: 0-NS 1-OpenSea 2-LooksRare 3-X2Y2
-	TokenID     string `json:"token_id"`     // 按 TokenID 搜索
-	UserAddress string `json:"user_address"` // 当前用户地址(用于查询是否持有)
-	ChainID     int    `json:"chain_id"`     // 链 ID
-	Page        int    `json:"page"`         // 页码
-	PageSize    int    `json:"page_size"`    // 每页数量
+	Sort        int             `json:"sort"`         // 排序方式: 1-价格升序 2-挂单时间降序 3-成交价降序 5-稀有度升序(最稀有在前) 6-稀有度降序
+	Status      []int           `json:"status"`       // 状态过滤: 1-一口价(BuyNow) 2-有出价(HasOffer) 3-全选
+	Markets     []int           `json:"markets"`      // 市场过滤: 0-NS 1-OpenSea 2-LooksRare 3-X2Y2
+	TokenID     string          `json:"token_id"`     // 按 TokenID 搜索
+	UserAddress string          `json:"user_address"` // 当前用户地址(用于查询是否持有)
+	Traits      []TraitFilter   `json:"traits"`       // 属性过滤: 同一属性的多个值取并集, 不同属性之间取交集
+	MinPrice    decimal.Decimal `json:"min_price"`    // 挂单价格下限, 0 表示不限制
+	MaxPrice    decimal.Decimal `json:"max_price"`    // 挂单价格上限, 0 表示不限制
+	ChainID     int             `json:"chain_id"`     // 链 ID
+	Page        int             `json:"page"`         // 页码
+	PageSize    int             `json:"page_size"`    // 每页数量
+}
+
+// TraitFilter 属性过滤条件
+type TraitFilter struct {
+	Trait  string   `json:"trait"`  // 属性名称
+	Values []string `json:"values"` // 属性值 (任意匹配)
 }
 
 // CollectionBidFilterParams 集合 Bids 查询过滤参数
@@ -26,15 +35,27 @@ type CollectionBidFilterParams struct {
 // CollectionBids 集合出价统计信息
 type CollectionBids struct {
 	Price   decimal.Decimal `json:"price"`   // 出价金额
-	Size    int             `json:"size"`    // 出价数量
+	Size    int             `json:"size"`    // 剩余未成交数量
+	Filled  int             `json:"filled"`  // 已成交数量
 	Total   decimal.Decimal `json:"total"`   // 总金额
 	Bidders int             `json:"bidders"` // 出价人数
 }
 
 // CollectionBidsResp 集合 Bids 响应
 type CollectionBidsResp struct {
-	Result interface{} `json:"result"`
-	Count  int64       `json:"count"`
+	Result []CollectionBids `json:"result"`
+	Count  int64            `json:"count"`
+}
+
+// ItemBidsResp 单个 Item 出价响应
+type ItemBidsResp struct {
+	Result []ItemBid `json:"result"`
+	Count  int64     `json:"count"`
+}
+
+// HistorySalesResp 历史成交价格响应
+type HistorySalesResp struct {
+	Result []HistorySalesPriceInfo `json:"result"`
 }
 
 // HistorySalesPriceInfo 历史成交价格信息
@@ -51,8 +72,8 @@ type TopTraitFilterParams struct {
 }
 
 type NFTListingInfoResp struct {
-	Result interface{} `json:"result"`
-	Count  int64       `json:"count"`
+	Result []*NFTListingInfo `json:"result"`
+	Count  int64             `json:"count"`
 }
 
 // NFTListingInfo NFT 列表展示信息 (聚合了 Item、Listing、Bid)
@@ -89,6 +110,10 @@ type NFTListingInfo struct {
 
 	LastSellPrice    decimal.Decimal `json:"last_sell_price"`    // 最近成交价
 	OwnerOwnedAmount int64           `json:"owner_owned_amount"` // 当前用户持有数量
+
+	RarityScore    decimal.Decimal `json:"rarity_score"`     // 统计稀有度分数
+	RarityRank     int64           `json:"rarity_rank"`      // 统计稀有度排名
+	TraitCountRank int64           `json:"trait_count_rank"` // 属性个数稀有度排名
 }
 
 type ItemTrait struct {
@@ -113,7 +138,7 @@ type CollectionRankingInfo struct {
 }
 
 type CollectionRankingResp struct {
-	Result interface{} `json:"result"`
+	Result []*CollectionRankingInfo `json:"result"`
 }
 
 // CollectionDetail 集合详情
@@ -134,11 +159,12 @@ type CollectionDetail struct {
 }
 
 type CollectionDetailResp struct {
-	Result interface{} `json:"result"`
+	Result CollectionDetail `json:"result"`
 }
 
+// CommonResp 通用响应, result 为操作对象标识或提示信息
 type CommonResp struct {
-	Result interface{} `json:"result"`
+	Result string `json:"result"`
 }
 
 type RefreshItem struct {
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+// 导出文件格式
+const (
+	ExportFormatCSV    = "csv"
+	ExportFormatNDJSON = "ndjson"
+)
+
+// ActivityExportFilterParams 活动导出过滤参数, 同步导出通过 filters 查询参数传递, 异步任务作为请求体
+type ActivityExportFilterParams struct {
+	ChainID             []int    `json:"chain_id"`             // 链 ID 列表, 为空时导出全部链
+	CollectionAddresses []string `json:"collection_addresses"` // 集合地址列表
+	TokenID             string   `json:"token_id"`             // Token ID
+	UserAddresses       []string `json:"user_addresses"`       // 用户地址列表 (作为 Maker 或 Taker)
+	EventTypes          []string `json:"event_types"`          // 事件类型, 同活动列表接口
+	StartTime           int64    `json:"start_time"`           // 起始时间 (秒, 含)
+	EndTime             int64    `json:"end_time"`             // 结束时间 (秒, 含), 0 表示不限
+	Format              string   `json:"format"`               // 文件格式: csv, ndjson, 默认 csv
+}
+
+// ActivityExportRow 导出的单条活动记录, 价格单位为 ETH
+// 手续费及净收入仅订单簿成交 (sale) 有值
+type ActivityExportRow struct {
+	ChainID           int    `json:"chain_id"`
+	Chain             string `json:"chain"`
+	EventType         string `json:"event_type"`
+	CollectionAddress string `json:"collection_address"`
+	TokenID           string `json:"token_id"`
+	Maker             string `json:"maker"`
+	Taker             string `json:"taker"`
+	MarketplaceID     int    `json:"marketplace_id"`
+	Currency          string `json:"currency"`
+	PriceEth          string `json:"price_eth"`
+	FeeEth            string `json:"fee_eth"`
+	NetProceedsEth    string `json:"net_proceeds_eth"`
+	TxHash            string `json:"tx_hash"`
+	BlockNumber       int64  `json:"block_number"`
+	BlockTime         int64  `json:"block_time"`     // 区块时间 (秒)
+	BlockTimeUTC      string `json:"block_time_utc"` // 区块时间 (RFC3339)
+}
+
+// ExportJobFilterParams 导出任务列表查询参数
+type ExportJobFilterParams struct {
+	Page     int `json:"page"`
+	PageSize int `json:"page_size"`
+}
+
+// ExportJobInfo 导出任务, 完成后 DownloadURL 在过期前可直接下载
+type ExportJobInfo struct {
+	base.ExportJob
+	DownloadURL string `json:"download_url"`
+}
+
+type ExportJobResp struct {
+	Result *ExportJobInfo `json:"result"`
+}
+
+type ExportJobsResp struct {
+	Result []ExportJobInfo `json:"result"`
+	Count  int64           `json:"count"`
+}
@@ -24,6 +24,11 @@ type ItemOwner struct {
 	Owner             string `json:"owner"`
 }
 
+// ItemOwnerResp Item 所有权响应
+type ItemOwnerResp struct {
+	Result *ItemOwner `json:"result"`
+}
+
 // ItemImage Item 图片资源信息
 type ItemImage struct {
 	CollectionAddress string `json:"collection_address"`
@@ -31,6 +36,11 @@ type ItemImage struct {
 	ImageUri          string `json:"image_uri"`
 }
 
+// ItemImageResp Item 图片响应
+type ItemImageResp struct {
+	Result *ItemImage `json:"result"`
+}
+
 // ItemDetailInfo Item 完整详情 (聚合视图)
 type ItemDetailInfo struct {
 	ChainID            int             `json:"chain_id"`
@@ -46,6 +56,8 @@ type ItemDetailInfo struct {
 	FloorPrice         decimal.Decimal `json:"floor_price"`          // 当前集合地板价
 	OwnerAddress       string          `json:"owner_address"`        // 持有人
 	MarketplaceID      int             `json:"marketplace_id"`       // 挂单所在市场
+	Views              int64           `json:"views"`                // 浏览量 (登录用户)
+	FavoriteCount      int64           `json:"favorite_count"`       // 收藏数
 
 	// 挂单详情
 	ListOrderID    string          `json:"list_order_id"`
@@ -54,6 +66,8 @@ type ItemDetailInfo struct {
 	ListExpireTime int64           `json:"list_expire_time"`
 	ListSalt       int64           `json:"list_salt"`
 	ListMaker      string          `json:"list_maker"`
+	// 挂单价格历史: editOrders 修改挂单时生成新订单, 沿修改链回溯, 当前挂单在前
+	ListPriceHistory []OrderPrice `json:"list_price_history"`
 
 	// 最佳出价详情
 	BidOrderID    string          `json:"bid_order_id"`
@@ -65,10 +79,21 @@ type ItemDetailInfo struct {
 	BidType       int64           `json:"bid_type"`
 	BidSize       int64           `json:"bid_size"`
 	BidUnfilled   int64           `json:"bid_unfilled"`
+
+	// 属性地板价及最高属性出价
+	TraitFloorPrices []TraitPrice `json:"trait_floor_prices"`
+	TraitOffer       *ItemBid     `json:"trait_offer"` // 适用于该 Item 的最高属性出价 (链下签名, 无托管, 由后端撮合, 不计入出价), 无则为 null
+}
+
+// OrderPrice 修改链中单个订单的价格
+type OrderPrice struct {
+	OrderID   string          `json:"order_id"`
+	Price     decimal.Decimal `json:"price"`
+	EventTime int64           `json:"event_time"`
 }
 
 type ItemDetailInfoResp struct {
-	Result interface{} `json:"result"`
+	Result ItemDetailInfo `json:"result"`
 }
 
 type ListingInfo struct {
@@ -86,5 +111,5 @@ type TraitPrice struct {
 }
 
 type ItemTopTraitResp struct {
-	Result interface{} `json:"result"`
+	Result []TraitPrice `json:"result"`
 }
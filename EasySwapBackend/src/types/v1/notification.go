@@ -0,0 +1,88 @@
+package types
+
+import (
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/shopspring/decimal"
+)
+
+// NotificationFilterParams 站内通知查询参数
+type NotificationFilterParams struct {
+	UnreadOnly bool `json:"unread_only"` // 只查询未读通知
+	Page       int  `json:"page"`
+	PageSize   int  `json:"page_size"`
+}
+
+// NotificationsResp 站内通知查询响应
+type NotificationsResp struct {
+	Result []base.Notification `json:"result"`
+	Count  int64               `json:"count"`
+	Unread int64               `json:"unread"` // 未读通知数
+}
+
+// NotificationReadReq 标记通知已读请求, All 为 true 时标记全部通知
+type NotificationReadReq struct {
+	Ids []int64 `json:"ids"`
+	All bool    `json:"all"`
+}
+
+// NotificationReadResp 标记通知已读响应
+type NotificationReadResp struct {
+	Result int64 `json:"result"` // 标记为已读的通知数
+}
+
+// NotificationPreferenceReq 更新通知偏好请求
+type NotificationPreferenceReq struct {
+	MutedKinds          []string `json:"muted_kinds"`           // 不接收的通知类型
+	WebhookEnabled      bool     `json:"webhook_enabled"`       // 是否投递 webhook
+	WebhookURL          string   `json:"webhook_url"`           // webhook 地址, 需为 https 公网地址, 开启 webhook 时必填
+	RotateWebhookSecret bool     `json:"rotate_webhook_secret"` // 重新生成 webhook 签名密钥
+	EmailEnabled        bool     `json:"email_enabled"`         // 是否投递邮件
+	Email               string   `json:"email"`                 // 邮箱地址, 开启邮件时必填
+}
+
+// NotificationPreference 用户通知偏好
+type NotificationPreference struct {
+	Kinds          []string `json:"kinds"`                    // 所有通知类型
+	MutedKinds     []string `json:"muted_kinds"`              // 不接收的通知类型
+	WebhookEnabled bool     `json:"webhook_enabled"`          // 是否投递 webhook
+	WebhookURL     string   `json:"webhook_url"`              // webhook 地址
+	WebhookSecret  string   `json:"webhook_secret,omitempty"` // webhook 签名密钥, 仅在生成时返回
+	EmailEnabled   bool     `json:"email_enabled"`            // 是否投递邮件
+	Email          string   `json:"email"`                    // 邮箱地址
+}
+
+// NotificationPreferenceResp 通知偏好响应
+type NotificationPreferenceResp struct {
+	Result *NotificationPreference `json:"result"`
+}
+
+// FloorAlertReq 创建/更新地板价提醒请求, 同一集合重复提交时更新阈值
+type FloorAlertReq struct {
+	ChainID           int             `json:"chain_id"`
+	CollectionAddress string          `json:"collection_address"`
+	Threshold         decimal.Decimal `json:"threshold"` // 地板价低于该值时通知
+}
+
+// FloorAlertResp 地板价提醒响应
+type FloorAlertResp struct {
+	Result *base.NotificationFloorAlert `json:"result"`
+}
+
+// FloorAlertsResp 地板价提醒列表响应
+type FloorAlertsResp struct {
+	Result []base.NotificationFloorAlert `json:"result"`
+}
+
+// NotificationDeliveryFilterParams 通知投递记录查询参数
+type NotificationDeliveryFilterParams struct {
+	Channel  string `json:"channel"` // 投递渠道(webhook/email)
+	Status   int    `json:"status"`  // 投递状态(1:待投递 2:成功 3:失败), 0 表示全部
+	Page     int    `json:"page"`
+	PageSize int    `json:"page_size"`
+}
+
+// NotificationDeliveriesResp 通知投递记录响应
+type NotificationDeliveriesResp struct {
+	Result []base.NotificationDelivery `json:"result"`
+	Count  int64                       `json:"count"`
+}
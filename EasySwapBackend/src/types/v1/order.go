@@ -1,5 +1,9 @@
 package types
 
+import (
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+)
+
 // OrderInfosParam 订单信息聚合查询参数
 type OrderInfosParam struct {
 	ChainID           int      `json:"chain_id"`           // 链 ID
@@ -7,3 +11,94 @@ type OrderInfosParam struct {
 	CollectionAddress string   `json:"collection_address"` // 集合地址
 	TokenIds          []string `json:"token_ids"`          // Token ID 列表
 }
+
+// OrderInfosResp 订单信息聚合查询响应
+type OrderInfosResp struct {
+	Result []ItemBid `json:"result"`
+}
+
+// SignedOrderAsset 订单 NFT 资产, 对应合约 LibOrder.Asset
+type SignedOrderAsset struct {
+	TokenId    string `json:"token_id"`   // Token ID (十进制字符串)
+	Collection string `json:"collection"` // 集合地址
+	Amount     int64  `json:"amount"`     // 数量
+}
+
+// OrderParam 订单参数, 字段与合约 LibOrder.Order 一一对应
+type OrderParam struct {
+	Side     uint8            `json:"side"`      // 0: List 1: Bid
+	SaleKind uint8            `json:"sale_kind"` // 0: FixedPriceForCollection 1: FixedPriceForItem
+	Maker    string           `json:"maker"`     // 挂单人地址
+	Nft      SignedOrderAsset `json:"nft"`       // NFT 资产
+	Price    string           `json:"price"`     // 单价 (wei)
+	Expiry   uint64           `json:"expiry"`    // 过期时间 (秒), 0 表示永不过期
+	Salt     uint64           `json:"salt"`      // 盐值, 不能为 0
+}
+
+// SignedOrderReq 链下 EIP-712 签名订单请求
+type SignedOrderReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+	OrderParam
+	Signature string `json:"signature"` // EIP-712 签名 (0x 开头, 65 字节)
+}
+
+// CancelSignedOrderReq 取消链下签名订单请求
+type CancelSignedOrderReq struct {
+	ChainID int `json:"chain_id"` // 链 ID
+}
+
+// SignedOrderResp 链下签名订单响应
+type SignedOrderResp struct {
+	Result multi.Order `json:"result"`
+}
+
+// MakeOrdersTxReq 构造 makeOrders 交易请求, 所有订单 Maker 需相同 (即交易发送者)
+type MakeOrdersTxReq struct {
+	ChainID int          `json:"chain_id"` // 链 ID
+	Orders  []OrderParam `json:"orders"`   // 新订单列表
+}
+
+// CancelOrdersTxReq 构造 cancelOrders 交易请求
+type CancelOrdersTxReq struct {
+	ChainID  int      `json:"chain_id"`  // 链 ID
+	OrderIDs []string `json:"order_ids"` // 待取消的订单 ID 列表
+}
+
+// EditOrderParam 修改订单参数, 合约仅允许修改价格 (可重新生成 expiry 与 salt)
+type EditOrderParam struct {
+	OrderID string `json:"order_id"` // 原订单 ID
+	Price   string `json:"price"`    // 新单价 (wei)
+	Expiry  uint64 `json:"expiry"`   // 新过期时间 (秒), 0 表示永不过期
+	Salt    uint64 `json:"salt"`     // 新盐值, 不能为 0
+}
+
+// EditOrdersTxReq 构造 editOrders 交易请求
+type EditOrdersTxReq struct {
+	ChainID int              `json:"chain_id"` // 链 ID
+	Edits   []EditOrderParam `json:"edits"`    // 修改列表
+}
+
+// MatchOrderParam 吃单参数
+type MatchOrderParam struct {
+	OrderID string `json:"order_id"` // 挂单 ID
+	Price   string `json:"price"`    // 前端展示的挂单价格 (wei), 与当前订单价格不一致时拒绝
+	TokenId string `json:"token_id"` // 接受集合出价时卖出的 Token ID
+}
+
+// MatchOrdersTxReq 构造 matchOrder/matchOrders 交易请求, 单个订单使用 matchOrder
+type MatchOrdersTxReq struct {
+	ChainID int               `json:"chain_id"` // 链 ID
+	Taker   string            `json:"taker"`    // 吃单人地址 (交易发送者)
+	Orders  []MatchOrderParam `json:"orders"`   // 吃单列表
+}
+
+// UnsignedTxResp 待签名交易
+type UnsignedTxResp struct {
+	ChainID  int      `json:"chain_id"`  // 链 ID
+	From     string   `json:"from"`      // 交易发送者
+	To       string   `json:"to"`        // 订单簿合约地址
+	Data     string   `json:"data"`      // 调用数据 (0x 开头)
+	Value    string   `json:"value"`     // 需附带的 ETH (wei)
+	Gas      uint64   `json:"gas"`       // 预估 gas
+	OrderIDs []string `json:"order_ids"` // 交易涉及的订单 ID (新建/修改订单为新 OrderKey)
+}
@@ -47,7 +47,7 @@ type UserCollectionsData struct {
 }
 
 type UserCollectionsResp struct {
-	Result interface{} `json:"result"`
+	Result UserCollectionsData `json:"result"`
 }
 
 // PortfolioMultiChainItemFilterParams 多链 Item 列表查询参数
@@ -118,8 +118,8 @@ type PortfolioItemInfo struct {
 }
 
 type UserItemsResp struct {
-	Result interface{} `json:"result"`
-	Count  int64       `json:"count"`
+	Result []PortfolioItemInfo `json:"result"`
+	Count  int64               `json:"count"`
 }
 
 type UserListingsResp struct {
@@ -166,6 +166,7 @@ type BidInfo struct {
 	BidSalt       int64           `json:"bid_salt"`
 	BidSize       int64           `json:"bid_size"`
 	BidUnfilled   int64           `json:"bid_unfilled"`
+	BidFilled     int64           `json:"bid_filled"`
 }
 
 type UserBidsResp struct {
@@ -184,10 +185,132 @@ type UserBid struct {
 	BidType           int64           `json:"bid_type"`
 	CollectionName    string          `json:"collection_name"`
 	ImageURI          string          `json:"image_uri"`
-	OrderSize         int64           `json:"order_size"`
+	OrderSize         int64           `json:"order_size"`  // 剩余未成交数量
+	FilledSize        int64           `json:"filled_size"` // 已成交数量
 	BidInfos          []BidInfo       `json:"bid_infos"`
 }
 
+// PortfolioEscrowFilterParams 用户 Vault 托管资产查询参数
+type PortfolioEscrowFilterParams struct {
+	ChainID             []int    `json:"chain_id"`
+	CollectionAddresses []string `json:"collection_addresses"`
+	UserAddresses       []string `json:"user_addresses"`
+}
+
+type UserEscrowResp struct {
+	Result *UserEscrow `json:"result"`
+}
+
+// UserEscrow 用户在 Vault 中托管的资产: 买单锁定的 ETH 及卖单托管的 NFT
+type UserEscrow struct {
+	TotalLockedEth  decimal.Decimal    `json:"total_locked_eth"`
+	TotalLockedNfts int64              `json:"total_locked_nfts"`
+	Collections     []EscrowCollection `json:"collections"`
+	Orders          []EscrowOrder      `json:"orders"`
+}
+
+// EscrowCollection 按集合汇总的托管资产
+type EscrowCollection struct {
+	ChainID           int             `json:"chain_id"`
+	CollectionAddress string          `json:"collection_address"`
+	CollectionName    string          `json:"collection_name"`
+	ImageURI          string          `json:"image_uri"`
+	LockedEth         decimal.Decimal `json:"locked_eth"`  // 买单锁定的 ETH
+	LockedNfts        int64           `json:"locked_nfts"` // 卖单托管的 NFT 数量
+	BidCount          int64           `json:"bid_count"`
+	ListingCount      int64           `json:"listing_count"`
+}
+
+// EscrowOrder 单个订单托管的资产
+type EscrowOrder struct {
+	ChainID           int             `json:"chain_id"`
+	CollectionAddress string          `json:"collection_address"`
+	TokenID           string          `json:"token_id"`
+	OrderID           string          `json:"order_id"`
+	OrderType         int64           `json:"order_type"`
+	OrderStatus       int             `json:"order_status"`
+	Maker             string          `json:"maker"`
+	Price             decimal.Decimal `json:"price"`
+	QuantityRemaining int64           `json:"quantity_remaining"`
+	ExpireTime        int64           `json:"expire_time"`
+	EscrowEth         decimal.Decimal `json:"escrow_eth"`
+	EscrowNft         bool            `json:"escrow_nft"`
+}
+
+// PortfolioValuationFilterParams 用户持仓估值查询参数
+type PortfolioValuationFilterParams struct {
+	ChainID       []int    `json:"chain_id"`
+	UserAddresses []string `json:"user_addresses"`
+}
+
+type UserValuationResp struct {
+	Result *PortfolioValuation `json:"result"`
+}
+
+// PortfolioValuation 用户持仓估值及盈亏
+// 未实现盈亏只统计有买入成本的持仓, 按地板价扣除当前协议手续费后计算; 已实现盈亏只统计有买入成本的卖出, 按成交时的费率扣除手续费
+type PortfolioValuation struct {
+	FloorValue    decimal.Decimal       `json:"floor_value"`    // 按地板价估值
+	BidValue      decimal.Decimal       `json:"bid_value"`      // 按集合最高出价估值
+	CostBasis     decimal.Decimal       `json:"cost_basis"`     // 持仓买入成本
+	UnrealizedPnl decimal.Decimal       `json:"unrealized_pnl"` // 未实现盈亏
+	RealizedPnl   decimal.Decimal       `json:"realized_pnl"`   // 已实现盈亏
+	SellProceeds  decimal.Decimal       `json:"sell_proceeds"`  // 卖出所得 (已扣除协议手续费)
+	ProtocolFees  decimal.Decimal       `json:"protocol_fees"`  // 卖出支付的协议手续费
+	Collections   []CollectionValuation `json:"collections"`
+	Items         []ItemValuation       `json:"items"`
+}
+
+// CollectionValuation 按集合汇总的估值及盈亏
+type CollectionValuation struct {
+	ChainID           int             `json:"chain_id"`
+	CollectionAddress string          `json:"collection_address"`
+	CollectionName    string          `json:"collection_name"`
+	ImageURI          string          `json:"image_uri"`
+	ItemCount         int64           `json:"item_count"`     // 持有数量
+	CostedItems       int64           `json:"costed_items"`   // 有买入成本的持有数量
+	FloorPrice        decimal.Decimal `json:"floor_price"`    // 当前地板价
+	BestBidPrice      decimal.Decimal `json:"best_bid_price"` // 当前集合最高出价
+	FloorValue        decimal.Decimal `json:"floor_value"`
+	BidValue          decimal.Decimal `json:"bid_value"`
+	CostBasis         decimal.Decimal `json:"cost_basis"`
+	UnrealizedPnl     decimal.Decimal `json:"unrealized_pnl"`
+	RealizedPnl       decimal.Decimal `json:"realized_pnl"`
+	SellProceeds      decimal.Decimal `json:"sell_proceeds"`
+	ProtocolFees      decimal.Decimal `json:"protocol_fees"`
+}
+
+// ItemValuation 单个持仓的估值
+type ItemValuation struct {
+	ChainID           int             `json:"chain_id"`
+	CollectionAddress string          `json:"collection_address"`
+	TokenID           string          `json:"token_id"`
+	FloorValue        decimal.Decimal `json:"floor_value"`
+	BidValue          decimal.Decimal `json:"bid_value"`
+	CostBasis         decimal.Decimal `json:"cost_basis"`     // 最近一次买入价格
+	HasCostBasis      bool            `json:"has_cost_basis"` // 是否有买入记录 (铸造或转入的 NFT 没有)
+	AcquiredTime      int64           `json:"acquired_time"`  // 最近一次买入时间
+	UnrealizedPnl     decimal.Decimal `json:"unrealized_pnl"`
+}
+
+// PortfolioValueHistoryFilterParams 用户持仓价值历史查询参数
+type PortfolioValueHistoryFilterParams struct {
+	ChainID       []int    `json:"chain_id"`
+	UserAddresses []string `json:"user_addresses"`
+	Period        string   `json:"period"` // 1d, 7d, 30d, 60d, 默认 7d
+}
+
+type UserValueHistoryResp struct {
+	Result []PortfolioValuePoint `json:"result"`
+}
+
+// PortfolioValuePoint 某一时间点按地板价计算的持仓价值
+type PortfolioValuePoint struct {
+	Time      int64           `json:"time"`
+	ItemCount int64           `json:"item_count"`
+	Value     decimal.Decimal `json:"value"`
+}
+
 type MultichainCollection struct {
 	CollectionAddress string `json:"collection_address"`
 	Chain             string `json:"chain"`
@@ -0,0 +1,16 @@
+package types
+
+// ChainSyncStatus 单条链的事件索引进度
+type ChainSyncStatus struct {
+	ChainID          int    `json:"chain_id"`
+	Chain            string `json:"chain"`
+	ChainHeadBlock   int64  `json:"chain_head_block"`   // 链上最新区块, 节点不可用时为 0
+	LastIndexedBlock int64  `json:"last_indexed_block"` // 已索引的区块高度
+	LastIndexedTime  int64  `json:"last_indexed_time"`  // 最近一次推进索引的时间 (unix 秒)
+	LagBlocks        int64  `json:"lag_blocks"`         // 落后链上最新区块的数量, 节点不可用时为 -1
+}
+
+// SyncStatusResp 索引进度响应
+type SyncStatusResp struct {
+	Result []ChainSyncStatus `json:"result"`
+}
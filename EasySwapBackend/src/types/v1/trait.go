@@ -9,7 +9,7 @@ type TraitCount struct {
 }
 
 type ItemTraitsResp struct {
-	Result interface{} `json:"result"`
+	Result []TraitInfo `json:"result"`
 }
 
 // TraitInfo 属性百分比信息
@@ -3,25 +3,61 @@ package types
 // LoginReq 用户登录请求
 type LoginReq struct {
 	ChainID   int    `json:"chain_id"`  // 链 ID
-	Message   string `json:"message"`   // 签名消息 (Nonce)
-	Signature string `json:"signature"` // 签名结果
+	Message   string `json:"message"`   // SIWE (EIP-4361) 登录消息原文
+	Signature string `json:"signature"` // personal_sign 签名结果
 	Address   string `json:"address"`   // 用户地址
 }
 
+// LoginDevice 登录设备信息, 记录在会话中供用户管理
+type LoginDevice struct {
+	UserAgent string
+	IP        string
+}
+
+// UserTokens 会话 token 对, 接口鉴权使用请求头 Authorization: Bearer <access_token>
+type UserTokens struct {
+	SessionID        string `json:"session_id"`         // 会话 ID
+	TokenType        string `json:"token_type"`         // 固定为 Bearer
+	AccessToken      string `json:"access_token"`       // 短期 access token
+	AccessExpiresAt  int64  `json:"access_expires_at"`  // access token 过期时间 (秒)
+	RefreshToken     string `json:"refresh_token"`      // 用于换取新 token 对的 refresh token, 仅可使用一次
+	RefreshExpiresAt int64  `json:"refresh_expires_at"` // refresh token 过期时间 (秒)
+}
+
 // UserLoginInfo 登录成功响应
 type UserLoginInfo struct {
-	Token     string `json:"token"`      // 鉴权 Token (JWT/Session)
-	IsAllowed bool   `json:"is_allowed"` // 是否允许登录
+	UserTokens
+	IsAllowed bool `json:"is_allowed"` // 是否允许登录
+}
+
+// RefreshTokenReq 刷新 token 请求
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// UserSession 用户登录会话 (设备)
+type UserSession struct {
+	SessionID     string `json:"session_id"`
+	UserAgent     string `json:"user_agent"`
+	IP            string `json:"ip"`
+	CreatedAt     int64  `json:"created_at"`      // 登录时间 (秒)
+	LastRefreshAt int64  `json:"last_refresh_at"` // 最近刷新 token 时间 (秒)
+	ExpiresAt     int64  `json:"expires_at"`      // 会话过期时间 (秒)
+	Current       bool   `json:"current"`         // 是否为当前请求所属会话
+}
+
+type UserSessionsResp struct {
+	Result []UserSession `json:"result"`
 }
 
 type UserLoginResp struct {
-	Result interface{} `json:"result"`
+	Result *UserLoginInfo `json:"result"`
 }
 
 // UserLoginMsgResp 登录消息响应
 type UserLoginMsgResp struct {
 	Address string `json:"address"` // 用户地址
-	Message string `json:"message"` // 生成的随机 Nonce 消息
+	Message string `json:"message"` // SIWE (EIP-4361) 登录消息, 前端原样签名
 }
 
 // UserSignStatusResp 用户签名状态
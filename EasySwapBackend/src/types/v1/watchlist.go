@@ -0,0 +1,65 @@
+package types
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// FavoriteItemReq 收藏 Item 请求
+type FavoriteItemReq struct {
+	ChainID           int    `json:"chain_id"`
+	CollectionAddress string `json:"collection_address"`
+	TokenID           string `json:"token_id"`
+}
+
+// FavoriteFilterParams 收藏 Item 查询参数
+type FavoriteFilterParams struct {
+	ChainID  []int `json:"chain_id"` // 为空时查询所有链
+	Page     int   `json:"page"`
+	PageSize int   `json:"page_size"`
+}
+
+// FavoriteItem 收藏的 Item
+type FavoriteItem struct {
+	ChainID            int             `json:"chain_id"`
+	CollectionAddress  string          `json:"collection_address"`
+	CollectionName     string          `json:"collection_name"`
+	CollectionImageURI string          `json:"collection_image_uri"`
+	TokenID            string          `json:"token_id"`
+	Name               string          `json:"name"`
+	ImageURI           string          `json:"image_uri"`
+	OwnerAddress       string          `json:"owner_address"`
+	FloorPrice         decimal.Decimal `json:"floor_price"`   // 集合当前地板价
+	FavoriteTime       int64           `json:"favorite_time"` // 收藏时间 (毫秒)
+}
+
+// FavoriteItemsResp 收藏 Item 查询响应
+type FavoriteItemsResp struct {
+	Result []FavoriteItem `json:"result"`
+	Count  int64          `json:"count"`
+}
+
+// WatchCollectionReq 关注集合请求
+type WatchCollectionReq struct {
+	ChainID           int    `json:"chain_id"`
+	CollectionAddress string `json:"collection_address"`
+}
+
+// WatchedCollection 关注的集合及实时行情
+type WatchedCollection struct {
+	ChainID         int             `json:"chain_id"`
+	Address         string          `json:"address"`
+	Name            string          `json:"name"`
+	ImageUri        string          `json:"image_uri"`
+	FloorPrice      decimal.Decimal `json:"floor_price"`        // 当前地板价
+	FloorChange     string          `json:"floor_price_change"` // 24h 地板价涨跌幅
+	Volume24h       decimal.Decimal `json:"volume_24h"`         // 24h 交易量
+	VolumeChange24h int             `json:"volume_change_24h"`  // 24h 交易量环比变化 (百分比)
+	Sales24h        int64           `json:"sales_24h"`          // 24h 成交数
+	ListAmount      int             `json:"list_amount"`        // 挂单数量
+	WatchTime       int64           `json:"watch_time"`         // 关注时间 (毫秒)
+}
+
+// WatchlistResp 关注集合列表响应
+type WatchlistResp struct {
+	Result []WatchedCollection `json:"result"`
+}
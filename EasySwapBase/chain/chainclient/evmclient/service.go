@@ -3,13 +3,17 @@ package evmclient
 import (
 	"context"
 	"math/big"
+	"net/http"
+	"strings"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 
 	logTypes "github.com/ProjectsTask/EasySwapBase/chain/types"
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
 )
 
 type Service struct {
@@ -17,13 +21,18 @@ type Service struct {
 }
 
 func New(nodeUrl string) (*Service, error) {
-	client, err := ethclient.Dial(nodeUrl)
+	// HTTP 节点使用带链路追踪的 Transport, 请求上下文中存在 span 时记录每次 JSON-RPC 请求
+	var opts []rpc.ClientOption
+	if strings.HasPrefix(nodeUrl, "http://") || strings.HasPrefix(nodeUrl, "https://") {
+		opts = append(opts, rpc.WithHTTPClient(&http.Client{Transport: xtrace.NewTransport(nil)}))
+	}
+	rpcClient, err := rpc.DialOptions(context.Background(), nodeUrl, opts...)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed on create client")
 	}
 
 	return &Service{
-		client: client,
+		client: ethclient.NewClient(rpcClient),
 	}, nil
 }
 
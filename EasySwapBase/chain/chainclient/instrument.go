@@ -0,0 +1,100 @@
+package chainclient
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	logTypes "github.com/ProjectsTask/EasySwapBase/chain/types"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
+)
+
+// instrumentedClient 记录每次 RPC 调用耗时、失败数及链路追踪 span 的 ChainClient
+type instrumentedClient struct {
+	ChainClient
+	chain string
+}
+
+// Instrument 为链客户端增加 RPC 指标与链路追踪, chain 为链名称
+func Instrument(client ChainClient, chain string) ChainClient {
+	return &instrumentedClient{ChainClient: client, chain: chain}
+}
+
+// startSpan 上下文中存在 span 时为 RPC 调用创建子 span
+func (c *instrumentedClient) startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	if !xtrace.HasSpan(ctx) {
+		return ctx, nil
+	}
+	return xtrace.Tracer().Start(ctx, "chainclient."+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("chain", c.chain)))
+}
+
+func (c *instrumentedClient) observe(span trace.Span, method string, start time.Time, err error) {
+	metrics.RPCDuration.WithLabelValues(c.chain, method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.RPCErrors.WithLabelValues(c.chain, method).Inc()
+	}
+	if span == nil {
+		return
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+func (c *instrumentedClient) FilterLogs(ctx context.Context, q logTypes.FilterQuery) ([]interface{}, error) {
+	ctx, span := c.startSpan(ctx, "FilterLogs")
+	start := time.Now()
+	logs, err := c.ChainClient.FilterLogs(ctx, q)
+	c.observe(span, "FilterLogs", start, err)
+	return logs, err
+}
+
+func (c *instrumentedClient) BlockTimeByNumber(ctx context.Context, number *big.Int) (uint64, error) {
+	ctx, span := c.startSpan(ctx, "BlockTimeByNumber")
+	start := time.Now()
+	blockTime, err := c.ChainClient.BlockTimeByNumber(ctx, number)
+	c.observe(span, "BlockTimeByNumber", start, err)
+	return blockTime, err
+}
+
+func (c *instrumentedClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	ctx, span := c.startSpan(ctx, "CallContract")
+	start := time.Now()
+	result, err := c.ChainClient.CallContract(ctx, msg, blockNumber)
+	c.observe(span, "CallContract", start, err)
+	return result, err
+}
+
+func (c *instrumentedClient) CallContractByChain(ctx context.Context, param logTypes.CallParam) (interface{}, error) {
+	ctx, span := c.startSpan(ctx, "CallContractByChain")
+	start := time.Now()
+	result, err := c.ChainClient.CallContractByChain(ctx, param)
+	c.observe(span, "CallContractByChain", start, err)
+	return result, err
+}
+
+// BlockNumber 接口不带上下文, 仅记录指标
+func (c *instrumentedClient) BlockNumber() (uint64, error) {
+	start := time.Now()
+	number, err := c.ChainClient.BlockNumber()
+	c.observe(nil, "BlockNumber", start, err)
+	return number, err
+}
+
+func (c *instrumentedClient) BlockWithTxs(ctx context.Context, blockNumber uint64) (interface{}, error) {
+	ctx, span := c.startSpan(ctx, "BlockWithTxs")
+	start := time.Now()
+	block, err := c.ChainClient.BlockWithTxs(ctx, blockNumber)
+	c.observe(span, "BlockWithTxs", start, err)
+	return block, err
+}
@@ -0,0 +1,42 @@
+package chainclient
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+)
+
+type fakeClient struct {
+	ChainClient
+	number uint64
+	err    error
+}
+
+func (f *fakeClient) BlockNumber() (uint64, error) {
+	return f.number, f.err
+}
+
+func TestInstrument(t *testing.T) {
+	client := Instrument(&fakeClient{number: 100}, "test")
+	number, err := client.BlockNumber()
+	if err != nil || number != 100 {
+		t.Fatalf("BlockNumber() = %d, %v", number, err)
+	}
+	if got := testutil.ToFloat64(metrics.RPCErrors.WithLabelValues("test", "BlockNumber")); got != 0 {
+		t.Errorf("errors after success = %v, want 0", got)
+	}
+
+	client = Instrument(&fakeClient{err: errors.New("node down")}, "test")
+	if _, err := client.BlockNumber(); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := testutil.ToFloat64(metrics.RPCErrors.WithLabelValues("test", "BlockNumber")); got != 1 {
+		t.Errorf("errors after failure = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(metrics.RPCDuration, "easyswap_rpc_request_duration_seconds"); got != 1 {
+		t.Errorf("duration series = %d, want 1", got)
+	}
+}
@@ -0,0 +1,137 @@
+package nftchainservice
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// BatchCaller 批量 JSON-RPC 调用, rpc.Client 满足该接口
+type BatchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Listing 待校验的挂单: Maker 需持有 NFT 并对 operator (Vault) 授权
+type Listing struct {
+	Collection common.Address
+	TokenId    *big.Int
+	Maker      common.Address
+}
+
+// ListingState 挂单 NFT 的链上所有权及授权状态
+type ListingState struct {
+	Owner          common.Address // NFT 当前持有人, NFT 已销毁时为零地址
+	ApprovedForAll bool           // Maker 是否 setApprovalForAll 给 operator
+	Approved       common.Address // 单个 NFT 的授权地址
+	Err            error          // 查询失败 (非合约 revert) 时无法判断有效性
+}
+
+// Valid 挂单可成交: Maker 仍持有 NFT, 且已对 operator 授权; operator 为零地址时只校验持有人
+func (s *ListingState) Valid(maker, operator common.Address) bool {
+	if s.Owner != maker {
+		return false
+	}
+	return operator == (common.Address{}) || s.ApprovedForAll || s.Approved == operator
+}
+
+// BatchListingStates 通过一次批量 RPC 请求查询挂单 NFT 的 ownerOf、isApprovedForAll(maker, operator) 及 getApproved
+// 单个调用被合约 revert (如 NFT 已销毁) 时视为零值, 其他错误记录在对应 ListingState.Err 中
+func BatchListingStates(ctx context.Context, caller BatchCaller, operator common.Address, listings []Listing) ([]ListingState, error) {
+	contractAbi, err := NftContractMetaData.GetAbi()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get contract abi")
+	}
+
+	type call struct {
+		method string
+		args   []interface{}
+	}
+	const callsPerListing = 3
+	elems := make([]rpc.BatchElem, 0, len(listings)*callsPerListing)
+	results := make([]hexutil.Bytes, len(listings)*callsPerListing)
+	for i, listing := range listings {
+		calls := []call{
+			{method: "ownerOf", args: []interface{}{listing.TokenId}},
+			{method: "isApprovedForAll", args: []interface{}{listing.Maker, operator}},
+			{method: "getApproved", args: []interface{}{listing.TokenId}},
+		}
+		for j, c := range calls {
+			data, err := contractAbi.Pack(c.method, c.args...)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed on pack %s", c.method)
+			}
+			elems = append(elems, rpc.BatchElem{
+				Method: "eth_call",
+				Args: []interface{}{map[string]interface{}{
+					"to":   listing.Collection,
+					"data": hexutil.Bytes(data),
+				}, "latest"},
+				Result: &results[i*callsPerListing+j],
+			})
+		}
+	}
+	if len(elems) == 0 {
+		return nil, nil
+	}
+	if err := caller.BatchCallContext(ctx, elems); err != nil {
+		return nil, errors.Wrap(err, "failed on batch call listing states")
+	}
+
+	states := make([]ListingState, len(listings))
+	for i := range listings {
+		state := &states[i]
+		owner, err := unpackCall(contractAbi, "ownerOf", elems[i*callsPerListing])
+		if err == nil && owner != nil {
+			state.Owner = *abi.ConvertType(owner, new(common.Address)).(*common.Address)
+		}
+		if err == nil {
+			var approvedForAll interface{}
+			approvedForAll, err = unpackCall(contractAbi, "isApprovedForAll", elems[i*callsPerListing+1])
+			if approvedForAll != nil {
+				state.ApprovedForAll = approvedForAll.(bool)
+			}
+		}
+		if err == nil && state.Owner != (common.Address{}) {
+			var approved interface{}
+			approved, err = unpackCall(contractAbi, "getApproved", elems[i*callsPerListing+2])
+			if approved != nil {
+				state.Approved = *abi.ConvertType(approved, new(common.Address)).(*common.Address)
+			}
+		}
+		state.Err = err
+	}
+	return states, nil
+}
+
+// unpackCall 解析批量调用中单个 eth_call 的结果, 合约 revert 时返回 nil
+func unpackCall(contractAbi *abi.ABI, method string, elem rpc.BatchElem) (interface{}, error) {
+	if elem.Error != nil {
+		if isRevert(elem.Error) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(elem.Error, "failed on call %s", method)
+	}
+	result := *elem.Result.(*hexutil.Bytes)
+	if len(result) == 0 {
+		return nil, nil
+	}
+	values, err := contractAbi.Unpack(method, result)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed on unpack %s", method)
+	}
+	return values[0], nil
+}
+
+func isRevert(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
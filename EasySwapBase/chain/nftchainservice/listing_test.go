@@ -0,0 +1,72 @@
+package nftchainservice
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeBatchCaller 按方法返回预设结果, 未配置的方法按 revert 处理
+type fakeBatchCaller struct {
+	outputs map[string][]interface{}
+}
+
+func (f *fakeBatchCaller) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
+	contractAbi, _ := NftContractMetaData.GetAbi()
+	for i := range b {
+		data := b[i].Args[0].(map[string]interface{})["data"].(hexutil.Bytes)
+		method, err := contractAbi.MethodById(data[:4])
+		if err != nil {
+			return err
+		}
+		outputs, ok := f.outputs[method.Name]
+		if !ok {
+			b[i].Error = errors.New("execution reverted")
+			continue
+		}
+		result, err := method.Outputs.Pack(outputs...)
+		if err != nil {
+			return err
+		}
+		*b[i].Result.(*hexutil.Bytes) = result
+	}
+	return nil
+}
+
+func TestBatchListingStates(t *testing.T) {
+	maker := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	vault := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	listings := []Listing{{Collection: common.HexToAddress("0x01"), TokenId: big.NewInt(1), Maker: maker}}
+
+	caller := &fakeBatchCaller{outputs: map[string][]interface{}{
+		"ownerOf":          {maker},
+		"isApprovedForAll": {false},
+		"getApproved":      {vault},
+	}}
+	states, err := BatchListingStates(context.Background(), caller, vault, listings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if states[0].Err != nil || !states[0].Valid(maker, vault) {
+		t.Fatalf("expected valid listing, got %+v", states[0])
+	}
+
+	// 授权被撤销
+	caller.outputs["getApproved"] = []interface{}{common.Address{}}
+	states, _ = BatchListingStates(context.Background(), caller, vault, listings)
+	if states[0].Valid(maker, vault) {
+		t.Fatalf("expected invalid listing after approval revoked")
+	}
+
+	// NFT 已销毁, ownerOf revert
+	delete(caller.outputs, "ownerOf")
+	states, _ = BatchListingStates(context.Background(), caller, vault, listings)
+	if states[0].Err != nil || states[0].Owner != (common.Address{}) || states[0].Valid(maker, vault) {
+		t.Fatalf("expected burned token to be invalid, got %+v", states[0])
+	}
+}
@@ -48,6 +48,7 @@ func New(ctx context.Context, endpoint, chainName string, chainID int, nameTags,
 	if err != nil {
 		return nil, errors.Wrap(err, "failed on create node client")
 	}
+	nodeClient = chainclient.Instrument(nodeClient, chainName)
 
 	abi, err := NftContractMetaData.GetAbi()
 	if err != nil {
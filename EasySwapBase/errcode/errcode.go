@@ -50,6 +50,9 @@ var (
 	ErrInvalidParams    = NewErr(10002, "Parameter is illegal")
 	ErrTokenVerify      = NewErr(10003, "Token check error", http.StatusUnauthorized)
 	ErrTokenExpire      = NewErr(10004, "Expired token", http.StatusUnauthorized)
+	ErrPermissionDenied = NewErr(10005, "Permission denied", http.StatusForbidden)
+	ErrTooManyRequests  = NewErr(10006, "Too many requests", http.StatusTooManyRequests)
+	ErrInvalidApiKey    = NewErr(10007, "Invalid api key", http.StatusUnauthorized)
 )
 
 var codeToErr = map[uint32]*Err{
@@ -60,6 +63,9 @@ var codeToErr = map[uint32]*Err{
 	10002: ErrInvalidParams,
 	10003: ErrTokenVerify,
 	10004: ErrTokenExpire,
+	10005: ErrPermissionDenied,
+	10006: ErrTooManyRequests,
+	10007: ErrInvalidApiKey,
 }
 
 // NewErr 创建新的业务错误
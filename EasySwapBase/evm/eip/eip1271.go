@@ -0,0 +1,51 @@
+package eip
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// EIP1271MagicValue 校验通过时的返回值, 即 bytes4(keccak256("isValidSignature(bytes32,bytes)"))
+var EIP1271MagicValue = [4]byte{0x16, 0x26, 0xba, 0x7e}
+
+var isValidSignatureSelector = crypto.Keccak256([]byte("isValidSignature(bytes32,bytes)"))[:4]
+
+var isValidSignatureArgs = func() abi.Arguments {
+	bytes32Type, _ := abi.NewType("bytes32", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	return abi.Arguments{{Type: bytes32Type}, {Type: bytesType}}
+}()
+
+// ContractCaller 合约只读调用, chainclient.ChainClient 满足该接口
+type ContractCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// IsValidSignature 通过 EIP-1271 校验合约钱包签名
+// 合约未实现该接口或调用 revert 时返回 false
+func IsValidSignature(ctx context.Context, caller ContractCaller, wallet common.Address, hash common.Hash, signature []byte) (bool, error) {
+	args, err := isValidSignatureArgs.Pack([32]byte(hash), signature)
+	if err != nil {
+		return false, errors.Wrap(err, "failed on pack isValidSignature")
+	}
+	data := append(append([]byte{}, isValidSignatureSelector...), args...)
+
+	res, err := caller.CallContract(ctx, ethereum.CallMsg{To: &wallet, Data: data}, nil)
+	if err != nil {
+		// 合约 revert 视为签名无效, 其余错误 (如节点不可用) 返回给调用方
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed on call isValidSignature")
+	}
+	return len(res) >= 4 && bytes.Equal(res[:4], EIP1271MagicValue[:]), nil
+}
@@ -0,0 +1,31 @@
+package eip
+
+import (
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// PersonalSignHash EIP-191 personal_sign 摘要: keccak256("\x19Ethereum Signed Message:\n" + len(message) + message)
+func PersonalSignHash(message []byte) common.Hash {
+	return common.BytesToHash(accounts.TextHash(message))
+}
+
+// RecoverPersonalSign 从 personal_sign 签名中恢复签名者, 兼容 v 为 0/1 及 27/28 的签名
+func RecoverPersonalSign(message []byte, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, errors.New("invalid signature length")
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(PersonalSignHash(message).Bytes(), sig)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed on recover signer")
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
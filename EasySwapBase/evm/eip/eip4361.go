@@ -0,0 +1,164 @@
+package eip
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// SiweVersion EIP-4361 消息版本
+const SiweVersion = "1"
+
+const siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+
+var siweNonceRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{8,}$`)
+
+// SiweMessage Sign-In with Ethereum (EIP-4361) 登录消息
+// ExpirationTime/NotBefore 为零值时表示消息中不包含该字段
+type SiweMessage struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time
+	NotBefore      time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// String 按 EIP-4361 格式生成待签名消息
+func (m *SiweMessage) String() string {
+	var b strings.Builder
+	b.WriteString(m.Domain + siweHeaderSuffix + "\n")
+	b.WriteString(m.Address.Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("URI: " + m.URI + "\n")
+	b.WriteString("Version: " + m.Version + "\n")
+	b.WriteString(fmt.Sprintf("Chain ID: %d\n", m.ChainID))
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + formatSiweTime(m.IssuedAt))
+	if !m.ExpirationTime.IsZero() {
+		b.WriteString("\nExpiration Time: " + formatSiweTime(m.ExpirationTime))
+	}
+	if !m.NotBefore.IsZero() {
+		b.WriteString("\nNot Before: " + formatSiweTime(m.NotBefore))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, resource := range m.Resources {
+			b.WriteString("\n- " + resource)
+		}
+	}
+	return b.String()
+}
+
+// ParseSiweMessage 解析 EIP-4361 消息
+func ParseSiweMessage(message string) (*SiweMessage, error) {
+	lines := strings.Split(message, "\n")
+	if len(lines) < 8 || !strings.HasSuffix(lines[0], siweHeaderSuffix) {
+		return nil, errors.New("invalid siwe message header")
+	}
+
+	m := &SiweMessage{Domain: strings.TrimSuffix(lines[0], siweHeaderSuffix)}
+	if m.Domain == "" {
+		return nil, errors.New("invalid siwe domain")
+	}
+	if !common.IsHexAddress(lines[1]) || !strings.HasPrefix(lines[1], "0x") {
+		return nil, errors.New("invalid siwe address")
+	}
+	m.Address = common.HexToAddress(lines[1])
+	if lines[2] != "" {
+		return nil, errors.New("invalid siwe message format")
+	}
+
+	// statement 可选: 存在时为 "statement" + 空行, 不存在时仅空行
+	i := 3
+	if lines[i] != "" {
+		m.Statement = lines[i]
+		i++
+		if i >= len(lines) || lines[i] != "" {
+			return nil, errors.New("invalid siwe message format")
+		}
+	}
+	i++
+
+	var err error
+	fields := []struct {
+		prefix   string
+		required bool
+		parse    func(string) error
+	}{
+		{"URI: ", true, func(v string) error { m.URI = v; return nil }},
+		{"Version: ", true, func(v string) error { m.Version = v; return nil }},
+		{"Chain ID: ", true, func(v string) error { m.ChainID, err = strconv.ParseInt(v, 10, 64); return err }},
+		{"Nonce: ", true, func(v string) error { m.Nonce = v; return nil }},
+		{"Issued At: ", true, func(v string) error { m.IssuedAt, err = parseSiweTime(v); return err }},
+		{"Expiration Time: ", false, func(v string) error { m.ExpirationTime, err = parseSiweTime(v); return err }},
+		{"Not Before: ", false, func(v string) error { m.NotBefore, err = parseSiweTime(v); return err }},
+		{"Request ID: ", false, func(v string) error { m.RequestID = v; return nil }},
+	}
+	for _, field := range fields {
+		if i < len(lines) && strings.HasPrefix(lines[i], field.prefix) {
+			if err := field.parse(strings.TrimPrefix(lines[i], field.prefix)); err != nil {
+				return nil, errors.Wrapf(err, "invalid siwe field %q", strings.TrimSuffix(field.prefix, ": "))
+			}
+			i++
+		} else if field.required {
+			return nil, errors.Errorf("missing siwe field %q", strings.TrimSuffix(field.prefix, ": "))
+		}
+	}
+
+	if i < len(lines) && lines[i] == "Resources:" {
+		for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+			m.Resources = append(m.Resources, strings.TrimPrefix(lines[i], "- "))
+		}
+	}
+	if i != len(lines) {
+		return nil, errors.New("unexpected siwe message content")
+	}
+
+	if m.Version != SiweVersion {
+		return nil, errors.New("unsupported siwe version")
+	}
+	if !siweNonceRegexp.MatchString(m.Nonce) {
+		return nil, errors.New("invalid siwe nonce")
+	}
+	return m, nil
+}
+
+// Validate 校验消息时间窗口: 已签发, 未过期, 已到生效时间
+func (m *SiweMessage) Validate(now time.Time) error {
+	if m.IssuedAt.After(now) {
+		return errors.New("siwe message is issued in the future")
+	}
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return errors.New("siwe message is expired")
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return errors.New("siwe message is not yet valid")
+	}
+	return nil
+}
+
+func formatSiweTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseSiweTime(v string) (time.Time, error) {
+	return time.Parse(time.RFC3339Nano, v)
+}
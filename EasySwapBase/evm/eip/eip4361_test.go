@@ -0,0 +1,121 @@
+package eip
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func testSiweMessage() *SiweMessage {
+	issuedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &SiweMessage{
+		Domain:         "easyswap.link",
+		Address:        common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"),
+		Statement:      "Welcome to EasySwap!",
+		URI:            "https://easyswap.link",
+		Version:        SiweVersion,
+		ChainID:        11155111,
+		Nonce:          "32891756a5f04f8f9c1e0d4d6c3e2b1a",
+		IssuedAt:       issuedAt,
+		ExpirationTime: issuedAt.Add(10 * time.Minute),
+	}
+}
+
+func TestSiweMessage(t *testing.T) {
+	msg := testSiweMessage()
+	text := msg.String()
+	assert.Equal(t, "easyswap.link wants you to sign in with your Ethereum account:\n"+
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266\n\n"+
+		"Welcome to EasySwap!\n\n"+
+		"URI: https://easyswap.link\n"+
+		"Version: 1\n"+
+		"Chain ID: 11155111\n"+
+		"Nonce: 32891756a5f04f8f9c1e0d4d6c3e2b1a\n"+
+		"Issued At: 2024-01-02T03:04:05Z\n"+
+		"Expiration Time: 2024-01-02T03:14:05Z", text)
+
+	parsed, err := ParseSiweMessage(text)
+	assert.Nil(t, err)
+	assert.Equal(t, msg.String(), parsed.String())
+	assert.Equal(t, msg.Address, parsed.Address)
+	assert.Equal(t, msg.ChainID, parsed.ChainID)
+
+	// 无 statement, 带 resources
+	msg.Statement = ""
+	msg.ExpirationTime = time.Time{}
+	msg.Resources = []string{"https://easyswap.link/terms"}
+	parsed, err = ParseSiweMessage(msg.String())
+	assert.Nil(t, err)
+	assert.Equal(t, "", parsed.Statement)
+	assert.True(t, parsed.ExpirationTime.IsZero())
+	assert.Equal(t, msg.Resources, parsed.Resources)
+
+	// 旧格式消息无法解析
+	_, err = ParseSiweMessage("Welcome to EasySwap!\nNonce:32891756a5f04f8f9c1e0d4d6c3e2b1a")
+	assert.NotNil(t, err)
+}
+
+func TestSiweValidate(t *testing.T) {
+	msg := testSiweMessage()
+	assert.Nil(t, msg.Validate(msg.IssuedAt.Add(time.Minute)))
+	assert.NotNil(t, msg.Validate(msg.IssuedAt.Add(-time.Minute)))
+	assert.NotNil(t, msg.Validate(msg.ExpirationTime))
+}
+
+func TestRecoverPersonalSign(t *testing.T) {
+	key, err := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	assert.Nil(t, err)
+	message := []byte(testSiweMessage().String())
+
+	sig, err := crypto.Sign(PersonalSignHash(message).Bytes(), key)
+	assert.Nil(t, err)
+	sig[crypto.RecoveryIDOffset] += 27 // 钱包返回的 v 为 27/28
+
+	signer, err := RecoverPersonalSign(message, sig)
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+
+	_, err = RecoverPersonalSign(message, hexutil.MustDecode("0x1234"))
+	assert.NotNil(t, err)
+}
+
+type mockCaller struct {
+	res []byte
+	err error
+	msg ethereum.CallMsg
+}
+
+func (m *mockCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	m.msg = msg
+	return m.res, m.err
+}
+
+func TestIsValidSignature(t *testing.T) {
+	wallet := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	hash := PersonalSignHash([]byte("hello"))
+
+	caller := &mockCaller{res: common.RightPadBytes(EIP1271MagicValue[:], 32)}
+	ok, err := IsValidSignature(context.Background(), caller, wallet, hash, []byte{1, 2, 3})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, wallet, *caller.msg.To)
+	assert.Equal(t, EIP1271MagicValue[:], caller.msg.Data[:4])
+	assert.Equal(t, hash.Bytes(), caller.msg.Data[4:36])
+
+	// EOA 调用返回空
+	ok, err = IsValidSignature(context.Background(), &mockCaller{}, wallet, hash, nil)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// 节点错误返回给调用方
+	_, err = IsValidSignature(context.Background(), &mockCaller{err: errors.New("connection refused")}, wallet, hash, nil)
+	assert.NotNil(t, err)
+}
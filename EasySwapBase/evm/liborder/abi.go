@@ -0,0 +1,57 @@
+package liborder
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+// orderArguments LibOrder.Order 的 ABI tuple 定义, 字段名与合约一致
+var orderArguments = func() abi.Arguments {
+	orderType, err := abi.NewType("tuple", "struct LibOrder.Order", []abi.ArgumentMarshaling{
+		{Name: "side", Type: "uint8"},
+		{Name: "saleKind", Type: "uint8"},
+		{Name: "maker", Type: "address"},
+		{Name: "nft", Type: "tuple", Components: []abi.ArgumentMarshaling{
+			{Name: "tokenId", Type: "uint256"},
+			{Name: "collection", Type: "address"},
+			{Name: "amount", Type: "uint96"},
+		}},
+		{Name: "price", Type: "uint128"},
+		{Name: "expiry", Type: "uint64"},
+		{Name: "salt", Type: "uint64"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Name: "order", Type: orderType}}
+}()
+
+// EncodeABI 将订单编码为 ABI tuple (abi.encode(order))
+func (o *Order) EncodeABI() ([]byte, error) {
+	data, err := orderArguments.Pack(o)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on pack order")
+	}
+	return data, nil
+}
+
+// DecodeABI 从 ABI tuple 解码订单, 与 EncodeABI 互逆
+func DecodeABI(data []byte) (*Order, error) {
+	values, err := orderArguments.Unpack(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on unpack order")
+	}
+
+	return FromABI(values[0])
+}
+
+// FromABI 将 go-ethereum 解码得到的 tuple 值 (如事件/调用参数中的 LibOrder.Order) 转为 Order
+// 解码值为按字段顺序生成的匿名结构体, 按位置转换
+func FromABI(value interface{}) (order *Order, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("failed on convert abi order: %v", r)
+		}
+	}()
+	return abi.ConvertType(value, new(Order)).(*Order), nil
+}
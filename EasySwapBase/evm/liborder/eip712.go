@@ -0,0 +1,67 @@
+package liborder
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// EIP712DomainTypeHash OpenZeppelin EIP712Upgradeable 使用的域类型
+var EIP712DomainTypeHash = crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)"))
+
+// Domain 订单簿合约的 EIP-712 签名域
+// Name/Version 为合约 initialize 时传入的 EIP712Name/EIP712Version, VerifyingContract 为订单簿合约地址
+type Domain struct {
+	Name              string
+	Version           string
+	ChainId           int64
+	VerifyingContract common.Address
+}
+
+// Separator EIP-712 domainSeparator
+func (d Domain) Separator() common.Hash {
+	return crypto.Keccak256Hash(
+		EIP712DomainTypeHash.Bytes(),
+		crypto.Keccak256([]byte(d.Name)),
+		crypto.Keccak256([]byte(d.Version)),
+		common.LeftPadBytes(big.NewInt(d.ChainId).Bytes(), 32),
+		common.LeftPadBytes(d.VerifyingContract.Bytes(), 32),
+	)
+}
+
+// Digest 订单的 EIP-712 签名摘要: keccak256("\x19\x01" || domainSeparator || hashStruct(order))
+func (o *Order) Digest(domain Domain) common.Hash {
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domain.Separator().Bytes(), o.StructHash().Bytes())
+}
+
+// RecoverSigner 从订单签名中恢复签名者, 兼容 v 为 27/28 的签名
+func (o *Order) RecoverSigner(domain Domain, signature []byte) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, errors.New("invalid signature length")
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(o.Digest(domain).Bytes(), sig)
+	if err != nil {
+		return common.Address{}, errors.Wrap(err, "failed on recover signer")
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// VerifySignature 校验签名者是否为订单 Maker
+func (o *Order) VerifySignature(domain Domain, signature []byte) error {
+	signer, err := o.RecoverSigner(domain, signature)
+	if err != nil {
+		return err
+	}
+	if signer != o.Maker {
+		return errors.New("signer is not maker")
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Package liborder 与合约 LibOrder 保持一致的订单结构、OrderKey 计算、EIP-712 签名及校验规则,
+// 保证 sync、backend 及工具对订单身份的计算结果一致.
+package liborder
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+)
+
+// Side 对应 LibOrder.Side
+const (
+	SideList uint8 = 0
+	SideBid  uint8 = 1
+)
+
+// SaleKind 对应 LibOrder.SaleKind
+const (
+	SaleKindFixedPriceForCollection uint8 = 0
+	SaleKindFixedPriceForItem       uint8 = 1
+)
+
+var (
+	// AssetTypeHash LibOrder.ASSET_TYPEHASH
+	AssetTypeHash = crypto.Keccak256Hash([]byte("Asset(uint256 tokenId,address collection,uint96 amount)"))
+	// OrderTypeHash LibOrder.ORDER_TYPEHASH
+	OrderTypeHash = crypto.Keccak256Hash([]byte("Order(uint8 side,uint8 saleKind,address maker,Asset nft,uint128 price,uint64 expiry,uint64 salt)Asset(uint256 tokenId,address collection,uint96 amount)"))
+)
+
+// Asset 对应 LibOrder.Asset
+type Asset struct {
+	TokenId    *big.Int
+	Collection common.Address
+	Amount     *big.Int // uint96
+}
+
+// Order 对应 LibOrder.Order, 字段顺序与合约一致, 可直接用于 ABI 编解码
+type Order struct {
+	Side     uint8
+	SaleKind uint8
+	Maker    common.Address
+	Nft      Asset
+	Price    *big.Int // uint128
+	Expiry   uint64
+	Salt     uint64
+}
+
+// Hash 对应 LibOrder.hash(Asset): keccak256(abi.encode(ASSET_TYPEHASH, tokenId, collection, amount))
+func (a Asset) Hash() common.Hash {
+	return crypto.Keccak256Hash(
+		AssetTypeHash.Bytes(),
+		common.LeftPadBytes(bigOrZero(a.TokenId).Bytes(), 32),
+		common.LeftPadBytes(a.Collection.Bytes(), 32),
+		common.LeftPadBytes(bigOrZero(a.Amount).Bytes(), 32),
+	)
+}
+
+// Key 对应 LibOrder.hash(Order), 即合约中的 OrderKey
+// 注意合约使用 abi.encodePacked, 与 EIP-712 的 StructHash 不同
+func (o *Order) Key() common.Hash {
+	return crypto.Keccak256Hash(
+		OrderTypeHash.Bytes(),
+		[]byte{o.Side},
+		[]byte{o.SaleKind},
+		o.Maker.Bytes(),
+		o.Nft.Hash().Bytes(),
+		common.LeftPadBytes(bigOrZero(o.Price).Bytes(), 16),
+		common.LeftPadBytes(new(big.Int).SetUint64(o.Expiry).Bytes(), 8),
+		common.LeftPadBytes(new(big.Int).SetUint64(o.Salt).Bytes(), 8),
+	)
+}
+
+// StructHash EIP-712 hashStruct(Order): keccak256(abi.encode(ORDER_TYPEHASH, side, saleKind, maker, hash(nft), price, expiry, salt))
+func (o *Order) StructHash() common.Hash {
+	return crypto.Keccak256Hash(
+		OrderTypeHash.Bytes(),
+		common.LeftPadBytes([]byte{o.Side}, 32),
+		common.LeftPadBytes([]byte{o.SaleKind}, 32),
+		common.LeftPadBytes(o.Maker.Bytes(), 32),
+		o.Nft.Hash().Bytes(),
+		common.LeftPadBytes(bigOrZero(o.Price).Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(o.Expiry).Bytes(), 32),
+		common.LeftPadBytes(new(big.Int).SetUint64(o.Salt).Bytes(), 32),
+	)
+}
+
+// OrderType 合约订单对应的 ob_order.order_type
+func (o *Order) OrderType() int64 {
+	if o.Side == SideList {
+		return multi.ListingOrder
+	}
+	if o.SaleKind == SaleKindFixedPriceForCollection {
+		return multi.CollectionBidOrder
+	}
+	return multi.ItemBidOrder
+}
+
+// FromModel 由 ob_order 记录还原合约订单
+// 仅支持订单簿合约的 listing/collection bid/item bid, 属性出价等链下订单不可上链
+func FromModel(order *multi.Order) (*Order, error) {
+	var side, saleKind uint8
+	switch order.OrderType {
+	case multi.ListingOrder:
+		side, saleKind = SideList, SaleKindFixedPriceForItem
+	case multi.CollectionBidOrder:
+		side, saleKind = SideBid, SaleKindFixedPriceForCollection
+	case multi.ItemBidOrder:
+		side, saleKind = SideBid, SaleKindFixedPriceForItem
+	default:
+		return nil, errors.Errorf("unsupported order type: %d", order.OrderType)
+	}
+
+	tokenID := big.NewInt(0)
+	if order.TokenId != "" {
+		var ok bool
+		if tokenID, ok = new(big.Int).SetString(order.TokenId, 10); !ok {
+			return nil, errors.Errorf("invalid token id: %s", order.TokenId)
+		}
+	}
+
+	return &Order{
+		Side:     side,
+		SaleKind: saleKind,
+		Maker:    common.HexToAddress(order.Maker),
+		Nft: Asset{
+			TokenId:    tokenID,
+			Collection: common.HexToAddress(order.CollectionAddress),
+			Amount:     big.NewInt(order.Size),
+		},
+		Price:  order.Price.BigInt(),
+		Expiry: uint64(order.ExpireTime),
+		Salt:   uint64(order.Salt),
+	}, nil
+}
+
+// KeyHex OrderKey 的 0x 开头小写十六进制表示, 与 ob_order.order_id 一致
+func (o *Order) KeyHex() string {
+	return strings.ToLower(o.Key().Hex())
+}
+
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return big.NewInt(0)
+	}
+	return v
+}
@@ -0,0 +1,209 @@
+package liborder
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+)
+
+// hardhat 默认账户 #0
+const (
+	hardhatAccount0    = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	hardhatAccount0Key = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+)
+
+// hardhatListOrder TestEasySwap.js 中 makeOrders 的卖单 (tokenId 0, salt 1),
+// 与 sync orderbookindexer 测试中记录的 LogMake 事件一致
+func hardhatListOrder() *Order {
+	price, _ := new(big.Int).SetString("2386f26fc10000", 16) // 0.01 ether
+	return &Order{
+		Side:     SideList,
+		SaleKind: SaleKindFixedPriceForItem,
+		Maker:    common.HexToAddress(hardhatAccount0),
+		Nft: Asset{
+			TokenId:    big.NewInt(0),
+			Collection: common.HexToAddress("0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"),
+			Amount:     big.NewInt(1),
+		},
+		Price:  price,
+		Expiry: 0x6558875d,
+		Salt:   1,
+	}
+}
+
+func TestTypeHash(t *testing.T) {
+	// LibOrder.ASSET_TYPEHASH / ORDER_TYPEHASH
+	assert.Equal(t, crypto.Keccak256Hash([]byte("Asset(uint256 tokenId,address collection,uint96 amount)")), AssetTypeHash)
+	assert.Equal(t, crypto.Keccak256Hash([]byte("Order(uint8 side,uint8 saleKind,address maker,Asset nft,uint128 price,uint64 expiry,uint64 salt)Asset(uint256 tokenId,address collection,uint96 amount)")), OrderTypeHash)
+}
+
+func TestOrderKey(t *testing.T) {
+	order := hardhatListOrder()
+	assert.Equal(t, "0xc773ae81bc9a186dc6c5d70a486730a6f734578ae1a0116acd0aaaf69250d265", order.KeyHex())
+
+	// 任一字段变化都会改变 OrderKey
+	other := hardhatListOrder()
+	other.Salt = 2
+	assert.NotEqual(t, order.Key(), other.Key())
+}
+
+func TestDigest(t *testing.T) {
+	order := hardhatListOrder()
+	domain := Domain{
+		Name:              "EasySwapOrderBook",
+		Version:           "1",
+		ChainId:           31337,
+		VerifyingContract: common.HexToAddress("0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0"),
+	}
+
+	// 与 go-ethereum 的通用 EIP-712 实现交叉校验
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Order": {
+				{Name: "side", Type: "uint8"},
+				{Name: "saleKind", Type: "uint8"},
+				{Name: "maker", Type: "address"},
+				{Name: "nft", Type: "Asset"},
+				{Name: "price", Type: "uint128"},
+				{Name: "expiry", Type: "uint64"},
+				{Name: "salt", Type: "uint64"},
+			},
+			"Asset": {
+				{Name: "tokenId", Type: "uint256"},
+				{Name: "collection", Type: "address"},
+				{Name: "amount", Type: "uint96"},
+			},
+		},
+		PrimaryType: "Order",
+		Domain: apitypes.TypedDataDomain{
+			Name:              domain.Name,
+			Version:           domain.Version,
+			ChainId:           math.NewHexOrDecimal256(domain.ChainId),
+			VerifyingContract: domain.VerifyingContract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"side":     "0",
+			"saleKind": "1",
+			"maker":    hardhatAccount0,
+			"nft": map[string]interface{}{
+				"tokenId":    "0",
+				"collection": "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512",
+				"amount":     "1",
+			},
+			"price":  order.Price.String(),
+			"expiry": "1700300637",
+			"salt":   "1",
+		},
+	}
+	expected, _, err := apitypes.TypedDataAndHash(typedData)
+	assert.Nil(t, err)
+	assert.Equal(t, common.BytesToHash(expected), order.Digest(domain))
+}
+
+func TestSignature(t *testing.T) {
+	order := hardhatListOrder()
+	domain := Domain{Name: "EasySwapOrderBook", Version: "1", ChainId: 31337,
+		VerifyingContract: common.HexToAddress("0x9fE46736679d2D9a65F0992F2272dE9f3c7fa6e0")}
+
+	key, err := crypto.HexToECDSA(hardhatAccount0Key)
+	assert.Nil(t, err)
+	sig, err := crypto.Sign(order.Digest(domain).Bytes(), key)
+	assert.Nil(t, err)
+
+	assert.Nil(t, order.VerifySignature(domain, sig))
+
+	// 钱包返回的签名 v 为 27/28
+	walletSig := append([]byte{}, sig...)
+	walletSig[crypto.RecoveryIDOffset] += 27
+	assert.Nil(t, order.VerifySignature(domain, walletSig))
+
+	// 域不同则签名无效
+	otherDomain := domain
+	otherDomain.ChainId = 11155111
+	assert.NotNil(t, order.VerifySignature(otherDomain, sig))
+
+	// 订单被篡改则签名无效
+	order.Price = big.NewInt(1)
+	assert.NotNil(t, order.VerifySignature(domain, sig))
+
+	_, err = order.RecoverSigner(domain, hexutil.MustDecode("0x1234"))
+	assert.NotNil(t, err)
+}
+
+func TestABI(t *testing.T) {
+	order := hardhatListOrder()
+	data, err := order.EncodeABI()
+	assert.Nil(t, err)
+	assert.Equal(t, 9*32, len(data)) // 静态 tuple, nft 展开为 3 个字段
+
+	decoded, err := DecodeABI(data)
+	assert.Nil(t, err)
+	assert.Equal(t, order.Key(), decoded.Key())
+}
+
+func TestFromModel(t *testing.T) {
+	order, err := FromModel(&multi.Order{
+		OrderType:         multi.ListingOrder,
+		Maker:             "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+		CollectionAddress: "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512",
+		TokenId:           "0",
+		Size:              1,
+		Price:             decimal.RequireFromString("10000000000000000"),
+		ExpireTime:        0x6558875d,
+		Salt:              1,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "0xc773ae81bc9a186dc6c5d70a486730a6f734578ae1a0116acd0aaaf69250d265", order.KeyHex())
+	assert.Equal(t, int64(multi.ListingOrder), order.OrderType())
+
+	_, err = FromModel(&multi.Order{OrderType: multi.TraitBidOrder})
+	assert.NotNil(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	const now = 1700000000
+	cases := []struct {
+		name   string
+		modify func(o *Order)
+		skip   bool
+		err    error
+	}{
+		{"valid list", func(o *Order) {}, false, nil},
+		{"miss maker", func(o *Order) { o.Maker = common.Address{} }, false, ErrMissMaker},
+		{"expired", func(o *Order) { o.Expiry = now }, false, ErrExpired},
+		{"skip expiry", func(o *Order) { o.Expiry = now }, true, nil},
+		{"never expire", func(o *Order) { o.Expiry = 0 }, false, nil},
+		{"zero salt", func(o *Order) { o.Salt = 0 }, false, ErrZeroSalt},
+		{"list without collection", func(o *Order) { o.Nft.Collection = common.Address{} }, false, ErrUnsupportedAsset},
+		{"list amount", func(o *Order) { o.Nft.Amount = big.NewInt(2) }, false, ErrInvalidAmount},
+		{"list for collection", func(o *Order) { o.SaleKind = SaleKindFixedPriceForCollection }, false, ErrListKindMismatch},
+		{"list zero price", func(o *Order) { o.Price = big.NewInt(0) }, false, nil},
+		{"bid zero price", func(o *Order) { o.Side = SideBid; o.Price = big.NewInt(0) }, false, ErrZeroPrice},
+		{"bid zero amount", func(o *Order) { o.Side = SideBid; o.Nft.Amount = big.NewInt(0) }, false, ErrInvalidAmount},
+		{"bid multi amount", func(o *Order) { o.Side = SideBid; o.Nft.Amount = big.NewInt(5) }, false, nil},
+		{"price overflow", func(o *Order) { o.Price = new(big.Int).Lsh(big.NewInt(1), 128) }, false, ErrPriceOverflow},
+		{"invalid side", func(o *Order) { o.Side = 2 }, false, ErrInvalidSide},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			order := hardhatListOrder()
+			c.modify(order)
+			assert.Equal(t, c.err, order.Validate(now, c.skip))
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package liborder
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// 订单簿合约方法名
+const (
+	MethodMakeOrders   = "makeOrders"
+	MethodCancelOrders = "cancelOrders"
+	MethodEditOrders   = "editOrders"
+	MethodMatchOrder   = "matchOrder"
+	MethodMatchOrders  = "matchOrders"
+)
+
+// OrderBook 解析后的订单簿合约 ABI
+var OrderBook = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(OrderBookABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// EditDetail 对应 LibOrder.EditDetail
+type EditDetail struct {
+	OldOrderKey common.Hash
+	NewOrder    Order
+}
+
+// MatchDetail 对应 LibOrder.MatchDetail
+type MatchDetail struct {
+	SellOrder Order
+	BuyOrder  Order
+}
+
+// PackMakeOrders 编码 makeOrders(newOrders) 调用数据
+func PackMakeOrders(orders []Order) ([]byte, error) {
+	return pack(MethodMakeOrders, orders)
+}
+
+// PackCancelOrders 编码 cancelOrders(orderKeys) 调用数据
+func PackCancelOrders(orderKeys []common.Hash) ([]byte, error) {
+	keys := make([][32]byte, len(orderKeys))
+	for i, key := range orderKeys {
+		keys[i] = key
+	}
+	return pack(MethodCancelOrders, keys)
+}
+
+// PackEditOrders 编码 editOrders(editDetails) 调用数据
+func PackEditOrders(details []EditDetail) ([]byte, error) {
+	type editDetail struct {
+		OldOrderKey [32]byte
+		NewOrder    Order
+	}
+	values := make([]editDetail, len(details))
+	for i, detail := range details {
+		values[i] = editDetail{OldOrderKey: detail.OldOrderKey, NewOrder: detail.NewOrder}
+	}
+	return pack(MethodEditOrders, values)
+}
+
+// PackMatchOrder 编码 matchOrder(sellOrder, buyOrder) 调用数据
+func PackMatchOrder(sellOrder, buyOrder Order) ([]byte, error) {
+	return pack(MethodMatchOrder, sellOrder, buyOrder)
+}
+
+// PackMatchOrders 编码 matchOrders(matchDetails) 调用数据
+func PackMatchOrders(details []MatchDetail) ([]byte, error) {
+	return pack(MethodMatchOrders, details)
+}
+
+// BidValue 买单挂单需随交易锁定到 Vault 的 ETH: price * amount, 卖单为 0
+func (o *Order) BidValue() *big.Int {
+	if o.Side != SideBid {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(bigOrZero(o.Price), bigOrZero(o.Nft.Amount))
+}
+
+func pack(method string, args ...interface{}) ([]byte, error) {
+	data, err := OrderBook.Pack(method, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed on pack %s", method)
+	}
+	return data, nil
+}
@@ -0,0 +1,73 @@
+package liborder
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackMakeOrders(t *testing.T) {
+	order := hardhatListOrder()
+	data, err := PackMakeOrders([]Order{*order})
+	assert.Nil(t, err)
+
+	selector := crypto.Keccak256([]byte("makeOrders((uint8,uint8,address,(uint256,address,uint96),uint128,uint64,uint64)[])"))[:4]
+	assert.Equal(t, selector, data[:4])
+
+	values, err := OrderBook.Methods[MethodMakeOrders].Inputs.Unpack(data[4:])
+	assert.Nil(t, err)
+	orders := values[0].([]struct {
+		Side     uint8          `json:"side"`
+		SaleKind uint8          `json:"saleKind"`
+		Maker    common.Address `json:"maker"`
+		Nft      struct {
+			TokenId    *big.Int       `json:"tokenId"`
+			Collection common.Address `json:"collection"`
+			Amount     *big.Int       `json:"amount"`
+		} `json:"nft"`
+		Price  *big.Int `json:"price"`
+		Expiry uint64   `json:"expiry"`
+		Salt   uint64   `json:"salt"`
+	})
+	decoded, err := FromABI(orders[0])
+	assert.Nil(t, err)
+	assert.Equal(t, order.Key(), decoded.Key())
+}
+
+func TestPackCancelAndEdit(t *testing.T) {
+	order := hardhatListOrder()
+
+	data, err := PackCancelOrders([]common.Hash{order.Key()})
+	assert.Nil(t, err)
+	values, err := OrderBook.Methods[MethodCancelOrders].Inputs.Unpack(data[4:])
+	assert.Nil(t, err)
+	assert.Equal(t, [][32]byte{order.Key()}, values[0])
+
+	newOrder := *order
+	newOrder.Price = big.NewInt(1)
+	newOrder.Salt = 2
+	_, err = PackEditOrders([]EditDetail{{OldOrderKey: order.Key(), NewOrder: newOrder}})
+	assert.Nil(t, err)
+}
+
+func TestPackMatchOrder(t *testing.T) {
+	sell := hardhatListOrder()
+	buy := *sell
+	buy.Side = SideBid
+	buy.Maker = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+	single, err := PackMatchOrder(*sell, buy)
+	assert.Nil(t, err)
+	assert.Equal(t, OrderBook.Methods[MethodMatchOrder].ID, single[:4])
+
+	batch, err := PackMatchOrders([]MatchDetail{{SellOrder: *sell, BuyOrder: buy}})
+	assert.Nil(t, err)
+	assert.Equal(t, OrderBook.Methods[MethodMatchOrders].ID, batch[:4])
+
+	assert.Equal(t, 0, sell.BidValue().Sign())
+	buy.Nft.Amount = big.NewInt(3)
+	assert.Equal(t, new(big.Int).Mul(sell.Price, big.NewInt(3)), buy.BidValue())
+}
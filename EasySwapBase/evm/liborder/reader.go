@@ -0,0 +1,116 @@
+package liborder
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// 订单簿及 Vault 合约只读方法名
+const (
+	MethodFilledAmount = "filledAmount"
+	MethodOrders       = "orders"
+	MethodGetBestPrice = "getBestPrice"
+	MethodBalanceOf    = "balanceOf"
+)
+
+// FilledAmountCancelled OrderValidator.CANCELLED, 订单取消后 filledAmount 被置为 uint256 最大值
+var FilledAmountCancelled = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Vault 解析后的 Vault 合约 ABI
+var Vault = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(VaultABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// ContractCaller 合约只读调用, chainclient.ChainClient 与 ethclient.Client 均满足该接口
+type ContractCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+// OrderState 订单在链上的状态
+type OrderState struct {
+	FilledAmount *big.Int       // 已成交数量, 取消后为 FilledAmountCancelled
+	Maker        common.Address // 订单簿中存储的 Maker, 订单不在簿中 (未上链、已成交或已取消) 时为零地址
+}
+
+// Cancelled 订单已在链上取消
+func (s *OrderState) Cancelled() bool {
+	return s.FilledAmount.Cmp(FilledAmountCancelled) == 0
+}
+
+// Filled 返回已成交数量, 已取消或超出 int64 范围时返回 math.MaxInt64
+func (s *OrderState) Filled() int64 {
+	if !s.FilledAmount.IsInt64() {
+		return math.MaxInt64
+	}
+	return s.FilledAmount.Int64()
+}
+
+// InBook 订单仍在订单簿中
+func (s *OrderState) InBook() bool {
+	return s.Maker != (common.Address{})
+}
+
+// VaultBalance 订单在 Vault 中托管的资产
+type VaultBalance struct {
+	ETHAmount *big.Int // 买单锁定的 ETH
+	TokenId   *big.Int // 卖单托管的 NFT TokenId
+}
+
+// QueryOrderState 查询订单在订单簿合约中的 filledAmount 及存储的订单, blockNumber 为 nil 时查询最新区块
+func QueryOrderState(ctx context.Context, caller ContractCaller, orderBook common.Address, orderKey common.Hash, blockNumber *big.Int) (*OrderState, error) {
+	filled, err := call(ctx, caller, blockNumber, OrderBook, orderBook, MethodFilledAmount, [32]byte(orderKey))
+	if err != nil {
+		return nil, err
+	}
+	stored, err := call(ctx, caller, blockNumber, OrderBook, orderBook, MethodOrders, [32]byte(orderKey))
+	if err != nil {
+		return nil, err
+	}
+	order := *abi.ConvertType(stored[0], new(Order)).(*Order)
+	return &OrderState{FilledAmount: filled[0].(*big.Int), Maker: order.Maker}, nil
+}
+
+// QueryBestPrice 查询集合指定方向的最优价格: 卖单为最低价, 买单为最高价, 无订单时为 0; blockNumber 为 nil 时查询最新区块
+func QueryBestPrice(ctx context.Context, caller ContractCaller, orderBook common.Address, collection common.Address, side uint8, blockNumber *big.Int) (*big.Int, error) {
+	values, err := call(ctx, caller, blockNumber, OrderBook, orderBook, MethodGetBestPrice, collection, side)
+	if err != nil {
+		return nil, err
+	}
+	return values[0].(*big.Int), nil
+}
+
+// QueryVaultBalance 查询订单在 Vault 合约中托管的资产, blockNumber 为 nil 时查询最新区块
+func QueryVaultBalance(ctx context.Context, caller ContractCaller, vault common.Address, orderKey common.Hash, blockNumber *big.Int) (*VaultBalance, error) {
+	values, err := call(ctx, caller, blockNumber, Vault, vault, MethodBalanceOf, [32]byte(orderKey))
+	if err != nil {
+		return nil, err
+	}
+	return &VaultBalance{ETHAmount: values[0].(*big.Int), TokenId: values[1].(*big.Int)}, nil
+}
+
+func call(ctx context.Context, caller ContractCaller, blockNumber *big.Int, contract abi.ABI, to common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := contract.Pack(method, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed on pack %s", method)
+	}
+	result, err := caller.CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, blockNumber)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed on call %s", method)
+	}
+	values, err := contract.Unpack(method, result)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed on unpack %s", method)
+	}
+	return values, nil
+}
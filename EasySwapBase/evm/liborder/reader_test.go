@@ -0,0 +1,60 @@
+package liborder
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCaller 按方法返回预设结果的合约调用
+type fakeCaller struct {
+	contract abi.ABI
+	outputs  map[string][]interface{}
+	blocks   []*big.Int // 每次调用查询的区块
+}
+
+func (f *fakeCaller) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	f.blocks = append(f.blocks, blockNumber)
+	method, err := f.contract.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(f.outputs[method.Name]...)
+}
+
+func TestQueryOrderState(t *testing.T) {
+	order := hardhatListOrder()
+	caller := &fakeCaller{contract: OrderBook, outputs: map[string][]interface{}{
+		MethodFilledAmount: {FilledAmountCancelled},
+		MethodOrders:       {*order, [32]byte{}},
+		MethodGetBestPrice: {big.NewInt(100)},
+	}}
+
+	state, err := QueryOrderState(context.Background(), caller, common.Address{}, order.Key(), big.NewInt(42))
+	assert.Nil(t, err)
+	assert.True(t, state.Cancelled())
+	assert.True(t, state.InBook())
+	assert.Equal(t, order.Maker, state.Maker)
+
+	assert.Equal(t, []*big.Int{big.NewInt(42), big.NewInt(42)}, caller.blocks)
+
+	price, err := QueryBestPrice(context.Background(), caller, common.Address{}, order.Nft.Collection, SideList, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), price.Int64())
+	assert.Nil(t, caller.blocks[2])
+}
+
+func TestQueryVaultBalance(t *testing.T) {
+	caller := &fakeCaller{contract: Vault, outputs: map[string][]interface{}{
+		MethodBalanceOf: {big.NewInt(5), big.NewInt(7)},
+	}}
+	balance, err := QueryVaultBalance(context.Background(), caller, common.Address{}, common.Hash{1}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), balance.ETHAmount.Int64())
+	assert.Equal(t, int64(7), balance.TokenId.Int64())
+}
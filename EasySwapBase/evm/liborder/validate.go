@@ -0,0 +1,84 @@
+package liborder
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	maxUint96  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 96), big.NewInt(1))
+	maxUint128 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+)
+
+// 校验错误, 信息与合约 revert 原因一致
+var (
+	ErrMissMaker        = errors.New("OVa: miss maker")
+	ErrExpired          = errors.New("OVa: expired")
+	ErrZeroSalt         = errors.New("OVa: zero salt")
+	ErrUnsupportedAsset = errors.New("OVa: unsupported nft asset")
+	ErrZeroPrice        = errors.New("OVa: zero price")
+	ErrInvalidSide      = errors.New("invalid side")
+	ErrInvalidSaleKind  = errors.New("invalid sale kind")
+	ErrInvalidAmount    = errors.New("invalid amount")
+	ErrPriceOverflow    = errors.New("price overflows uint128")
+	ErrAmountOverflow   = errors.New("amount overflows uint96")
+	ErrListKindMismatch = errors.New("HD: kind mismatch")
+	ErrInvalidTokenId   = errors.New("invalid token id")
+)
+
+// Validate 校验订单, 规则与合约 OrderValidator._validateOrder 一致:
+// maker 非零; 未过期 (expiry 为 0 表示永不过期, skipExpiry 为 true 时跳过); salt 非零;
+// 卖单需指定 collection, 买单价格需大于 0.
+// 额外校验 makeOrders 的数量规则 (卖单数量为 1, 买单数量大于 0) 及撮合要求的卖单类型.
+func (o *Order) Validate(now uint64, skipExpiry bool) error {
+	if o.Side != SideList && o.Side != SideBid {
+		return ErrInvalidSide
+	}
+	if o.SaleKind != SaleKindFixedPriceForCollection && o.SaleKind != SaleKindFixedPriceForItem {
+		return ErrInvalidSaleKind
+	}
+	if o.Maker == (common.Address{}) {
+		return ErrMissMaker
+	}
+	if !skipExpiry && o.Expiry != 0 && o.Expiry <= now {
+		return ErrExpired
+	}
+	if o.Salt == 0 {
+		return ErrZeroSalt
+	}
+
+	price := bigOrZero(o.Price)
+	if price.Sign() < 0 || price.Cmp(maxUint128) > 0 {
+		return ErrPriceOverflow
+	}
+	amount := bigOrZero(o.Nft.Amount)
+	if amount.Sign() < 0 || amount.Cmp(maxUint96) > 0 {
+		return ErrAmountOverflow
+	}
+	if bigOrZero(o.Nft.TokenId).Sign() < 0 {
+		return ErrInvalidTokenId
+	}
+
+	if o.Side == SideList {
+		if o.Nft.Collection == (common.Address{}) {
+			return ErrUnsupportedAsset
+		}
+		if o.SaleKind != SaleKindFixedPriceForItem {
+			return ErrListKindMismatch
+		}
+		if amount.Cmp(big.NewInt(1)) != 0 {
+			return ErrInvalidAmount
+		}
+		return nil
+	}
+
+	if price.Sign() == 0 {
+		return ErrZeroPrice
+	}
+	if amount.Sign() == 0 {
+		return ErrInvalidAmount
+	}
+	return nil
+}
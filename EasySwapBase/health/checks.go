@@ -0,0 +1,43 @@
+package health
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/gorm"
+)
+
+// BlockNumberer 可查询最新区块高度的链客户端, chainclient.ChainClient 满足该接口
+type BlockNumberer interface {
+	BlockNumber() (uint64, error)
+}
+
+// DBCheck 检查数据库连接
+func DBCheck(db *gorm.DB) Check {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return errors.Wrap(err, "failed on get sql db")
+		}
+		return sqlDB.PingContext(ctx)
+	}
+}
+
+// RedisCheck 检查 Redis 连接
+func RedisCheck(r *redis.Redis) Check {
+	return func(ctx context.Context) error {
+		if !r.PingCtx(ctx) {
+			return errors.New("redis ping failed")
+		}
+		return nil
+	}
+}
+
+// ChainCheck 检查链节点 RPC 是否可用
+func ChainCheck(client BlockNumberer) Check {
+	return func(ctx context.Context) error {
+		_, err := client.BlockNumber()
+		return errors.Wrap(err, "failed on get block number")
+	}
+}
@@ -0,0 +1,215 @@
+// Package health 服务存活 (liveness) / 就绪 (readiness) 检查
+// 存活检查基于后台循环心跳: 循环卡住或异常退出后心跳过期, 由编排系统重启进程;
+// 就绪检查依次探测 DB、Redis、链节点等依赖是否可用
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOk   = "ok"
+	StatusFail = "fail"
+
+	defaultCheckTimeout = 3 * time.Second
+)
+
+// Check 依赖检查函数, 返回 nil 表示依赖可用
+type Check func(ctx context.Context) error
+
+// Heartbeat 后台循环心跳, 超过 maxAge 未上报视为循环已卡住或退出
+type Heartbeat struct {
+	name   string
+	maxAge time.Duration
+	last   atomic.Int64 // 最近一次上报时间 (unix nano)
+}
+
+// Beat 上报一次心跳
+func (h *Heartbeat) Beat() {
+	h.last.Store(time.Now().UnixNano())
+}
+
+// LastBeat 最近一次心跳时间
+func (h *Heartbeat) LastBeat() time.Time {
+	return time.Unix(0, h.last.Load())
+}
+
+// Alive 心跳是否在 maxAge 内
+func (h *Heartbeat) Alive(now time.Time) bool {
+	return now.Sub(h.LastBeat()) <= h.maxAge
+}
+
+var (
+	mu         sync.RWMutex
+	heartbeats = make(map[string]*Heartbeat)
+)
+
+// NewHeartbeat 注册心跳, 注册时即视为一次上报, 给循环留出启动时间
+// 同名心跳重复注册时返回已注册的实例
+func NewHeartbeat(name string, maxAge time.Duration) *Heartbeat {
+	mu.Lock()
+	defer mu.Unlock()
+	if h, ok := heartbeats[name]; ok {
+		return h
+	}
+	h := &Heartbeat{name: name, maxAge: maxAge}
+	h.Beat()
+	heartbeats[name] = h
+	return h
+}
+
+// Result 单项检查结果
+type Result struct {
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+	Latency int64  `json:"latency_ms"`
+}
+
+// HeartbeatStatus 单个心跳状态
+type HeartbeatStatus struct {
+	Status   string  `json:"status"`
+	LastBeat int64   `json:"last_beat"`   // unix 秒
+	Age      float64 `json:"age_seconds"` // 距最近一次心跳的秒数
+	MaxAge   float64 `json:"max_age_seconds"`
+}
+
+// Report 检查报告, 任一项失败时 Status 为 fail
+type Report struct {
+	Status     string                     `json:"status"`
+	Checks     map[string]Result          `json:"checks,omitempty"`
+	Heartbeats map[string]HeartbeatStatus `json:"heartbeats,omitempty"`
+}
+
+// Ok 是否全部检查通过
+func (r *Report) Ok() bool {
+	return r.Status == StatusOk
+}
+
+// Liveness 汇总所有已注册心跳
+func Liveness() *Report {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	now := time.Now()
+	report := &Report{Status: StatusOk, Heartbeats: make(map[string]HeartbeatStatus, len(heartbeats))}
+	for name, h := range heartbeats {
+		status := HeartbeatStatus{
+			Status:   StatusOk,
+			LastBeat: h.LastBeat().Unix(),
+			Age:      now.Sub(h.LastBeat()).Seconds(),
+			MaxAge:   h.maxAge.Seconds(),
+		}
+		if !h.Alive(now) {
+			status.Status = StatusFail
+			report.Status = StatusFail
+		}
+		report.Heartbeats[name] = status
+	}
+	return report
+}
+
+// Checker 就绪检查器, 并发执行已注册的依赖检查
+type Checker struct {
+	timeout time.Duration
+	names   []string
+	checks  map[string]Check
+}
+
+// NewChecker 新建就绪检查器, timeout 为单项检查超时时间, <=0 时使用默认值
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+	return &Checker{timeout: timeout, checks: make(map[string]Check)}
+}
+
+// Add 注册依赖检查, 同名检查会被覆盖
+func (c *Checker) Add(name string, check Check) *Checker {
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+		sort.Strings(c.names)
+	}
+	c.checks[name] = check
+	return c
+}
+
+// Readiness 执行所有依赖检查
+func (c *Checker) Readiness(ctx context.Context) *Report {
+	report := &Report{Status: StatusOk, Checks: make(map[string]Result, len(c.names))}
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	for _, name := range c.names {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			result := run(ctx, check, c.timeout)
+
+			lock.Lock()
+			defer lock.Unlock()
+			report.Checks[name] = result
+			if result.Status != StatusOk {
+				report.Status = StatusFail
+			}
+		}(name, c.checks[name])
+	}
+	wg.Wait()
+	return report
+}
+
+func run(ctx context.Context, check Check, timeout time.Duration) Result {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	// 部分检查 (如链节点 BlockNumber) 不支持 context, 在协程中执行以保证超时返回
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := Result{Status: StatusOk, Latency: time.Since(start).Milliseconds()}
+	if err != nil {
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// LivenessHandler 存活检查 HTTP 接口, 心跳过期时返回 503
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteReport(w, Liveness())
+	})
+}
+
+// ReadinessHandler 就绪检查 HTTP 接口, 任一依赖不可用时返回 503
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		WriteReport(w, c.Readiness(r.Context()))
+	})
+}
+
+// WriteReport 以 JSON 输出检查报告
+func WriteReport(w http.ResponseWriter, report *Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	if report.Ok() {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,66 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLiveness(t *testing.T) {
+	h := NewHeartbeat("test_loop", time.Minute)
+	if NewHeartbeat("test_loop", time.Second) != h {
+		t.Fatalf("expected same heartbeat for duplicated name")
+	}
+
+	if report := Liveness(); !report.Ok() {
+		t.Fatalf("expected alive right after register, got %+v", report)
+	}
+
+	// 模拟循环卡住
+	h.last.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	report := Liveness()
+	if report.Ok() || report.Heartbeats["test_loop"].Status != StatusFail {
+		t.Fatalf("expected stale heartbeat, got %+v", report)
+	}
+
+	rec := httptest.NewRecorder()
+	LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+
+	h.Beat()
+	if report := Liveness(); !report.Ok() {
+		t.Fatalf("expected alive after beat, got %+v", report)
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	checker := NewChecker(50*time.Millisecond).
+		Add("ok", func(ctx context.Context) error { return nil }).
+		Add("broken", func(ctx context.Context) error { return errors.New("connection refused") })
+
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503, got %d", rec.Code)
+	}
+
+	report := checker.Readiness(context.Background())
+	if report.Checks["ok"].Status != StatusOk || report.Checks["broken"].Error != "connection refused" {
+		t.Fatalf("unexpected report %+v", report)
+	}
+
+	// 不响应 context 的检查按超时失败
+	blocked := make(chan struct{})
+	defer close(blocked)
+	report = NewChecker(50*time.Millisecond).
+		Add("hang", func(ctx context.Context) error { <-blocked; return nil }).
+		Readiness(context.Background())
+	if report.Ok() || report.Checks["hang"].Error != context.DeadlineExceeded.Error() {
+		t.Fatalf("expected timeout, got %+v", report)
+	}
+}
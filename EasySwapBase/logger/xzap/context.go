@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 
@@ -67,9 +68,25 @@ func tagsToFields(ctx context.Context) []zapcore.Field {
 	return fields
 }
 
+// traceToFields 将上下文中的链路追踪 id 转换为zap中的Field
+func traceToFields(ctx context.Context) []zapcore.Field {
+	if ctx == nil {
+		return nil
+	}
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return nil
+	}
+	return []zapcore.Field{
+		zap.String("trace_id", spanCtx.TraceID().String()),
+		zap.String("span_id", spanCtx.SpanID().String()),
+	}
+}
+
 // Extract 提取xzap中最新的Logger
 func (l *CtxLogger) Extract() *zap.Logger {
 	fields := tagsToFields(l.ctx)
+	fields = append(fields, traceToFields(l.ctx)...)
 	fields = append(fields, l.fields...)
 	return l.logger.With(fields...)
 }
@@ -0,0 +1,134 @@
+// Package metrics EasySwap 各服务共用的 Prometheus 指标
+// 指标注册到默认 Registry, 通过 Handler 暴露; 看板与告警规则见仓库根目录 monitoring/
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const Namespace = "easyswap"
+
+var (
+	// ChainHeadBlock 链上最新区块高度
+	ChainHeadBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "indexer",
+		Name:      "chain_head_block",
+		Help:      "Latest block number reported by the chain node.",
+	}, []string{"chain"})
+
+	// LastIndexedBlock 已同步的区块高度 (对应 ob_indexed_status.last_indexed_block)
+	LastIndexedBlock = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "indexer",
+		Name:      "last_indexed_block",
+		Help:      "Last block number persisted as indexed.",
+	}, []string{"chain"})
+
+	// IndexerLagBlocks 同步落后链上最新区块的数量
+	IndexerLagBlocks = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "indexer",
+		Name:      "lag_blocks",
+		Help:      "Number of blocks between the chain head and the last indexed block.",
+	}, []string{"chain"})
+
+	// LogsProcessed 处理的合约日志数, event 为事件名 (LogMake/LogCancel/LogMatch)
+	LogsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "indexer",
+		Name:      "logs_processed_total",
+		Help:      "Number of contract logs processed, by event type.",
+	}, []string{"chain", "event"})
+
+	// HandlerErrors 日志处理失败数
+	HandlerErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "indexer",
+		Name:      "handler_errors_total",
+		Help:      "Number of contract logs whose handler failed, by event type.",
+	}, []string{"chain", "event"})
+
+	// RPCDuration 链节点 RPC 调用耗时 (秒)
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of chain node RPC calls, by method.",
+		Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"chain", "method"})
+
+	// RPCErrors 链节点 RPC 调用失败数
+	RPCErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of failed chain node RPC calls, by method.",
+	}, []string{"chain", "method"})
+
+	// TimeWheelPending 订单过期时间轮中等待过期检查的订单数
+	TimeWheelPending = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "ordermanager",
+		Name:      "time_wheel_pending",
+		Help:      "Number of orders waiting in the expiry time wheel.",
+	}, []string{"chain"})
+
+	// FloorPriceUpdates 集合地板价变更次数
+	FloorPriceUpdates = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "ordermanager",
+		Name:      "floor_price_updates_total",
+		Help:      "Number of collection floor price changes written.",
+	}, []string{"chain"})
+
+	// LoopRestarts 后台循环异常退出后被重启的次数, loop 为循环名称
+	LoopRestarts = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "supervisor",
+		Name:      "loop_restarts_total",
+		Help:      "Number of times a supervised background loop was restarted after exiting unexpectedly.",
+	}, []string{"loop"})
+
+	// ReconcileDiscrepancies 链上对账发现的订单/地板价不一致数, kind 为不一致类型, fixed 表示是否已自动修复
+	ReconcileDiscrepancies = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "reconciler",
+		Name:      "discrepancies_total",
+		Help:      "Number of divergences between ob_order and on-chain orderbook state found by the reconciler.",
+	}, []string{"chain", "kind", "fixed"})
+
+	// ListingValidityChanges 挂单有效性变化次数, status 为 invalidated (Maker 不再持有 NFT 或撤销授权) 或 restored
+	ListingValidityChanges = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "listing_validator",
+		Name:      "changes_total",
+		Help:      "Number of listings marked inactive or restored by the ownership and approval validator.",
+	}, []string{"chain", "status"})
+
+	// NotificationDeliveries 通知外部渠道投递次数, status 为 sent / retry / failed (重试次数用尽)
+	NotificationDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "notification",
+		Name:      "deliveries_total",
+		Help:      "Number of notification delivery attempts by channel and outcome.",
+	}, []string{"channel", "status"})
+
+	// HTTPRequestDuration 接口请求耗时 (秒), route 为路由模板
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests, by route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+)
+
+// Handler 指标采集接口
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+)
+
+type QueueType int
+
+const (
+	ListQueue QueueType = iota // Redis List, 长度取 LLEN
+	SetQueue                   // Redis Set, 长度取 SCARD
+)
+
+// Queue 需要采集长度的 Redis 队列
+type Queue struct {
+	Name  string // 队列名, 作为 queue 标签
+	Chain string
+	Key   string
+	Type  QueueType
+}
+
+var queueLengthDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(Namespace, "queue", "length"),
+	"Number of pending entries in a Redis queue.",
+	[]string{"chain", "queue"}, nil,
+)
+
+// QueueCollector 在采集时读取 Redis 队列长度
+// 读取失败的队列本次不上报, 避免以 0 掩盖 Redis 故障, 也不影响其他指标的采集
+type QueueCollector struct {
+	kv     *xkv.Store
+	queues []Queue
+}
+
+func NewQueueCollector(kv *xkv.Store, queues ...Queue) *QueueCollector {
+	return &QueueCollector{kv: kv, queues: queues}
+}
+
+// RegisterQueues 注册队列长度采集
+func RegisterQueues(kv *xkv.Store, queues ...Queue) error {
+	return prometheus.Register(NewQueueCollector(kv, queues...))
+}
+
+func (c *QueueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- queueLengthDesc
+}
+
+func (c *QueueCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, q := range c.queues {
+		length, err := c.length(q)
+		if err != nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(queueLengthDesc, prometheus.GaugeValue, float64(length), q.Chain, q.Name)
+	}
+}
+
+func (c *QueueCollector) length(q Queue) (int64, error) {
+	if q.Type == SetQueue {
+		return c.kv.Scard(q.Key)
+	}
+	n, err := c.kv.Llen(q.Key)
+	return int64(n), err
+}
@@ -0,0 +1,45 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+// Channel 通知外部投递渠道
+type Channel interface {
+	// Name 渠道名称, 与 NotificationDelivery.Channel 对应
+	Name() string
+	// Send 投递单条通知, 返回错误时按退避策略重试
+	Send(ctx context.Context, pref *base.NotificationPreference, n *base.Notification) error
+}
+
+var weiPerEther = decimal.New(1, 18)
+
+// Render 生成通知的标题和正文, 用于邮件等文本渠道
+func Render(n *base.Notification) (string, string) {
+	price := n.Price.Div(weiPerEther).String() + " ETH"
+	item := n.CollectionAddress
+	if n.TokenId != "" {
+		item = fmt.Sprintf("%s #%s", n.CollectionAddress, n.TokenId)
+	}
+
+	switch n.Kind {
+	case base.NotificationItemSold:
+		return "Your item sold", fmt.Sprintf("%s sold for %s.", item, price)
+	case base.NotificationBidAccepted:
+		return "Your bid was accepted", fmt.Sprintf("Your bid of %s on %s was accepted.", price, item)
+	case base.NotificationOutbid:
+		return "You were outbid", fmt.Sprintf("A higher bid of %s was placed on %s.", price, item)
+	case base.NotificationListingExpiring:
+		return "Your listing is about to expire", fmt.Sprintf("Your listing of %s at %s is about to expire.", item, price)
+	case base.NotificationListingExpired:
+		return "Your listing expired", fmt.Sprintf("Your listing of %s at %s has expired.", item, price)
+	case base.NotificationFloorDrop:
+		return "Floor price alert", fmt.Sprintf("The floor price of %s dropped to %s.", n.CollectionAddress, price)
+	}
+	return "EasySwap notification", fmt.Sprintf("%s: %s", n.Kind, item)
+}
@@ -0,0 +1,211 @@
+package notify
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+const (
+	DefaultBatchSize   = 100 // 默认每次投递的记录数
+	DefaultMaxAttempts = 8   // 默认最大尝试次数
+
+	minBackoff = time.Minute
+	maxBackoff = 6 * time.Hour
+	// claimLease 领取投递记录后的租约, 多个 Sync 实例共用投递表, 租约内其他实例不会重复投递
+	claimLease    = 5 * time.Minute
+	maxLastErrLen = 512
+)
+
+// Dispatcher 投递待处理的通知, 失败后按指数退避重试, 重试次数用尽后标记为失败
+type Dispatcher struct {
+	db          *gorm.DB
+	channels    map[string]Channel
+	batchSize   int
+	maxAttempts int
+}
+
+// NewDispatcher 创建通知投递器, batchSize 及 maxAttempts 不大于 0 时使用默认值
+func NewDispatcher(db *gorm.DB, batchSize, maxAttempts int, channels ...Channel) *Dispatcher {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultMaxAttempts
+	}
+	d := &Dispatcher{db: db, channels: make(map[string]Channel), batchSize: batchSize, maxAttempts: maxAttempts}
+	for _, channel := range channels {
+		d.channels[channel.Name()] = channel
+	}
+	return d
+}
+
+// Backoff 第 attempts 次失败后的重试间隔: 1 分钟起指数增长, 最长 6 小时
+func Backoff(attempts int) time.Duration {
+	backoff := minBackoff
+	for i := 1; i < attempts && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// DispatchOnce 投递一批到期的投递记录, 返回处理的记录数
+func (d *Dispatcher) DispatchOnce(ctx context.Context) (int, error) {
+	now := time.Now().Unix()
+	var deliveries []base.NotificationDelivery
+	if err := d.db.WithContext(ctx).Table(base.NotificationDeliveryTableName()).
+		Where("status = ? and next_attempt_time <= ?", base.DeliveryStatusPending, now).
+		Order("id asc").
+		Limit(d.batchSize).
+		Find(&deliveries).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on query pending deliveries")
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	notifications, prefs, err := d.load(ctx, deliveries)
+	if err != nil {
+		return 0, err
+	}
+
+	var processed int
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			break
+		}
+		delivery := &deliveries[i]
+		claimed, err := d.claim(ctx, delivery, now)
+		if err != nil {
+			return processed, err
+		}
+		if !claimed {
+			continue
+		}
+		processed++
+		if err := d.deliver(ctx, delivery, notifications[delivery.NotificationId], prefs[delivery.UserAddress]); err != nil {
+			return processed, err
+		}
+	}
+	return processed, nil
+}
+
+// load 批量加载投递记录对应的通知及用户偏好
+func (d *Dispatcher) load(ctx context.Context, deliveries []base.NotificationDelivery) (map[int64]*base.Notification, map[string]*base.NotificationPreference, error) {
+	var ids []int64
+	var users []string
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.NotificationId)
+		users = append(users, delivery.UserAddress)
+	}
+
+	var notifications []base.Notification
+	if err := d.db.WithContext(ctx).Table(base.NotificationTableName()).
+		Where("id in (?)", ids).
+		Find(&notifications).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed on query notifications")
+	}
+	var prefs []base.NotificationPreference
+	if err := d.db.WithContext(ctx).Table(base.NotificationPreferenceTableName()).
+		Where("user_address in (?)", users).
+		Find(&prefs).Error; err != nil {
+		return nil, nil, errors.Wrap(err, "failed on query notification preferences")
+	}
+
+	notificationMap := make(map[int64]*base.Notification, len(notifications))
+	for i := range notifications {
+		notificationMap[notifications[i].Id] = &notifications[i]
+	}
+	prefMap := make(map[string]*base.NotificationPreference, len(prefs))
+	for i := range prefs {
+		prefMap[prefs[i].UserAddress] = &prefs[i]
+	}
+	return notificationMap, prefMap, nil
+}
+
+// claim 以租约领取投递记录, 已被其他实例领取时返回 false
+func (d *Dispatcher) claim(ctx context.Context, delivery *base.NotificationDelivery, now int64) (bool, error) {
+	result := d.db.WithContext(ctx).Table(base.NotificationDeliveryTableName()).
+		Where("id = ? and status = ? and next_attempt_time = ?", delivery.Id, base.DeliveryStatusPending, delivery.NextAttemptTime).
+		Update("next_attempt_time", now+int64(claimLease/time.Second))
+	if result.Error != nil {
+		return false, errors.Wrap(result.Error, "failed on claim delivery")
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// deliver 投递单条记录并记录结果
+func (d *Dispatcher) deliver(ctx context.Context, delivery *base.NotificationDelivery, n *base.Notification, pref *base.NotificationPreference) error {
+	var sendErr error
+	channel, ok := d.channels[delivery.Channel]
+	switch {
+	case !ok:
+		sendErr = errors.Errorf("unsupported channel %s", delivery.Channel)
+	case n == nil:
+		sendErr = errors.New("notification not found")
+	case pref == nil || !channelEnabled(pref, delivery.Channel):
+		sendErr = errors.New("channel disabled by user")
+	default:
+		sendErr = channel.Send(ctx, pref, n)
+	}
+
+	delivery.Attempts++
+	updates := map[string]interface{}{"attempts": delivery.Attempts}
+	status := "sent"
+	switch {
+	case sendErr == nil:
+		updates["status"] = base.DeliveryStatusSent
+		updates["last_error"] = ""
+	case !ok || n == nil || pref == nil || !channelEnabled(pref, delivery.Channel) || delivery.Attempts >= d.maxAttempts:
+		// 无法投递的记录不再重试
+		status = "failed"
+		updates["status"] = base.DeliveryStatusFailed
+		updates["last_error"] = truncate(sendErr.Error(), maxLastErrLen)
+	default:
+		status = "retry"
+		updates["next_attempt_time"] = time.Now().Add(Backoff(delivery.Attempts)).Unix()
+		updates["last_error"] = truncate(sendErr.Error(), maxLastErrLen)
+	}
+	metrics.NotificationDeliveries.WithLabelValues(delivery.Channel, status).Inc()
+	if sendErr != nil {
+		xzap.WithContext(ctx).Warn("failed on deliver notification",
+			zap.Int64("delivery_id", delivery.Id),
+			zap.String("channel", delivery.Channel),
+			zap.Int("attempts", delivery.Attempts),
+			zap.Error(sendErr))
+	}
+
+	if err := d.db.WithContext(ctx).Table(base.NotificationDeliveryTableName()).
+		Where("id = ?", delivery.Id).
+		Updates(updates).Error; err != nil {
+		return errors.Wrap(err, "failed on update delivery")
+	}
+	return nil
+}
+
+func channelEnabled(pref *base.NotificationPreference, channel string) bool {
+	switch channel {
+	case base.NotificationChannelWebhook:
+		return pref.WebhookEnabled && pref.WebhookURL != ""
+	case base.NotificationChannelEmail:
+		return pref.EmailEnabled && pref.Email != ""
+	}
+	return false
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
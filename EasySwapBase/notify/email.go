@@ -0,0 +1,67 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+// SMTPConfig 邮件服务器配置
+type SMTPConfig struct {
+	Host     string `toml:"host" mapstructure:"host" json:"host"`
+	Port     int    `toml:"port" mapstructure:"port" json:"port"`
+	Username string `toml:"username" mapstructure:"username" json:"username"`
+	Password string `toml:"password" mapstructure:"password" json:"password"`
+	From     string `toml:"from" mapstructure:"from" json:"from"` // 发件人地址
+}
+
+// SendMailFunc 与 smtp.SendMail 签名一致, 便于替换
+type SendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// EmailChannel 通过 SMTP 发送纯文本邮件
+type EmailChannel struct {
+	cfg      SMTPConfig
+	sendMail SendMailFunc
+}
+
+// NewEmailChannel 创建邮件渠道
+func NewEmailChannel(cfg SMTPConfig) *EmailChannel {
+	return &EmailChannel{cfg: cfg, sendMail: smtp.SendMail}
+}
+
+func (e *EmailChannel) Name() string {
+	return base.NotificationChannelEmail
+}
+
+// Send 发送通知邮件到用户配置的邮箱
+func (e *EmailChannel) Send(ctx context.Context, pref *base.NotificationPreference, n *base.Notification) error {
+	var auth smtp.Auth
+	if e.cfg.Username != "" {
+		auth = smtp.PlainAuth("", e.cfg.Username, e.cfg.Password, e.cfg.Host)
+	}
+	addr := net.JoinHostPort(e.cfg.Host, strconv.Itoa(e.cfg.Port))
+	if err := e.sendMail(addr, auth, e.cfg.From, []string{pref.Email}, e.message(pref.Email, n)); err != nil {
+		return errors.Wrap(err, "failed on send mail")
+	}
+	return nil
+}
+
+func (e *EmailChannel) message(to string, n *base.Notification) []byte {
+	subject, body := Render(n)
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", e.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: %s\r\n", subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(body)
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,100 @@
+// Package notify 用户通知: 保存站内通知, 并按用户偏好生成 webhook / 邮件投递记录, 由 Dispatcher 异步投递及重试
+package notify
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+// Publish 保存通知并生成外部渠道投递记录
+// 用户屏蔽该类型时不保存; EventKey 已存在 (重复事件) 时不做任何处理, 返回 false
+func Publish(ctx context.Context, db *gorm.DB, n *base.Notification) (bool, error) {
+	n.UserAddress = strings.ToLower(n.UserAddress)
+	n.CollectionAddress = strings.ToLower(n.CollectionAddress)
+
+	pref, err := QueryPreference(ctx, db, n.UserAddress)
+	if err != nil {
+		return false, err
+	}
+	if pref != nil && Muted(pref, n.Kind) {
+		return false, nil
+	}
+
+	var published bool
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(base.NotificationTableName()).Clauses(clause.OnConflict{DoNothing: true}).Create(n)
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed on create notification")
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		published = true
+
+		deliveries := Deliveries(pref, n)
+		if len(deliveries) == 0 {
+			return nil
+		}
+		if err := tx.Table(base.NotificationDeliveryTableName()).Create(&deliveries).Error; err != nil {
+			return errors.Wrap(err, "failed on create notification deliveries")
+		}
+		return nil
+	})
+	return published, err
+}
+
+// QueryPreference 查询用户通知偏好, 没有设置时返回 nil
+func QueryPreference(ctx context.Context, db *gorm.DB, userAddr string) (*base.NotificationPreference, error) {
+	var prefs []base.NotificationPreference
+	if err := db.WithContext(ctx).Table(base.NotificationPreferenceTableName()).
+		Where("user_address = ?", strings.ToLower(userAddr)).
+		Limit(1).
+		Find(&prefs).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query notification preference")
+	}
+	if len(prefs) == 0 {
+		return nil, nil
+	}
+	return &prefs[0], nil
+}
+
+// Muted 用户是否屏蔽该类型的通知
+func Muted(pref *base.NotificationPreference, kind string) bool {
+	for _, muted := range strings.Split(pref.MutedKinds, ",") {
+		if strings.TrimSpace(muted) == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Deliveries 按用户偏好生成通知的外部渠道投递记录
+func Deliveries(pref *base.NotificationPreference, n *base.Notification) []base.NotificationDelivery {
+	if pref == nil {
+		return nil
+	}
+	var channels []string
+	if pref.WebhookEnabled && pref.WebhookURL != "" {
+		channels = append(channels, base.NotificationChannelWebhook)
+	}
+	if pref.EmailEnabled && pref.Email != "" {
+		channels = append(channels, base.NotificationChannelEmail)
+	}
+
+	deliveries := make([]base.NotificationDelivery, 0, len(channels))
+	for _, channel := range channels {
+		deliveries = append(deliveries, base.NotificationDelivery{
+			NotificationId: n.Id,
+			UserAddress:    n.UserAddress,
+			Channel:        channel,
+			Status:         base.DeliveryStatusPending,
+		})
+	}
+	return deliveries
+}
@@ -0,0 +1,149 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Minute, Backoff(1))
+	assert.Equal(t, 2*time.Minute, Backoff(2))
+	assert.Equal(t, 8*time.Minute, Backoff(4))
+	assert.Equal(t, 6*time.Hour, Backoff(20))
+}
+
+func TestMuted(t *testing.T) {
+	pref := &base.NotificationPreference{MutedKinds: "outbid, floor_drop"}
+	assert.True(t, Muted(pref, base.NotificationOutbid))
+	assert.True(t, Muted(pref, base.NotificationFloorDrop))
+	assert.False(t, Muted(pref, base.NotificationItemSold))
+}
+
+func TestDeliveries(t *testing.T) {
+	n := &base.Notification{Id: 7, UserAddress: "0xabc"}
+	assert.Empty(t, Deliveries(nil, n))
+
+	pref := &base.NotificationPreference{WebhookEnabled: true, WebhookURL: "https://example.com/hook", EmailEnabled: true}
+	deliveries := Deliveries(pref, n)
+	if assert.Len(t, deliveries, 1) {
+		assert.Equal(t, base.NotificationChannelWebhook, deliveries[0].Channel)
+		assert.Equal(t, int64(7), deliveries[0].NotificationId)
+		assert.Equal(t, base.DeliveryStatusPending, deliveries[0].Status)
+	}
+}
+
+func TestWebhookSend(t *testing.T) {
+	const secret = "s3cret"
+	var status = http.StatusOK
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if Sign(secret, r.Header.Get(HeaderTimestamp), body) != r.Header.Get(HeaderSignature) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	// 测试服务监听在回环地址, 放开目标 IP 校验并信任其证书
+	channel := newWebhookChannel(time.Second, func(net.IP) bool { return true })
+	channel.client.Transport.(*http.Transport).TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+	n := &base.Notification{Kind: base.NotificationItemSold, Price: decimal.New(1, 18)}
+	pref := &base.NotificationPreference{WebhookURL: server.URL, WebhookSecret: secret}
+	assert.NoError(t, channel.Send(context.Background(), pref, n))
+
+	pref.WebhookSecret = "wrong"
+	assert.Error(t, channel.Send(context.Background(), pref, n))
+
+	pref.WebhookSecret = secret
+	status = http.StatusInternalServerError
+	assert.Error(t, channel.Send(context.Background(), pref, n))
+}
+
+func TestWebhookRejectsPrivateAddress(t *testing.T) {
+	var called bool
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	n := &base.Notification{Kind: base.NotificationItemSold}
+	channel := NewWebhookChannel(time.Second)
+
+	// 连接时校验解析后的 IP, 域名指向回环地址同样被拒绝
+	port := server.Listener.Addr().(*net.TCPAddr).Port
+	for _, raw := range []string{server.URL, fmt.Sprintf("https://localhost:%d", port)} {
+		err := channel.Send(context.Background(), &base.NotificationPreference{WebhookURL: raw}, n)
+		assert.ErrorIs(t, err, ErrForbiddenWebhookAddress, raw)
+	}
+	err := channel.Send(context.Background(), &base.NotificationPreference{WebhookURL: "http://example.com/hook"}, n)
+	assert.Error(t, err)
+	assert.False(t, called)
+}
+
+func TestWebhookDoesNotFollowRedirect(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "https://169.254.169.254/latest/meta-data", http.StatusFound)
+	}))
+	defer server.Close()
+
+	channel := newWebhookChannel(time.Second, func(net.IP) bool { return true })
+	channel.client.Transport.(*http.Transport).TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+	err := channel.Send(context.Background(), &base.NotificationPreference{WebhookURL: server.URL}, &base.Notification{})
+	assert.EqualError(t, err, "webhook responded with status 302")
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	for raw, ok := range map[string]bool{
+		"https://example.com/hook":          true,
+		"https://93.184.216.34/hook":        true,
+		"http://example.com/hook":           false,
+		"ftp://example.com/hook":            false,
+		"https:///hook":                     false,
+		"https://localhost/hook":            false,
+		"https://api.localhost./hook":       false,
+		"https://127.0.0.1/hook":            false,
+		"https://10.0.0.8/hook":             false,
+		"https://192.168.1.1/hook":          false,
+		"https://169.254.169.254/latest":    false,
+		"https://100.64.0.1/hook":           false,
+		"https://[::1]/hook":                false,
+		"https://[fd00::1]/hook":            false,
+		"https://[fe80::1]/hook":            false,
+		"https://[::ffff:127.0.0.1]/hook":   false,
+		"https://[2606:4700:4700::1111]/hk": true,
+	} {
+		err := ValidateWebhookURL(raw)
+		assert.Equal(t, ok, err == nil, "%s: %v", raw, err)
+	}
+}
+
+func TestEmailSend(t *testing.T) {
+	var gotTo []string
+	var gotMsg string
+	channel := NewEmailChannel(SMTPConfig{Host: "smtp.example.com", Port: 587, From: "noreply@example.com"})
+	channel.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		assert.Equal(t, "smtp.example.com:587", addr)
+		gotTo, gotMsg = to, string(msg)
+		return nil
+	}
+
+	n := &base.Notification{Kind: base.NotificationItemSold, CollectionAddress: "0xc", TokenId: "1", Price: decimal.New(15, 17)}
+	assert.NoError(t, channel.Send(context.Background(), &base.NotificationPreference{Email: "user@example.com"}, n))
+	assert.Equal(t, []string{"user@example.com"}, gotTo)
+	assert.True(t, strings.Contains(gotMsg, "Subject: Your item sold\r\n"))
+	assert.True(t, strings.Contains(gotMsg, "0xc #1 sold for 1.5 ETH."))
+}
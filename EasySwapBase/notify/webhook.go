@@ -0,0 +1,191 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+)
+
+// webhook 请求头
+const (
+	HeaderTimestamp = "X-EasySwap-Timestamp"
+	HeaderSignature = "X-EasySwap-Signature"
+)
+
+const defaultWebhookTimeout = 10 * time.Second
+
+// ErrForbiddenWebhookAddress webhook 地址指向内网、回环或链路本地地址
+var ErrForbiddenWebhookAddress = errors.New("webhook address is not public")
+
+// 除 net.IP 内置判断外需要拒绝的保留网段
+var reservedNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{
+		"0.0.0.0/8",     // 本网络
+		"100.64.0.0/10", // 运营商级 NAT
+		"192.0.0.0/24",  // IETF 协议分配
+		"198.18.0.0/15", // 基准测试
+		"64:ff9b::/96",  // NAT64, 可映射到内网 IPv4
+	} {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}()
+
+// WebhookChannel 以 JSON POST 投递通知, 请求带 HMAC-SHA256 签名, 非 2xx 响应视为失败
+// 仅投递到 https 公网地址: 建立连接时校验解析后的 IP, 防止通过 DNS 重绑定访问内网; 不跟随重定向
+type WebhookChannel struct {
+	client *http.Client
+}
+
+// NewWebhookChannel 创建 webhook 渠道, timeout 为 0 时使用默认超时
+func NewWebhookChannel(timeout time.Duration) *WebhookChannel {
+	return newWebhookChannel(timeout, publicIP)
+}
+
+// newWebhookChannel allow 判断连接的目标 IP 是否允许访问
+func newWebhookChannel(timeout time.Duration, allow func(net.IP) bool) *WebhookChannel {
+	if timeout <= 0 {
+		timeout = defaultWebhookTimeout
+	}
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Control 在 DNS 解析之后、建立连接之前调用, address 为实际连接的 IP
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !allow(ip) {
+				return ErrForbiddenWebhookAddress
+			}
+			return nil
+		},
+	}
+	transport := &http.Transport{
+		Proxy:               nil, // 经代理转发时无法校验目标 IP
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: timeout,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	return &WebhookChannel{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// ValidateWebhookURL 校验 webhook 地址: 需为 https 绝对地址, 主机不能是 localhost 或非公网 IP
+// 域名解析结果在每次投递建立连接时校验
+func ValidateWebhookURL(raw string) error {
+	u, err := parseHTTPSURL(raw)
+	if err != nil {
+		return err
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "" {
+		return errors.New("webhook url host is required")
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrForbiddenWebhookAddress
+	}
+	if ip := net.ParseIP(host); ip != nil && !publicIP(ip) {
+		return ErrForbiddenWebhookAddress
+	}
+	return nil
+}
+
+// parseHTTPSURL 解析 webhook 地址, 仅允许 https
+func parseHTTPSURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on parse webhook url")
+	}
+	if u.Scheme != "https" {
+		return nil, errors.New("webhook url must use https")
+	}
+	return u, nil
+}
+
+// publicIP 判断是否为公网单播地址
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, ipNet := range reservedNets {
+		if ipNet.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func (w *WebhookChannel) Name() string {
+	return base.NotificationChannelWebhook
+}
+
+// Send 投递通知到用户配置的 webhook 地址
+func (w *WebhookChannel) Send(ctx context.Context, pref *base.NotificationPreference, n *base.Notification) error {
+	// 目标 IP 在建立连接时校验
+	if _, err := parseHTTPSURL(pref.WebhookURL); err != nil {
+		return err
+	}
+	body, err := json.Marshal(n)
+	if err != nil {
+		return errors.Wrap(err, "failed on marshal notification")
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pref.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "failed on create webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(pref.WebhookSecret, timestamp, body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed on post webhook")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign webhook 签名: hex(HMAC-SHA256(secret, timestamp + "." + body)), 接收方按同样方式校验
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,6 +1,7 @@
 package ordermanager
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,31 +11,30 @@ import (
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
 
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
 )
 
 // orderExpiryProcess 函数负责处理订单过期的逻辑,主要包含以下功能:
-// 1. 使用defer recover防止panic导致主协程退出
-// 2. 启动时从数据库加载所有活跃订单到时间轮队列
+// 1. 启动 (包括 panic 或加载失败后被 supervisor 重启) 时清空时间轮
+// 2. 从数据库加载所有活跃订单到时间轮队列
 // 3. 每秒检查一次时间轮,处理到期的订单
-func (om *OrderManager) orderExpiryProcess() {
-	// 1. 使用 defer recover 来捕获可能的 panic,防止主协程死掉
-	defer func() {
-		if r := recover(); r != nil {
-			xzap.WithContext(om.Ctx).Error("[Order Manage] dq process recovered: " + fmt.Sprintf("%v", r))
-		}
-	}()
+func (om *OrderManager) orderExpiryProcess(ctx context.Context) error {
+	// 1. 清空时间轮, 避免重启后重复加载订单
+	om.resetTimeWheel()
 
 	// 2. 启动时从数据库加载所有活跃订单到时间轮队列中
 	if err := om.loadOrdersToQueue(); err != nil {
-		xzap.WithContext(om.Ctx).Error("[Order Manage] load orders to queue", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on load orders to queue")
 	}
 
 	// 3. 每秒检查一次时间轮
 	for {
 		select {
+		case <-ctx.Done():
+			return nil
 		case <-time.After(time.Second * 1): // 每秒执行一次检查
+			om.expiryHeartbeat.Beat()
 			// 如果当前索引超过时间轮大小,则取模重置
 			if om.CurrentIndex >= WheelSize {
 				om.CurrentIndex = om.CurrentIndex % WheelSize
@@ -53,14 +53,16 @@ func (om *OrderManager) orderExpiryProcess() {
 			for p != nil {
 				// 如果任务的循环计数为0,说明到期需要处理
 				if p.CycleCount == 0 {
-					// 异步更新订单状态,避免阻塞主循环
-					go func(chain string, orderId string, collectionAddr string) {
+					// 异步更新订单状态,避免阻塞主循环; 退出时等待其完成
+					chain, orderId, collectionAddr := p.ChainSuffix, p.orderID, p.CollectionAddr
+					om.supervisor.Task("update_expired_order", func() {
 						if err := om.updateOrderState(orderId, collectionAddr); err != nil {
 							xzap.WithContext(om.Ctx).Error("failed on update order status", zap.Error(err), zap.String("chain", chain), zap.String("order_id", orderId))
 						}
-					}(p.ChainSuffix, p.orderID, p.CollectionAddr)
+					})
 
 					// 从链表中删除该节点
+					metrics.TimeWheelPending.WithLabelValues(om.chain).Dec()
 					if prev == p { // 如果是头节点
 						om.TimeWheel[headIndex].NotifyActivities = p.Next
 						prev = p.Next
@@ -80,6 +82,16 @@ func (om *OrderManager) orderExpiryProcess() {
 	}
 }
 
+// resetTimeWheel 清空时间轮
+func (om *OrderManager) resetTimeWheel() {
+	om.Mux.Lock()
+	defer om.Mux.Unlock()
+
+	om.TimeWheel = [WheelSize]wheel{}
+	om.CurrentIndex = 0
+	metrics.TimeWheelPending.WithLabelValues(om.chain).Set(0)
+}
+
 // loadOrdersToQueue 函数负责在系统启动时加载所有活跃订单并处理它们的过期状态
 // 主要功能包括:
 // 1. 从数据库分批加载所有活跃订单
@@ -191,6 +203,8 @@ func (om *OrderManager) addToOrderExpiryCheckQueue(delaySeconds int64, chainSuff
 		WheelPosition:  index,
 	}
 
+	metrics.TimeWheelPending.WithLabelValues(om.chain).Inc()
+
 	// 如果该位置为空,直接放入
 	if om.TimeWheel[index].NotifyActivities == nil {
 		om.TimeWheel[index].NotifyActivities = orderActivity
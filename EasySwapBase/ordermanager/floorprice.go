@@ -1,6 +1,7 @@
 package ordermanager
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -14,8 +15,11 @@ import (
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
 
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/rarity"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
 	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
 )
 
 type EventType int
@@ -55,28 +59,37 @@ type TradeEvent struct {
 	TxHash         string          `json:"txHash"`
 }
 
-func (om *OrderManager) floorPriceProcess() {
-	// 清空缓存中的剩余事件
-	key := genTradeEventsCacheKey(om.chain)
-	if err := om.Xkv.Redis.Ltrim(key, 1, 0); err != nil { // clear all value
-		xzap.WithContext(om.Ctx).Error("failed on flush remaining trade events", zap.Error(err))
-	}
+// floorPriceProcess 加载订单后持续消费交易事件队列
+// 异常退出后由 supervisor 重启, 队列中尚未消费的事件保留: 事件按产生顺序在重新加载的状态上重放,
+// 上架/取消/成交的处理均可重复执行, 而挂单数量刷新等只能由事件触发, 清空队列会丢失这部分更新
+func (om *OrderManager) floorPriceProcess(ctx context.Context) error {
+	key := GenTradeEventsCacheKey(om.chain)
 
 	// 从数据库加载订单并更新地板价
 	if err := om.loadCollectionTradeInfo(); err != nil {
-		xzap.WithContext(om.Ctx).Error("[Order Manage] load orders to queue", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on load collection trade info")
+	}
+
+	// 重新计算属性地板价
+	if err := om.loadTraitFloorPrice(); err != nil {
+		xzap.WithContext(om.Ctx).Error("[Order Manage] load trait floor price", zap.Error(err))
 	}
 
 	// 持续监听并处理交易事件
 	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
 		// 从缓存中获取交易事件
 		result, err := om.Xkv.Lpop(key)
 		if err != nil || result == "" {
 			if err != nil && err != redis.Nil {
 				xzap.WithContext(om.Ctx).Warn("failed on get trade events from cache", zap.Error(err), zap.String("result", result))
 			}
-			time.Sleep(1 * time.Second)
+			supervisor.Sleep(ctx, 1*time.Second)
 			continue
 		}
 
@@ -115,6 +128,8 @@ func (om *OrderManager) floorPriceProcess() {
 					zap.String("collection_addr", event.CollectionAddr), zap.String("floor_price", event.Price.String()),
 					zap.Error(err))
 			}
+			// 更新属性地板价
+			om.checkAndUpdateTraitFloorPrice(&event)
 
 		case Cancel, Expired: // 取消或过期事件
 			// 从队列中删除订单
@@ -135,6 +150,8 @@ func (om *OrderManager) floorPriceProcess() {
 					zap.String("collection_addr", event.CollectionAddr),
 					zap.Error(err))
 			}
+			// 更新属性地板价
+			om.checkAndUpdateTraitFloorPrice(&event)
 
 		case Buy, Transfer: // 购买或转移事件
 			// 如果是购买事件,从队列中删除订单
@@ -192,6 +209,8 @@ func (om *OrderManager) floorPriceProcess() {
 					zap.String("collection_addr", event.CollectionAddr), zap.String("floor_price", event.Price.String()),
 					zap.Error(err))
 			}
+			// 更新属性地板价
+			om.checkAndUpdateTraitFloorPrice(&event)
 
 		case ImportCollection: // 导入新的Collection事件
 			// 检查Collection是否已存在
@@ -207,6 +226,12 @@ func (om *OrderManager) floorPriceProcess() {
 				orders:     NewPriorityQueueMap(maxQueueLength),
 			}
 
+			// 新导入的集合需要计算稀有度
+			if err := rarity.AddCollectionToRefreshQueue(om.Xkv, om.chain, event.CollectionAddr); err != nil {
+				xzap.WithContext(om.Ctx).Error("failed on add collection to rarity refresh queue",
+					zap.String("collection_addr", event.CollectionAddr), zap.Error(err))
+			}
+
 		case UpdateCollection: // 更新Collection事件
 			// 检查地板价是否变化
 			_, floorPrice := tradeInfo.orders.GetMin()
@@ -227,6 +252,11 @@ func (om *OrderManager) floorPriceProcess() {
 					zap.String("collection_addr", event.CollectionAddr), zap.String("floor_price", event.Price.String()),
 					zap.Error(err))
 			}
+			// 地板价变化时重新计算集合所有属性的地板价
+			if err := om.refreshCollectionTraitFloorPrice(event.CollectionAddr); err != nil {
+				xzap.WithContext(om.Ctx).Error("failed on refresh collection trait floor price",
+					zap.String("collection_addr", event.CollectionAddr), zap.Error(err))
+			}
 
 		default:
 			xzap.WithContext(om.Ctx).Error("unsupported event type", zap.String("event content", result))
@@ -308,6 +338,7 @@ func (om *OrderManager) updateFloorPrice(collectionAddr string, price decimal.De
 		Where("address=?", collectionAddr).Update("floor_price", price).Error; err != nil {
 		return errors.Wrap(err, "failed on update collection floor price")
 	}
+	metrics.FloorPriceUpdates.WithLabelValues(om.chain).Inc()
 	return nil
 }
 
@@ -475,7 +506,7 @@ func (om *OrderManager) addUpdateFloorPriceEvent(event *TradeEvent) error {
 	}
 
 	// 获取Redis队列key
-	key := genTradeEventsCacheKey(om.chain)
+	key := GenTradeEventsCacheKey(om.chain)
 	// 将事件添加到Redis队列
 	if _, err := om.Xkv.Rpush(key, string(rawEvent)); err != nil {
 		return errors.Wrap(err, "failed on push trade event to queue")
@@ -483,7 +514,7 @@ func (om *OrderManager) addUpdateFloorPriceEvent(event *TradeEvent) error {
 	return nil
 }
 
-func genTradeEventsCacheKey(chain string) string {
+func GenTradeEventsCacheKey(chain string) string {
 	return fmt.Sprintf(CacheTradeEventsQueuePre, chain)
 }
 
@@ -522,7 +553,7 @@ func AddUpdatePriceEvent(kv *xkv.Store, event *TradeEvent, chain string) error {
 	}
 
 	// 获取Redis队列key
-	key := genTradeEventsCacheKey(chain)
+	key := GenTradeEventsCacheKey(chain)
 	// 将事件添加到Redis队列
 	if _, err := kv.Rpush(key, string(rawEvent)); err != nil {
 		return errors.Wrap(err, "failed on push trade event to queue")
@@ -1,6 +1,7 @@
 package ordermanager
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -27,7 +28,7 @@ func GenCollectionListedKey(chain, address string) string {
 // 1. 启动时统计所有集合的上架数量
 // 2. 定时(每分钟)更新有变动的集合的上架数量
 // 3. 实时接收集合状态变更通知并记录需要更新的集合
-func (om *OrderManager) listCountProcess() {
+func (om *OrderManager) listCountProcess(ctx context.Context) error {
 	// 启动时重新统计所有的collection list数量
 	collectionsListed, err := om.countCollectionListed([]string{})
 	if err != nil {
@@ -52,6 +53,7 @@ func (om *OrderManager) listCountProcess() {
 	for {
 		select {
 		case <-ticker.C: // 定时器触发
+			om.listCountHeartbeat.Beat()
 			// 将map中记录的集合地址转换为切片
 			var cs []string
 			for c := range collections {
@@ -76,10 +78,9 @@ func (om *OrderManager) listCountProcess() {
 			}
 		case addr := <-om.collectionListedCh: // 接收到集合状态变更通知
 			collections[strings.ToLower(addr)] = true // 记录需要更新的集合地址
-		case <-om.Ctx.Done(): // 上下文取消时退出
-			xzap.WithContext(om.Ctx).Info("collection list count process exit",
-				zap.Error(err))
-			return
+		case <-ctx.Done(): // 上下文取消时退出
+			xzap.WithContext(om.Ctx).Info("collection list count process exit")
+			return nil
 		}
 	}
 }
@@ -10,13 +10,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/zeromicro/go-zero/core/stores/redis"
-	"github.com/zeromicro/go-zero/core/threading"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"github.com/ProjectsTask/EasySwapBase/health"
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
 	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
 )
 
 const (
@@ -24,6 +25,11 @@ const (
 	WheelSize           = 3600
 	List                = 3
 	CacheOrdersQueuePre = "cache:es:orders:%s"
+
+	// ExpiryHeartbeatMaxAge 时间轮每秒推进一次, 超过该时长未推进视为卡住
+	ExpiryHeartbeatMaxAge = time.Minute
+	// ListCountHeartbeatMaxAge 上架数量每分钟统计一次
+	ListCountHeartbeatMaxAge = 3 * time.Minute
 )
 
 func GenOrdersCacheKey(chain string) string {
@@ -67,6 +73,10 @@ type OrderManager struct {
 	DB  *gorm.DB
 	Ctx context.Context
 	Mux *sync.RWMutex
+
+	supervisor         *supervisor.Supervisor
+	expiryHeartbeat    *health.Heartbeat // 订单过期时间轮心跳
+	listCountHeartbeat *health.Heartbeat // 上架数量统计心跳
 }
 
 // NewDelayQueue : create func instance entrance
@@ -80,18 +90,24 @@ func New(ctx context.Context, db *gorm.DB, xkv *xkv.Store, chain string, project
 		collectionOrders:   make(map[string]*collectionTradeInfo),
 		collectionListedCh: make(chan string, 1000),
 		project:            project,
+		supervisor:         supervisor.New(ctx),
+		expiryHeartbeat:    health.NewHeartbeat("order_expiry_wheel", ExpiryHeartbeatMaxAge),
+		listCountHeartbeat: health.NewHeartbeat("collection_list_count", ListCountHeartbeatMaxAge),
 	}
 }
 
+// Start 启动后台循环, 循环异常退出后由 supervisor 退避重启
 func (om *OrderManager) Start() {
 	// listen redis cache
-	threading.GoSafe(om.ListenNewListingLoop) // 处理新订单
-	threading.GoSafe(om.orderExpiryProcess)   // 处理订单过期状态
-	threading.GoSafe(om.floorPriceProcess)    // 处理floorprice更新
-	threading.GoSafe(om.listCountProcess)     // 处理listCount更新
+	om.supervisor.Go("new_listing", om.ListenNewListingLoop)       // 处理新订单
+	om.supervisor.Go("order_expiry_wheel", om.orderExpiryProcess)  // 处理订单过期状态
+	om.supervisor.Go("floor_price", om.floorPriceProcess)          // 处理floorprice更新
+	om.supervisor.Go("collection_list_count", om.listCountProcess) // 处理listCount更新
 }
 
-func (om *OrderManager) Stop() {
+// Stop 停止所有后台循环, 等待正在处理的订单及过期状态更新完成, ctx 到期时返回错误
+func (om *OrderManager) Stop(ctx context.Context) error {
+	return om.supervisor.Shutdown(ctx)
 }
 
 type ListingInfo struct {
@@ -103,15 +119,21 @@ type ListingInfo struct {
 	Maker          string          `json:"maker"`
 }
 
-func (om *OrderManager) ListenNewListingLoop() {
+func (om *OrderManager) ListenNewListingLoop(ctx context.Context) error {
 	key := GenOrdersCacheKey(om.chain)
 	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
 		result, err := om.Xkv.Lpop(key)
 		if err != nil || result == "" {
 			if err != nil && err != redis.Nil {
 				xzap.WithContext(context.Background()).Warn("failed on get order from cache", zap.Error(err), zap.String("result", result))
 			}
-			time.Sleep(1 * time.Second)
+			supervisor.Sleep(ctx, 1*time.Second)
 			continue
 		}
 
@@ -171,6 +193,12 @@ func (om *OrderManager) ListenNewListingLoop() {
 }
 
 func (om *OrderManager) AddToOrderManagerQueue(order *multi.Order) error {
+	return AddOrderToManagerQueue(om.Xkv, om.chain, order)
+}
+
+// AddOrderToManagerQueue 将订单加入 OrderManager 队列
+// 供不持有 OrderManager 实例的服务(如 backend 接收的链下签名订单)使用
+func AddOrderToManagerQueue(kv *xkv.Store, chain string, order *multi.Order) error {
 	if order.TokenId == "" {
 		return errors.New("order manger need token id")
 	}
@@ -186,7 +214,7 @@ func (om *OrderManager) AddToOrderManagerQueue(order *multi.Order) error {
 		return errors.Wrap(err, "failed on marshal listing info")
 	}
 
-	if _, err := om.Xkv.Lpush(GenOrdersCacheKey(om.chain), string(rawInfo)); err != nil {
+	if _, err := kv.Lpush(GenOrdersCacheKey(chain), string(rawInfo)); err != nil {
 		return errors.Wrap(err, "failed on add to queue")
 	}
 
@@ -0,0 +1,137 @@
+package ordermanager
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+)
+
+type traitKey struct {
+	Trait      string `json:"trait"`
+	TraitValue string `json:"trait_value"`
+}
+
+// loadTraitFloorPrice 启动时重新计算所有集合的属性地板价
+func (om *OrderManager) loadTraitFloorPrice() error {
+	var collections []string
+	if err := om.DB.WithContext(om.Ctx).Table(gdb.GetMultiProjectCollectionTableName(om.project, om.chain)).
+		Pluck("address", &collections).Error; err != nil {
+		return errors.Wrap(err, "failed on get collections")
+	}
+
+	for _, collection := range collections {
+		if err := om.refreshCollectionTraitFloorPrice(collection); err != nil {
+			return errors.Wrap(err, "failed on refresh collection trait floor price")
+		}
+	}
+	return nil
+}
+
+// refreshCollectionTraitFloorPrice 重新计算集合内所有属性的地板价
+func (om *OrderManager) refreshCollectionTraitFloorPrice(collectionAddr string) error {
+	var traits []traitKey
+	if err := om.DB.WithContext(om.Ctx).Table(gdb.GetMultiProjectItemTraitTableName(om.project, om.chain)).
+		Select("distinct trait, trait_value").
+		Where("collection_address = ?", collectionAddr).
+		Scan(&traits).Error; err != nil {
+		return errors.Wrap(err, "failed on get collection traits")
+	}
+
+	for i := 0; i < len(traits); i += MaxBatchReqNum {
+		end := i + MaxBatchReqNum
+		if end > len(traits) {
+			end = len(traits)
+		}
+		if err := om.updateTraitsFloorPrice(collectionAddr, traits[i:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateItemTraitsFloorPrice 更新指定 item 所拥有属性的地板价
+// item 的挂单、取消、过期、成交及转移都可能改变其属性的地板价
+func (om *OrderManager) updateItemTraitsFloorPrice(collectionAddr, tokenID string) error {
+	if tokenID == "" {
+		return nil
+	}
+
+	var traits []traitKey
+	if err := om.DB.WithContext(om.Ctx).Table(gdb.GetMultiProjectItemTraitTableName(om.project, om.chain)).
+		Select("trait, trait_value").
+		Where("collection_address = ? and token_id = ?", collectionAddr, tokenID).
+		Scan(&traits).Error; err != nil {
+		return errors.Wrap(err, "failed on get item traits")
+	}
+
+	return om.updateTraitsFloorPrice(collectionAddr, traits)
+}
+
+// updateTraitsFloorPrice 计算并持久化指定属性的地板价
+// 地板价为拥有该属性的 item 中, 有效挂单(maker 为当前持有者且未过期)的最低价格, 无挂单时为 0
+func (om *OrderManager) updateTraitsFloorPrice(collectionAddr string, traits []traitKey) error {
+	if len(traits) == 0 {
+		return nil
+	}
+
+	var conditions [][]interface{}
+	for _, t := range traits {
+		conditions = append(conditions, []interface{}{t.Trait, t.TraitValue})
+	}
+
+	var floorPrices []multi.TraitFloorPrice
+	if err := om.DB.WithContext(om.Ctx).Table(fmt.Sprintf("%s as co", gdb.GetMultiProjectOrderTableName(om.project, om.chain))).
+		Select("cit.trait as trait, cit.trait_value as trait_value, min(co.price) as price").
+		Joins(fmt.Sprintf("join %s ci on co.collection_address = ci.collection_address and co.token_id = ci.token_id", gdb.GetMultiProjectItemTableName(om.project, om.chain))).
+		Joins(fmt.Sprintf("join %s cit on co.collection_address = cit.collection_address and co.token_id = cit.token_id", gdb.GetMultiProjectItemTraitTableName(om.project, om.chain))).
+		Where("co.collection_address = ? and co.order_type = ? and co.order_status = ? and co.expire_time > ? and co.maker = ci.owner",
+			collectionAddr, multi.ListingOrder, multi.OrderStatusActive, time.Now().Unix()).
+		Where("(cit.trait, cit.trait_value) in ?", conditions).
+		Group("cit.trait, cit.trait_value").
+		Scan(&floorPrices).Error; err != nil {
+		return errors.Wrap(err, "failed on query trait floor price")
+	}
+
+	prices := make(map[traitKey]decimal.Decimal, len(floorPrices))
+	for _, fp := range floorPrices {
+		prices[traitKey{Trait: fp.Trait, TraitValue: fp.TraitValue}] = fp.Price
+	}
+
+	now := time.Now().UnixMilli()
+	valueStrings := make([]string, 0, len(traits))
+	valueArgs := make([]interface{}, 0, len(traits)*6)
+	for _, t := range traits {
+		price, ok := prices[t]
+		if !ok {
+			price = decimal.Zero
+		}
+		valueStrings = append(valueStrings, "(?,?,?,?,?,?)")
+		valueArgs = append(valueArgs, strings.ToLower(collectionAddr), t.Trait, t.TraitValue, price, now, now)
+	}
+
+	stmt := fmt.Sprintf(`INSERT INTO %s (collection_address,trait,trait_value,price,create_time,update_time) VALUES %s
+		ON DUPLICATE KEY UPDATE price=VALUES(price),update_time=VALUES(update_time)`,
+		gdb.GetMultiProjectTraitFloorPriceTableName(om.project, om.chain), strings.Join(valueStrings, ","))
+	if err := om.DB.WithContext(om.Ctx).Exec(stmt, valueArgs...).Error; err != nil {
+		return errors.Wrap(err, "failed on persist trait floor price")
+	}
+	return nil
+}
+
+// checkAndUpdateTraitFloorPrice 根据交易事件更新相关 item 的属性地板价
+func (om *OrderManager) checkAndUpdateTraitFloorPrice(event *TradeEvent) {
+	if err := om.updateItemTraitsFloorPrice(event.CollectionAddr, event.TokenID); err != nil {
+		xzap.WithContext(om.Ctx).Error("failed on update trait floor price",
+			zap.Int("event_type", int(event.EventType)), zap.String("order_id", event.OrderId),
+			zap.String("collection_addr", event.CollectionAddr), zap.String("token_id", event.TokenID),
+			zap.Error(err))
+	}
+}
@@ -0,0 +1,91 @@
+package ordermanager
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+)
+
+var (
+	itemTraitsQuery  = regexp.QuoteMeta("SELECT trait, trait_value FROM `ob_item_trait_sepolia` WHERE collection_address = ? and token_id = ?")
+	traitFloorQuery  = regexp.QuoteMeta("SELECT cit.trait as trait, cit.trait_value as trait_value, min(co.price) as price FROM ob_order_sepolia as co")
+	traitFloorUpsert = regexp.QuoteMeta("INSERT INTO ob_trait_floor_price_sepolia (collection_address,trait,trait_value,price,create_time,update_time) VALUES (?,?,?,?,?,?),(?,?,?,?,?,?)")
+)
+
+func newMockOrderManager(t *testing.T) (*OrderManager, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	return New(context.Background(), db, nil, "sepolia", gdb.OrderBookDexProject), mock
+}
+
+func TestUpdateItemTraitsFloorPrice(t *testing.T) {
+	om, mock := newMockOrderManager(t)
+
+	mock.ExpectQuery(itemTraitsQuery).WithArgs("0xa", "1").
+		WillReturnRows(sqlmock.NewRows([]string{"trait", "trait_value"}).
+			AddRow("Background", "Red").
+			AddRow("Hat", "Cap"))
+	// 仅统计 maker 为持有者且未过期的有效挂单
+	mock.ExpectQuery(traitFloorQuery).
+		WithArgs("0xa", multi.ListingOrder, multi.OrderStatusActive, sqlmock.AnyArg(), "Background", "Red", "Hat", "Cap").
+		WillReturnRows(sqlmock.NewRows([]string{"trait", "trait_value", "price"}).
+			AddRow("Background", "Red", "100"))
+	// 没有有效挂单的属性地板价置为 0
+	mock.ExpectExec(traitFloorUpsert).
+		WithArgs("0xa", "Background", "Red", decimal.NewFromInt(100), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			"0xa", "Hat", "Cap", decimal.Zero, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	require.NoError(t, om.updateItemTraitsFloorPrice("0xa", "1"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateItemTraitsFloorPriceWithoutTraits(t *testing.T) {
+	om, mock := newMockOrderManager(t)
+
+	// 集合级事件 (token_id 为空) 不更新属性地板价
+	require.NoError(t, om.updateItemTraitsFloorPrice("0xa", ""))
+
+	// item 没有属性时不查询挂单
+	mock.ExpectQuery(itemTraitsQuery).WithArgs("0xa", "2").
+		WillReturnRows(sqlmock.NewRows([]string{"trait", "trait_value"}))
+	require.NoError(t, om.updateItemTraitsFloorPrice("0xa", "2"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRefreshCollectionTraitFloorPrice(t *testing.T) {
+	om, mock := newMockOrderManager(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT distinct trait, trait_value FROM `ob_item_trait_sepolia` WHERE collection_address = ?")).
+		WithArgs("0xa").
+		WillReturnRows(sqlmock.NewRows([]string{"trait", "trait_value"}).
+			AddRow("Background", "Red").
+			AddRow("Background", "Blue"))
+	mock.ExpectQuery(traitFloorQuery).
+		WithArgs("0xa", multi.ListingOrder, multi.OrderStatusActive, sqlmock.AnyArg(), "Background", "Red", "Background", "Blue").
+		WillReturnRows(sqlmock.NewRows([]string{"trait", "trait_value", "price"}).
+			AddRow("Background", "Red", "100").
+			AddRow("Background", "Blue", "80"))
+	mock.ExpectExec(traitFloorUpsert).
+		WithArgs("0xa", "Background", "Red", decimal.NewFromInt(100), sqlmock.AnyArg(), sqlmock.AnyArg(),
+			"0xa", "Background", "Blue", decimal.NewFromInt(80), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	require.NoError(t, om.refreshCollectionTraitFloorPrice("0xa"))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
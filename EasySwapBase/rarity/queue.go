@@ -0,0 +1,104 @@
+package rarity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+)
+
+// CacheRarityRefreshQueuePre 待重新计算稀有度的集合队列 (Redis Set)
+const CacheRarityRefreshQueuePre = "cache:es:rarity:refresh:%s"
+
+func GenRarityRefreshCacheKey(chain string) string {
+	return fmt.Sprintf(CacheRarityRefreshQueuePre, strings.ToLower(chain))
+}
+
+// AddCollectionToRefreshQueue 将集合加入稀有度重算队列
+// 集合导入或元数据刷新后调用, 由 sync 服务异步消费
+// 使用 Set 存储, 同一集合多次加入只会计算一次
+func AddCollectionToRefreshQueue(kv *xkv.Store, chain, collectionAddr string) error {
+	if collectionAddr == "" {
+		return errors.New("invalid rarity refresh. collection address is null")
+	}
+
+	if _, err := kv.Sadd(GenRarityRefreshCacheKey(chain), strings.ToLower(collectionAddr)); err != nil {
+		return errors.Wrap(err, "failed on push collection to rarity refresh queue")
+	}
+	return nil
+}
+
+// CacheRarityPendingMetadataPre 等待元数据刷新完成后再重算稀有度的 Item (Redis Hash)
+// field 为 collection:token_id, value 为请求刷新的时间 (毫秒)
+const CacheRarityPendingMetadataPre = "cache:es:rarity:pending:metadata:%s"
+
+// MetadataRefreshTimeout 等待元数据刷新完成的最长时间 (秒), 超时后直接重算稀有度
+const MetadataRefreshTimeout = 600
+
+func GenRarityPendingMetadataCacheKey(chain string) string {
+	return fmt.Sprintf(CacheRarityPendingMetadataPre, strings.ToLower(chain))
+}
+
+// PendingItem 等待元数据刷新完成的 Item
+type PendingItem struct {
+	CollectionAddr string
+	TokenId        string
+	RequestTime    int64 // 请求刷新的时间 (毫秒)
+}
+
+func pendingItemField(collectionAddr, tokenId string) string {
+	return strings.ToLower(collectionAddr) + ":" + tokenId
+}
+
+func parsePendingItem(field, value string) (PendingItem, bool) {
+	idx := strings.Index(field, ":")
+	if idx <= 0 || idx == len(field)-1 {
+		return PendingItem{}, false
+	}
+	requestTime, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return PendingItem{}, false
+	}
+	return PendingItem{CollectionAddr: field[:idx], TokenId: field[idx+1:], RequestTime: requestTime}, true
+}
+
+// AddItemPendingMetadataRefresh 记录 Item 的元数据刷新请求
+// 元数据异步刷新, sync 服务在刷新完成 (或超时) 后才将集合加入稀有度重算队列, 避免按旧属性计算
+func AddItemPendingMetadataRefresh(kv *xkv.Store, chain, collectionAddr, tokenId string, requestTime int64) error {
+	if collectionAddr == "" || tokenId == "" {
+		return errors.New("invalid pending metadata refresh. collection address or token id is null")
+	}
+
+	if err := kv.Hset(GenRarityPendingMetadataCacheKey(chain), pendingItemField(collectionAddr, tokenId),
+		strconv.FormatInt(requestTime, 10)); err != nil {
+		return errors.Wrap(err, "failed on add item to pending metadata refresh")
+	}
+	return nil
+}
+
+// ListPendingMetadataRefresh 查询等待元数据刷新完成的 Item
+func ListPendingMetadataRefresh(kv *xkv.Store, chain string) ([]PendingItem, error) {
+	fields, err := kv.Hgetall(GenRarityPendingMetadataCacheKey(chain))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get pending metadata refresh")
+	}
+
+	items := make([]PendingItem, 0, len(fields))
+	for field, value := range fields {
+		if item, ok := parsePendingItem(field, value); ok {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// RemovePendingMetadataRefresh 移除等待记录
+func RemovePendingMetadataRefresh(kv *xkv.Store, chain string, item PendingItem) error {
+	if _, err := kv.Hdel(GenRarityPendingMetadataCacheKey(chain), pendingItemField(item.CollectionAddr, item.TokenId)); err != nil {
+		return errors.Wrap(err, "failed on remove pending metadata refresh")
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package rarity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePendingItem(t *testing.T) {
+	item, ok := parsePendingItem(pendingItemField("0xABC", "12:3"), "1700000000000")
+	assert.True(t, ok)
+	assert.Equal(t, PendingItem{CollectionAddr: "0xabc", TokenId: "12:3", RequestTime: 1700000000000}, item)
+
+	for _, c := range []struct{ field, value string }{
+		{"0xabc", "1"},
+		{":1", "1"},
+		{"0xabc:", "1"},
+		{"0xabc:1", "bad"},
+	} {
+		_, ok := parsePendingItem(c.field, c.value)
+		assert.False(t, ok, c.field)
+	}
+}
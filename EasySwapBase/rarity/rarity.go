@@ -0,0 +1,127 @@
+package rarity
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// MissingTraitValue 某个 item 缺少集合中存在的属性时, 视为拥有该属性的 "None" 值参与统计
+const MissingTraitValue = "None"
+
+// scorePrecision 稀有度分数保留的小数位数
+const scorePrecision = 4
+
+type Trait struct {
+	Trait      string `json:"trait"`
+	TraitValue string `json:"trait_value"`
+}
+
+// Result 单个 item 的稀有度计算结果
+type Result struct {
+	TokenId         string          `json:"token_id"`
+	RarityScore     decimal.Decimal `json:"rarity_score"`      // 统计稀有度分数: sum(total / 属性值数量)
+	RarityRank      int64           `json:"rarity_rank"`       // 统计稀有度排名, 1 为最稀有
+	TraitCountScore decimal.Decimal `json:"trait_count_score"` // 属性个数稀有度分数: total / 相同属性个数的 item 数量
+	TraitCountRank  int64           `json:"trait_count_rank"`  // 属性个数稀有度排名, 1 为最稀有
+}
+
+// Calculate 计算集合内所有 item 的稀有度分数及排名
+// 主要功能:
+// 1. 统计每个 (属性名, 属性值) 出现的次数, 缺失的属性按 MissingTraitValue 计数
+// 2. 统计稀有度: 每个属性的稀有度为 total / 该属性值出现次数, item 分数为各属性稀有度之和
+// 3. 属性个数稀有度: total / 拥有相同属性个数的 item 数量
+// 4. 按分数降序排名, 分数相同的 item 排名相同 (1,2,2,4)
+// 参数说明:
+// - items: tokenId -> 属性列表
+func Calculate(items map[string][]Trait) []Result {
+	total := len(items)
+	if total == 0 {
+		return nil
+	}
+
+	// 1. 统计属性值出现次数及属性个数分布
+	valueCounts := make(map[string]map[string]int)
+	traitCountCounts := make(map[int]int)
+	itemTraits := make(map[string]map[string]string, total)
+	for tokenId, traits := range items {
+		values := make(map[string]string, len(traits))
+		for _, t := range traits {
+			if _, ok := values[t.Trait]; ok { // 同一属性重复出现时只统计一次
+				continue
+			}
+			values[t.Trait] = t.TraitValue
+			if _, ok := valueCounts[t.Trait]; !ok {
+				valueCounts[t.Trait] = make(map[string]int)
+			}
+			valueCounts[t.Trait][t.TraitValue]++
+		}
+		itemTraits[tokenId] = values
+		traitCountCounts[len(values)]++
+	}
+
+	// 缺失属性的 item 计入 "None"
+	for trait, counts := range valueCounts {
+		present := 0
+		for _, c := range counts {
+			present += c
+		}
+		if present < total {
+			counts[MissingTraitValue] += total - present
+		}
+		valueCounts[trait] = counts
+	}
+
+	// 2. 计算分数
+	results := make([]Result, 0, total)
+	for tokenId, values := range itemTraits {
+		var score float64
+		for trait, counts := range valueCounts {
+			value, ok := values[trait]
+			if !ok {
+				value = MissingTraitValue
+			}
+			score += float64(total) / float64(counts[value])
+		}
+		traitCountScore := float64(total) / float64(traitCountCounts[len(values)])
+
+		results = append(results, Result{
+			TokenId:         tokenId,
+			RarityScore:     decimal.NewFromFloat(score).Round(scorePrecision),
+			TraitCountScore: decimal.NewFromFloat(traitCountScore).Round(scorePrecision),
+		})
+	}
+
+	// 3. 计算排名
+	rank(results, func(r *Result) decimal.Decimal { return r.RarityScore }, func(r *Result, v int64) { r.RarityRank = v })
+	rank(results, func(r *Result) decimal.Decimal { return r.TraitCountScore }, func(r *Result, v int64) { r.TraitCountRank = v })
+
+	// 结果按统计稀有度排名输出, 排名相同时按 tokenId 排序保证稳定
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].RarityRank != results[j].RarityRank {
+			return results[i].RarityRank < results[j].RarityRank
+		}
+		return results[i].TokenId < results[j].TokenId
+	})
+
+	return results
+}
+
+// rank 按分数降序为结果设置排名, 分数相同排名相同
+func rank(results []Result, score func(*Result) decimal.Decimal, set func(*Result, int64)) {
+	idx := make([]int, len(results))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool {
+		return score(&results[idx[i]]).GreaterThan(score(&results[idx[j]]))
+	})
+
+	var current int64
+	for pos, i := range idx {
+		if pos == 0 || !score(&results[i]).Equal(score(&results[idx[pos-1]])) {
+			current = int64(pos + 1)
+		}
+		set(&results[i], current)
+	}
+}
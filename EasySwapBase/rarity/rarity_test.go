@@ -0,0 +1,42 @@
+package rarity
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalculate(t *testing.T) {
+	items := map[string][]Trait{
+		"1": {{Trait: "background", TraitValue: "red"}, {Trait: "eyes", TraitValue: "blue"}},
+		"2": {{Trait: "background", TraitValue: "red"}, {Trait: "eyes", TraitValue: "green"}},
+		"3": {{Trait: "background", TraitValue: "blue"}, {Trait: "eyes", TraitValue: "blue"}},
+		"4": {{Trait: "background", TraitValue: "red"}},
+	}
+
+	results := Calculate(items)
+	assert.Equal(t, 4, len(results))
+
+	expected := []struct {
+		tokenId         string
+		score           string
+		rank            int64
+		traitCountScore string
+		traitCountRank  int64
+	}{
+		{"3", "6", 1, "1.3333", 2},
+		{"2", "5.3333", 2, "1.3333", 2},
+		{"4", "5.3333", 2, "4", 1},
+		{"1", "3.3333", 4, "1.3333", 2},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.tokenId, results[i].TokenId)
+		assert.True(t, decimal.RequireFromString(e.score).Equal(results[i].RarityScore), results[i].RarityScore.String())
+		assert.Equal(t, e.rank, results[i].RarityRank)
+		assert.True(t, decimal.RequireFromString(e.traitCountScore).Equal(results[i].TraitCountScore), results[i].TraitCountScore.String())
+		assert.Equal(t, e.traitCountRank, results[i].TraitCountRank)
+	}
+
+	assert.Nil(t, Calculate(map[string][]Trait{}))
+}
@@ -112,6 +112,10 @@ func NewDB(c *Config) (*gorm.DB, error) {
 		return nil, errors.WithMessage(err, "gdb: open database connection err")
 	}
 
+	if err := db.Use(TracePlugin{}); err != nil {
+		return nil, errors.WithMessage(err, "gdb: register tracing plugin err")
+	}
+
 	sqlDB, err := db.DB()
 	if err != nil {
 		return nil, errors.WithMessage(err, "gdb: get database instance err")
@@ -0,0 +1,26 @@
+package base
+
+// 审计日志目标类型
+const (
+	AuditTargetCollection = "collection"
+	AuditTargetItem       = "item"
+	AuditTargetUser       = "user"
+	AuditTargetApiKey     = "api_key"
+)
+
+// AdminAuditLog 管理员操作审计日志, 每次后台管理操作记录一条
+type AdminAuditLog struct {
+	Id           int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	AdminAddress string `gorm:"column:admin_address;NOT NULL" json:"admin_address"`                                      // 操作的管理员地址
+	Action       string `gorm:"column:action;NOT NULL" json:"action"`                                                    // 操作类型
+	Chain        string `gorm:"column:chain;default:'';NOT NULL" json:"chain"`                                           // 链名称, 用户相关操作为空
+	TargetType   string `gorm:"column:target_type;NOT NULL" json:"target_type"`                                          // 操作对象类型(collection/item/user)
+	Target       string `gorm:"column:target;NOT NULL" json:"target"`                                                    // 操作对象(集合地址/集合地址:token_id/用户地址)
+	Detail       string `gorm:"column:detail" json:"detail"`                                                             // 操作参数(json)
+	Ip           string `gorm:"column:ip;default:'';NOT NULL" json:"ip"`                                                 // 请求来源 IP
+	CreateTime   int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+}
+
+func AdminAuditLogTableName() string {
+	return "ob_admin_audit_log"
+}
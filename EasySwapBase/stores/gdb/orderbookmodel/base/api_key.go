@@ -0,0 +1,24 @@
+package base
+
+// API key 状态
+const (
+	ApiKeyStatusActive  = 1
+	ApiKeyStatusRevoked = 2
+)
+
+// ApiKey 合作方 API key, 仅保存 key 的 sha256 摘要, 明文只在签发时返回一次
+type ApiKey struct {
+	Id         int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	Name       string `gorm:"column:name;NOT NULL" json:"name"`                                                        // 合作方名称
+	Owner      string `gorm:"column:owner;default:'';NOT NULL" json:"owner"`                                           // 合作方联系地址
+	KeyPrefix  string `gorm:"column:key_prefix;NOT NULL" json:"key_prefix"`                                            // key 前缀, 用于展示和识别
+	KeyHash    string `gorm:"column:key_hash;NOT NULL" json:"-"`                                                       // key 的 sha256 摘要(hex)
+	RateLimit  int    `gorm:"column:rate_limit;default:0;NOT NULL" json:"rate_limit"`                                  // 每个限流窗口的请求配额, 0 表示使用默认配额
+	Status     int    `gorm:"column:status;default:1;NOT NULL" json:"status"`                                          // 状态(1:有效 2:已撤销)
+	CreateTime int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func ApiKeyTableName() string {
+	return "ob_api_key"
+}
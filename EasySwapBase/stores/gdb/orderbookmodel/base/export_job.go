@@ -0,0 +1,49 @@
+package base
+
+// 导出任务状态
+const (
+	ExportJobStatusPending = 1 // 等待执行
+	ExportJobStatusRunning = 2 // 执行中
+	ExportJobStatusDone    = 3 // 已完成, 可下载
+	ExportJobStatusFailed  = 4 // 执行失败
+	ExportJobStatusExpired = 5 // 文件已过期删除
+)
+
+// ExportJob 异步数据导出任务, 导出文件在 ExpireTime 前可通过 DownloadToken 下载
+// 文件内容按 Attempts 分块保存在 ExportFileChunk 中, 各实例均可提供下载
+type ExportJob struct {
+	Id            int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	UserAddress   string `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 创建任务的用户地址
+	Kind          string `gorm:"column:kind;NOT NULL" json:"kind"`                                                        // 导出数据类型
+	Format        string `gorm:"column:format;NOT NULL" json:"format"`                                                    // 文件格式(csv/ndjson)
+	Params        string `gorm:"column:params;type:text" json:"params"`                                                   // 导出过滤条件(JSON)
+	Status        int    `gorm:"column:status;default:1;NOT NULL" json:"status"`                                          // 状态(1:等待 2:执行中 3:完成 4:失败 5:已过期)
+	Rows          int64  `gorm:"column:rows;default:0;NOT NULL" json:"rows"`                                              // 已导出行数
+	FileName      string `gorm:"column:file_name;default:'';NOT NULL" json:"file_name"`                                   // 导出文件名
+	FileSize      int64  `gorm:"column:file_size;default:0;NOT NULL" json:"file_size"`                                    // 文件大小(字节)
+	DownloadToken string `gorm:"column:download_token;default:'';NOT NULL" json:"-"`                                      // 下载凭证
+	ExpireTime    int64  `gorm:"column:expire_time;default:0;NOT NULL" json:"expire_time"`                                // 文件过期时间(秒)
+	LastError     string `gorm:"column:last_error;default:'';NOT NULL" json:"last_error"`                                 // 失败原因
+	Attempts      int    `gorm:"column:attempts;default:0;NOT NULL" json:"attempts"`                                      // 已领取执行的次数
+	CreateTime    int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime    int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func ExportJobTableName() string {
+	return "ob_export_job"
+}
+
+// ExportFileChunk 导出文件分块, 按 Seq 顺序拼接即为第 Attempt 次执行生成的文件
+type ExportFileChunk struct {
+	Id         int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	JobId      int64  `gorm:"column:job_id;NOT NULL" json:"job_id"`                                                    // 导出任务 id
+	Attempt    int    `gorm:"column:attempt;NOT NULL" json:"attempt"`                                                  // 生成该分块的执行次数
+	Seq        int    `gorm:"column:seq;NOT NULL" json:"seq"`                                                          // 分块序号, 从 0 开始
+	Data       []byte `gorm:"column:data;type:mediumblob" json:"-"`                                                    // 分块内容
+	CreateTime int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func ExportFileChunkTableName() string {
+	return "ob_export_file_chunk"
+}
@@ -7,6 +7,7 @@ const (
 	TypeMultiMarketsSaleIndex       = 3
 	TypeHubContractEventIndex       = 4
 	TypeMultiMarketsFloorPriceIndex = 5
+	TypeOrderBookEventIndex         = 6 // 订单簿合约事件同步进度
 )
 
 type IndexedStatus struct {
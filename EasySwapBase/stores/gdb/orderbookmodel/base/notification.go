@@ -0,0 +1,111 @@
+package base
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// 通知类型
+const (
+	NotificationItemSold        = "item_sold"        // 挂单成交
+	NotificationBidAccepted     = "bid_accepted"     // 出价被接受
+	NotificationOutbid          = "outbid"           // 出价被更高出价超过
+	NotificationListingExpiring = "listing_expiring" // 挂单即将过期
+	NotificationListingExpired  = "listing_expired"  // 挂单已过期
+	NotificationFloorDrop       = "floor_drop"       // 关注集合的地板价低于阈值
+)
+
+// NotificationKinds 所有通知类型
+var NotificationKinds = []string{
+	NotificationItemSold,
+	NotificationBidAccepted,
+	NotificationOutbid,
+	NotificationListingExpiring,
+	NotificationListingExpired,
+	NotificationFloorDrop,
+}
+
+// 通知投递渠道, 站内信不需要投递
+const (
+	NotificationChannelWebhook = "webhook"
+	NotificationChannelEmail   = "email"
+)
+
+// 通知投递状态
+const (
+	DeliveryStatusPending = 1 // 待投递或等待重试
+	DeliveryStatusSent    = 2 // 投递成功
+	DeliveryStatusFailed  = 3 // 重试次数用尽
+)
+
+// Notification 用户站内通知, EventKey 唯一, 同一事件重复产生 (如重放区块) 时只保存一次
+type Notification struct {
+	Id                int64           `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	UserAddress       string          `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 接收用户地址
+	ChainId           int64           `gorm:"column:chain_id;default:0;NOT NULL" json:"chain_id"`                                      // 链 ID
+	Kind              string          `gorm:"column:kind;NOT NULL" json:"kind"`                                                        // 通知类型
+	EventKey          string          `gorm:"column:event_key;NOT NULL" json:"-"`                                                      // 事件唯一标识, 用于去重
+	CollectionAddress string          `gorm:"column:collection_address;default:'';NOT NULL" json:"collection_address"`                 // 集合地址
+	TokenId           string          `gorm:"column:token_id;default:'';NOT NULL" json:"token_id"`                                     // Token ID
+	OrderId           string          `gorm:"column:order_id;default:'';NOT NULL" json:"order_id"`                                     // 相关订单 ID
+	Price             decimal.Decimal `gorm:"column:price;type:decimal(30)" json:"price"`                                              // 成交价/出价/地板价
+	Payload           string          `gorm:"column:payload;type:text" json:"payload"`                                                 // 附加信息 (JSON)
+	IsRead            bool            `gorm:"column:is_read;default:0;NOT NULL" json:"is_read"`                                        // 是否已读
+	CreateTime        int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime        int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func NotificationTableName() string {
+	return "ob_notification"
+}
+
+// NotificationPreference 用户通知偏好, 没有记录时接收全部类型的站内通知, 不投递外部渠道
+type NotificationPreference struct {
+	Id             int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	UserAddress    string `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 用户地址
+	MutedKinds     string `gorm:"column:muted_kinds;default:'';NOT NULL" json:"muted_kinds"`                               // 不接收的通知类型, 逗号分隔
+	WebhookEnabled bool   `gorm:"column:webhook_enabled;default:0;NOT NULL" json:"webhook_enabled"`                        // 是否投递 webhook
+	WebhookURL     string `gorm:"column:webhook_url;default:'';NOT NULL" json:"webhook_url"`                               // webhook 地址
+	WebhookSecret  string `gorm:"column:webhook_secret;default:'';NOT NULL" json:"-"`                                      // webhook 签名密钥
+	EmailEnabled   bool   `gorm:"column:email_enabled;default:0;NOT NULL" json:"email_enabled"`                            // 是否投递邮件
+	Email          string `gorm:"column:email;default:'';NOT NULL" json:"email"`                                           // 邮箱地址
+	CreateTime     int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime     int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func NotificationPreferenceTableName() string {
+	return "ob_notification_preference"
+}
+
+// NotificationFloorAlert 集合地板价提醒, 地板价低于阈值时通知一次, 回到阈值以上后重新生效
+type NotificationFloorAlert struct {
+	Id                int64           `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	UserAddress       string          `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 用户地址
+	ChainId           int64           `gorm:"column:chain_id;NOT NULL" json:"chain_id"`                                                // 链 ID
+	CollectionAddress string          `gorm:"column:collection_address;NOT NULL" json:"collection_address"`                            // 集合地址
+	Threshold         decimal.Decimal `gorm:"column:threshold;type:decimal(30)" json:"threshold"`                                      // 地板价阈值
+	Triggered         bool            `gorm:"column:triggered;default:0;NOT NULL" json:"triggered"`                                    // 已通知且地板价仍低于阈值
+	CreateTime        int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime        int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func NotificationFloorAlertTableName() string {
+	return "ob_notification_floor_alert"
+}
+
+// NotificationDelivery 通知在外部渠道的投递记录, 同时作为投递重试队列
+type NotificationDelivery struct {
+	Id              int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	NotificationId  int64  `gorm:"column:notification_id;NOT NULL" json:"notification_id"`                                  // 通知 ID
+	UserAddress     string `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 用户地址
+	Channel         string `gorm:"column:channel;NOT NULL" json:"channel"`                                                  // 投递渠道
+	Status          int    `gorm:"column:status;default:1;NOT NULL" json:"status"`                                          // 状态(1:待投递 2:成功 3:失败)
+	Attempts        int    `gorm:"column:attempts;default:0;NOT NULL" json:"attempts"`                                      // 已尝试次数
+	NextAttemptTime int64  `gorm:"column:next_attempt_time;default:0;NOT NULL" json:"next_attempt_time"`                    // 下次尝试时间 (秒)
+	LastError       string `gorm:"column:last_error;default:'';NOT NULL" json:"last_error"`                                 // 最近一次失败原因
+	CreateTime      int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime      int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func NotificationDeliveryTableName() string {
+	return "ob_notification_delivery"
+}
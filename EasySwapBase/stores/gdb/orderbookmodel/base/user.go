@@ -1,10 +1,17 @@
 package base
 
+// 用户角色
+const (
+	UserRoleNormal = ""      // 普通用户
+	UserRoleAdmin  = "admin" // 管理员, 可访问后台管理接口
+)
+
 type User struct {
 	Id         int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`         // 主键
 	Address    string `gorm:"column:address;NOT NULL" json:"address"`                 // 用户地址
-	IsAllowed  bool   `gorm:"column:is_allowed;default:0;NOT NULL" json:"is_allowed"` // 是否允许用户访问
+	IsAllowed  bool   `gorm:"column:is_allowed;NOT NULL" json:"is_allowed"` // 是否允许用户访问, false 表示被管理员封禁
 	IsSigned   bool   `gorm:"column:is_signed;default:0" json:"is_signed"`
+	Role       string `gorm:"column:role;default:'';NOT NULL" json:"role"`                                             // 用户角色(空:普通用户 admin:管理员)
 	CreateTime int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
 	UpdateTime int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
 }
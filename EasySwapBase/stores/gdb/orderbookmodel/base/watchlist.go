@@ -0,0 +1,30 @@
+package base
+
+// UserFavoriteItem 用户收藏的 Item
+type UserFavoriteItem struct {
+	Id                int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	UserAddress       string `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 用户地址
+	ChainId           int64  `gorm:"column:chain_id;NOT NULL" json:"chain_id"`                                                // 链 ID
+	CollectionAddress string `gorm:"column:collection_address;NOT NULL" json:"collection_address"`                            // 集合地址
+	TokenId           string `gorm:"column:token_id;NOT NULL" json:"token_id"`                                                // Token ID
+	CreateTime        int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime        int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func UserFavoriteItemTableName() string {
+	return "ob_user_favorite_item"
+}
+
+// UserWatchCollection 用户关注的集合
+type UserWatchCollection struct {
+	Id                int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	UserAddress       string `gorm:"column:user_address;NOT NULL" json:"user_address"`                                        // 用户地址
+	ChainId           int64  `gorm:"column:chain_id;NOT NULL" json:"chain_id"`                                                // 链 ID
+	CollectionAddress string `gorm:"column:collection_address;NOT NULL" json:"collection_address"`                            // 集合地址
+	CreateTime        int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime        int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func UserWatchCollectionTableName() string {
+	return "ob_user_watch_collection"
+}
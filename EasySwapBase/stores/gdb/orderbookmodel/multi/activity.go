@@ -20,6 +20,11 @@ const (
 	ItemBid             = 10
 	CancelCollectionBid = 16
 	CancelItemBid       = 17
+	TraitBid            = 18 // 已废弃, 属性出价为链下订单, 不再记录活动
+	CancelTraitBid      = 19
+	EditListing         = 20 // editOrders 修改挂单, 取代同一交易中的 Cancel Listing 及 List
+	EditCollectionBid   = 21
+	EditItemBid         = 22
 )
 
 const (
@@ -44,6 +49,7 @@ type Activity struct {
 	Price             decimal.Decimal `gorm:"column:price" json:"price"`
 	SellPrice         decimal.Decimal `json:"sell_price" gorm:"column:sell_price;type:decimal(30);not null;default:0"`
 	BuyPrice          decimal.Decimal `json:"buy_price" gorm:"column:buy_price;type:decimal(30);not null;default:0"`
+	PrevPrice         decimal.Decimal `json:"prev_price" gorm:"column:prev_price;type:decimal(30);not null;default:0"` // 修改订单前的价格, 仅 Edit 类型活动
 	BlockNumber       int64           `json:"block_number" gorm:"column:block_number;type:bigint(20);not null"`
 	TxHash            string          `json:"tx_hash" gorm:"column:tx_hash;type:varchar(255);not null"`
 	EventTime         int64           `json:"event_time" gorm:"column:event_time;type:bigint(20);default:0;comment:链上事件发生的时间"`
@@ -29,6 +29,13 @@ const (
 	AlreadySyncHistorySale = 1
 )
 
+// 集合展示状态, 由管理员设置
+const (
+	CollectionStatusNormal = 0 // 正常展示
+	CollectionStatusHidden = 1 // 隐藏, 不出现在排行等列表中, 仍可通过地址访问
+	CollectionStatusBanned = 2 // 封禁, 不再对外提供任何信息
+)
+
 type Collection struct {
 	Id               int64           `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`             // 主键
 	Symbol           string          `gorm:"column:symbol;NOT NULL" json:"symbol"`                       // 项目标识
@@ -56,6 +63,7 @@ type Collection struct {
 	HistorySaleSync  int             `gorm:"column:history_sale_sync" json:"history_sale_sync"`
 	HistoryOverview  int             `gorm:"column:history_overview" json:"history_overview"` // 是否生成历史成交overview(0:已经生成 1:等待生成 2:生成错误)
 	FloorPriceStatus int             `gorm:"column:floor_price_status" json:"floor_price_status"`
+	Status           int             `gorm:"column:status;default:0;NOT NULL" json:"status"`                                          // 展示状态(0:正常 1:隐藏 2:封禁)
 	CreateTime       int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
 	UpdateTime       int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
 }
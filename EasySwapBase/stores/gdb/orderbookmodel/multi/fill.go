@@ -0,0 +1,30 @@
+package multi
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Fill 订单成交记录, 每个 LogMatch 为买卖双方订单各生成一条, 订单剩余数量由成交记录推导
+type Fill struct {
+	Id                int64           `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	OrderID           string          `gorm:"column:order_id;NOT NULL" json:"order_id"`                                                // 成交的订单 id
+	CounterOrderID    string          `gorm:"column:counter_order_id;NOT NULL" json:"counter_order_id"`                                // 对手方订单 id
+	OrderType         int64           `gorm:"column:order_type" json:"order_type"`                                                     // 成交订单的类型
+	CollectionAddress string          `gorm:"column:collection_address;NOT NULL" json:"collection_address"`                            // 成交 NFT 的合约地址
+	TokenId           string          `gorm:"column:token_id;NOT NULL" json:"token_id"`                                                // 成交 NFT 的 TokenId
+	Amount            int64           `gorm:"column:amount;default:1" json:"amount"`                                                   // 成交数量
+	Price             decimal.Decimal `gorm:"column:price;type:decimal(30)" json:"price"`                                              // 成交价格
+	Maker             string          `gorm:"column:maker" json:"maker"`                                                               // 成交订单的 Maker
+	TxHash            string          `gorm:"column:tx_hash;NOT NULL" json:"tx_hash"`                                                  // 交易哈希
+	LogIndex          int64           `gorm:"column:log_index" json:"log_index"`                                                       // LogMatch 在区块中的日志序号
+	BlockNumber       int64           `gorm:"column:block_number" json:"block_number"`                                                 // 区块号
+	EventTime         int64           `gorm:"column:event_time" json:"event_time"`                                                     // 成交时间
+	CreateTime        int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime        int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func FillTableName(chainName string) string {
+	return fmt.Sprintf("ob_fill_%s", chainName)
+}
@@ -6,6 +6,13 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// Item 展示状态, 由管理员设置
+const (
+	ItemStatusNormal = 0 // 正常展示
+	ItemStatusHidden = 1 // 隐藏, 不出现在集合 Item 列表中
+	ItemStatusBanned = 2 // 封禁, 不再对外提供任何信息
+)
+
 type Item struct {
 	Id                int64           `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
 	ChainId           int             `gorm:"column:chain_id;default:1;NOT NULL" json:"chain_id"`                                      // 链类型
@@ -19,6 +26,11 @@ type Item struct {
 	ListTime          int64           `gorm:"column:list_time" json:"list_time"`                                                       // 上架时间
 	SalePrice         decimal.Decimal `gorm:"column:sale_price" json:"sale_price"`                                                     // 销售价格
 	Views             int64           `gorm:"column:views" json:"views"`                                                               // 浏览量
+	RarityScore       decimal.Decimal `gorm:"column:rarity_score" json:"rarity_score"`                                                 // 统计稀有度分数
+	RarityRank        int64           `gorm:"column:rarity_rank" json:"rarity_rank"`                                                   // 统计稀有度排名
+	TraitCountScore   decimal.Decimal `gorm:"column:trait_count_score" json:"trait_count_score"`                                       // 属性个数稀有度分数
+	TraitCountRank    int64           `gorm:"column:trait_count_rank" json:"trait_count_rank"`                                         // 属性个数稀有度排名
+	Status            int             `gorm:"column:status;default:0;NOT NULL" json:"status"`                                          // 展示状态(0:正常 1:隐藏 2:封禁)
 	CreateTime        int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
 	UpdateTime        int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
 }
@@ -11,6 +11,7 @@ const (
 	OfferOrder         = 2
 	CollectionBidOrder = 3
 	ItemBidOrder       = 4
+	TraitBidOrder      = 5 // 属性出价, Maker 签名的集合出价, 只保存在链下无托管; 由后端撮合, 出价人以不高于出价的价格购买拥有该属性的 Item
 )
 
 const (
@@ -20,6 +21,7 @@ const (
 	OrderStatusCancelled = 3
 	OrderStatusFilled    = 4
 	OrderStatusNeedSign  = 5
+	OrderStatusPending   = 6 // 链下签名订单尚未上链, 合约无法成交; sync 收到 LogMake 后转为 Active
 )
 
 const (
@@ -37,6 +39,7 @@ type Order struct {
 	CollectionAddress string          `gorm:"column:collection_address" json:"collection_address"`
 	TokenId           string          `gorm:"column:token_id" json:"token_id"`
 	OrderID           string          `gorm:"column:order_id" json:"order_id"`                            //  订单唯一id
+	PrevOrderID       string          `gorm:"column:prev_order_id" json:"prev_order_id"`                  // editOrders 修改前的订单 id
 	OrderStatus       int             `gorm:"column:order_status;default:0;NOT NULL" json:"order_status"` // 订单状态
 	EventTime         int64           `gorm:"column:event_time" json:"event_time"`
 	ExpireTime        int64           `gorm:"column:expire_time" json:"expire_time"` // in seconds
@@ -46,11 +49,16 @@ type Order struct {
 	Taker             string          `gorm:"column:taker" json:"taker"`
 	QuantityRemaining int64           `gorm:"column:quantity_remaining" json:"quantity_remaining"`
 	Size              int64           `gorm:"column:size" json:"size"`
-	// 1: listing 2:offer 3:collection bid 4:item bid
-	OrderType  int64 `gorm:"column:order_type" json:"order_type"`
-	Salt       int64 `gorm:"column:salt" json:"salt"`
-	CreateTime int64 `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
-	UpdateTime int64 `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+	// 1: listing 2:offer 3:collection bid 4:item bid 5:trait bid
+	OrderType  int64           `gorm:"column:order_type" json:"order_type"`
+	Trait      string          `gorm:"column:trait" json:"trait"`             // 属性出价的属性名称
+	TraitValue string          `gorm:"column:trait_value" json:"trait_value"` // 属性出价的属性值
+	Salt       int64           `gorm:"column:salt" json:"salt"`
+	Signature  string          `gorm:"column:signature" json:"signature"`                                                       // 链下签名订单的 EIP-712 签名, 链上订单为空
+	EscrowEth  decimal.Decimal `gorm:"column:escrow_eth" json:"escrow_eth"`                                                     // 买单在 Vault 中锁定的 ETH, 链下签名订单为 0
+	EscrowNft  bool            `gorm:"column:escrow_nft" json:"escrow_nft"`                                                     // 卖单 NFT 是否托管在 Vault 中
+	CreateTime int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
 }
 
 func OrderTableName(chainName string) string {
@@ -0,0 +1,19 @@
+package multi
+
+import "fmt"
+
+// ProtocolShare 协议手续费率变更记录, 由合约 LogUpdatedProtocolShare 事件同步 (含 initialize 时的初始费率)
+type ProtocolShare struct {
+	Id          int64  `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	Share       int64  `gorm:"column:share;NOT NULL" json:"share"`                                                      // 协议手续费率 (万分位)
+	TxHash      string `gorm:"column:tx_hash;NOT NULL" json:"tx_hash"`                                                  // 交易哈希
+	LogIndex    int64  `gorm:"column:log_index" json:"log_index"`                                                       // 事件在区块中的日志序号
+	BlockNumber int64  `gorm:"column:block_number" json:"block_number"`                                                 // 区块号
+	EventTime   int64  `gorm:"column:event_time" json:"event_time"`                                                     // 生效时间
+	CreateTime  int64  `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime  int64  `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func ProtocolShareTableName(chainName string) string {
+	return fmt.Sprintf("ob_protocol_share_%s", chainName)
+}
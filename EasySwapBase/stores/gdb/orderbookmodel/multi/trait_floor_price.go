@@ -0,0 +1,21 @@
+package multi
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+type TraitFloorPrice struct {
+	Id                int64           `gorm:"column:id;AUTO_INCREMENT;primary_key" json:"id"`                                          // 主键
+	CollectionAddress string          `gorm:"column:collection_address;NOT NULL" json:"collection_address"`                            // 链上合约地址
+	Trait             string          `gorm:"column:trait;NOT NULL" json:"trait"`                                                      // 属性名称
+	TraitValue        string          `gorm:"column:trait_value;NOT NULL" json:"trait_value"`                                          // 属性值
+	Price             decimal.Decimal `gorm:"column:price;type:decimal(30);comment:拥有该属性的item中最低的listing价格" json:"price"`              // 属性地板价, 0 表示无挂单
+	CreateTime        int64           `json:"create_time" gorm:"column:create_time;type:bigint(20);autoCreateTime:milli;comment:创建时间"` // 创建时间
+	UpdateTime        int64           `json:"update_time" gorm:"column:update_time;type:bigint(20);autoUpdateTime:milli;comment:更新时间"` // 更新时间
+}
+
+func TraitFloorPriceTableName(chainName string) string {
+	return fmt.Sprintf("ob_trait_floor_price_%s", chainName)
+}
@@ -68,3 +68,11 @@ func GetMultiProjectCollectionTradeTableName(project string, chain string) strin
 		return ""
 	}
 }
+
+func GetMultiProjectTraitFloorPriceTableName(project string, chain string) string {
+	if project == OrderBookDexProject {
+		return multi.TraitFloorPriceTableName(chain)
+	} else {
+		return ""
+	}
+}
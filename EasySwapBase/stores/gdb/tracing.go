@@ -0,0 +1,85 @@
+package gdb
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
+)
+
+const (
+	tracePluginName = "gdb:tracing"
+	traceSpanKey    = "gdb:tracing:span"
+)
+
+// TracePlugin 为每条 SQL 创建 span 的 GORM 插件
+// 仅在 db.WithContext 传入的上下文中存在 span 时生效
+type TracePlugin struct{}
+
+func (TracePlugin) Name() string {
+	return tracePluginName
+}
+
+// Initialize 在各类操作的回调前后注册 span 的开始与结束
+func (p TracePlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	hooks := []struct {
+		op     string
+		before func(name string, fn func(*gorm.DB)) error
+		after  func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, h := range hooks {
+		if err := h.before(tracePluginName+":before_"+h.op, p.before("gorm."+h.op)); err != nil {
+			return err
+		}
+		if err := h.after(tracePluginName+":after_"+h.op, p.after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p TracePlugin) before(spanName string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if !xtrace.HasSpan(db.Statement.Context) {
+			return
+		}
+		ctx, span := xtrace.Tracer().Start(db.Statement.Context, spanName,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemMySQL))
+		db.Statement.Context = ctx
+		db.InstanceSet(traceSpanKey, span)
+	}
+}
+
+func (p TracePlugin) after(db *gorm.DB) {
+	v, ok := db.InstanceGet(traceSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(semconv.DBStatement(db.Statement.SQL.String()))
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBSQLTable(db.Statement.Table))
+	}
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
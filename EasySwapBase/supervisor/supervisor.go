@@ -0,0 +1,148 @@
+// Package supervisor 后台循环监督
+// 循环异常退出 (返回错误、提前返回或 panic) 后按指数退避重启;
+// Shutdown 取消所有循环的 context 并等待正在处理的任务完成
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+)
+
+const (
+	defaultMinBackoff = time.Second
+	defaultMaxBackoff = time.Minute
+)
+
+// Loop 后台循环, ctx 取消时应尽快返回
+// ctx 未取消时返回 (无论是否出错) 均视为异常退出并重启
+type Loop func(ctx context.Context) error
+
+// Supervisor 后台循环监督器
+type Supervisor struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+// Option 监督器配置项
+type Option func(s *Supervisor)
+
+// WithBackoff 设置重启退避区间, 每次连续失败退避时间翻倍直至 max
+func WithBackoff(min, max time.Duration) Option {
+	return func(s *Supervisor) {
+		s.minBackoff = min
+		s.maxBackoff = max
+	}
+}
+
+// New 新建监督器, parent 取消或调用 Shutdown 时所有循环停止
+func New(parent context.Context, opts ...Option) *Supervisor {
+	ctx, cancel := context.WithCancel(parent)
+	s := &Supervisor{
+		ctx:        ctx,
+		cancel:     cancel,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Go 启动受监督的循环
+func (s *Supervisor) Go(name string, loop Loop) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		s.supervise(name, loop)
+	}()
+}
+
+// Task 启动一次性任务, Shutdown 时等待其完成, 用于循环中派生的异步处理
+func (s *Supervisor) Task(name string, fn func()) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer func() {
+			if r := recover(); r != nil {
+				xzap.WithContext(s.ctx).Error("supervised task panic", zap.String("task", name), zap.Any("panic", r))
+			}
+		}()
+		fn()
+	}()
+}
+
+// Shutdown 停止所有循环并等待退出, ctx 到期时返回错误
+func (s *Supervisor) Shutdown(ctx context.Context) error {
+	s.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "failed on wait supervised loops exit")
+	}
+}
+
+func (s *Supervisor) supervise(name string, loop Loop) {
+	backoff := s.minBackoff
+	for {
+		start := time.Now()
+		err := run(s.ctx, loop)
+		if s.ctx.Err() != nil {
+			xzap.WithContext(s.ctx).Info("supervised loop stopped", zap.String("loop", name))
+			return
+		}
+
+		// 运行超过最大退避时间视为已恢复正常, 重置退避时间
+		if time.Since(start) > s.maxBackoff {
+			backoff = s.minBackoff
+		}
+		metrics.LoopRestarts.WithLabelValues(name).Inc()
+		xzap.WithContext(s.ctx).Error("supervised loop exited, restarting",
+			zap.String("loop", name), zap.Duration("backoff", backoff), zap.Error(err))
+
+		Sleep(s.ctx, backoff)
+		backoff *= 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+	}
+}
+
+func run(ctx context.Context, loop Loop) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return loop(ctx)
+}
+
+// Sleep 休眠 d, ctx 取消时提前返回
+func Sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
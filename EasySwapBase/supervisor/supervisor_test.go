@@ -0,0 +1,93 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+)
+
+func TestMain(m *testing.M) {
+	if _, err := xzap.SetUp(logging.LogConf{ServiceName: "supervisor_test", Mode: "console", Path: os.TempDir(), Level: "error"}); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestSupervisorRestart(t *testing.T) {
+	s := New(context.Background(), WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+	var runs atomic.Int32
+	restarted := make(chan struct{})
+	s.Go("flaky", func(ctx context.Context) error {
+		switch runs.Add(1) {
+		case 1:
+			return errors.New("checkpoint update failed")
+		case 2:
+			panic("boom")
+		case 3:
+			return nil // ctx 未取消时提前返回同样重启
+		case 4:
+			close(restarted)
+		}
+		<-ctx.Done()
+		return nil
+	})
+
+	select {
+	case <-restarted:
+	case <-time.After(time.Second):
+		t.Fatalf("loop not restarted, runs: %d", runs.Load())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if runs.Load() != 4 {
+		t.Fatalf("expected 4 runs, got %d", runs.Load())
+	}
+}
+
+func TestSupervisorShutdownDrain(t *testing.T) {
+	s := New(context.Background())
+
+	var drained atomic.Bool
+	s.Go("worker", func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	})
+	s.Task("in-flight", func() {
+		time.Sleep(20 * time.Millisecond)
+		drained.Store(true)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := s.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !drained.Load() {
+		t.Fatal("in-flight task not drained")
+	}
+
+	// 循环不响应取消时按超时返回
+	s = New(context.Background())
+	block := make(chan struct{})
+	defer close(block)
+	s.Go("stuck", func(ctx context.Context) error {
+		<-block
+		return nil
+	})
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Shutdown(ctx); err == nil {
+		t.Fatal("expected shutdown timeout")
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
 )
 
 const MB = 1 << (10 * 2)
@@ -72,7 +74,7 @@ func NewHTTPClient(c *Config) *http.Client {
 
 	client := &http.Client{
 		Timeout:   c.HTTPTimeout,
-		Transport: tr,
+		Transport: xtrace.NewTransport(tr), // 请求上下文中存在 span 时记录出站请求
 	}
 
 	return client
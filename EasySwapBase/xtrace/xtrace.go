@@ -0,0 +1,118 @@
+// Package xtrace OpenTelemetry 链路追踪初始化及通用工具
+// 未调用 SetUp 或未配置 exporter 时使用 otel 默认的空实现, 各处埋点不产生任何开销
+package xtrace
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName 各组件创建 span 使用的 tracer 名称
+	TracerName = "github.com/ProjectsTask/EasySwapBase"
+	// TraceIDHeader 返回给调用方的链路追踪 id 响应头
+	TraceIDHeader = "X-Trace-Id"
+
+	ExporterOtlpGrpc = "otlpgrpc"
+	ExporterOtlpHttp = "otlphttp"
+	ExporterStdout   = "stdout"
+)
+
+// Config 链路追踪配置, exporter 为空时不开启
+type Config struct {
+	ServiceName string            `toml:"service_name" mapstructure:"service_name" json:"service_name"`
+	Exporter    string            `toml:"exporter" mapstructure:"exporter" json:"exporter"` // otlpgrpc | otlphttp | stdout
+	Endpoint    string            `toml:"endpoint" mapstructure:"endpoint" json:"endpoint"` // OTLP collector 地址, 如 127.0.0.1:4317
+	Insecure    bool              `toml:"insecure" mapstructure:"insecure" json:"insecure"` // OTLP 不使用 TLS
+	Headers     map[string]string `toml:"headers" mapstructure:"headers" json:"headers"`    // OTLP 请求头, 如鉴权 token
+	Sampler     float64           `toml:"sampler" mapstructure:"sampler" json:"sampler"`    // 采样率 (0, 1], 为 0 时全部采样
+}
+
+// ShutdownFunc 刷新并关闭 exporter, 进程退出前调用
+type ShutdownFunc func(ctx context.Context) error
+
+// SetUp 初始化全局 TracerProvider 及 W3C trace context 传播
+func SetUp(c *Config) (ShutdownFunc, error) {
+	noop := func(context.Context) error { return nil }
+	if c == nil || c.Exporter == "" {
+		return noop, nil
+	}
+
+	exporter, err := newExporter(c)
+	if err != nil {
+		return noop, errors.Wrap(err, "failed on create trace exporter")
+	}
+
+	sampler := c.Sampler
+	if sampler <= 0 || sampler > 1 {
+		sampler = 1
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampler))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(c.ServiceName))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tp.Shutdown, nil
+}
+
+func newExporter(c *Config) (sdktrace.SpanExporter, error) {
+	switch c.Exporter {
+	case ExporterOtlpGrpc:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(c.Endpoint)}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(c.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(c.Headers))
+		}
+		return otlptracegrpc.New(context.Background(), opts...)
+	case ExporterOtlpHttp:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(c.Endpoint)}
+		if c.Insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(c.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(c.Headers))
+		}
+		return otlptracehttp.New(context.Background(), opts...)
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, errors.Errorf("unsupported trace exporter: %s", c.Exporter)
+	}
+}
+
+// Tracer 返回全局 tracer
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// HasSpan 上下文中是否存在有效的 span
+// 后台任务没有上游 span, 组件埋点据此跳过, 避免产生大量孤立的根 span
+func HasSpan(ctx context.Context) bool {
+	return ctx != nil && trace.SpanContextFromContext(ctx).IsValid()
+}
+
+// TraceID 返回上下文中的链路追踪 id, 不存在时返回空字符串
+func TraceID(ctx context.Context) string {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.HasTraceID() {
+		return ""
+	}
+	return spanCtx.TraceID().String()
+}
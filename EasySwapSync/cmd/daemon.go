@@ -2,15 +2,20 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof" // 引入 pprof 用于性能分析
 	"os"
 	"os/signal"
-	"sync"
 	"syscall"
+	"time"
 
+	"github.com/ProjectsTask/EasySwapBase/health"
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
@@ -18,90 +23,120 @@ import (
 	"github.com/ProjectsTask/EasySwapSync/service/config"
 )
 
+// shutdownTimeout 收到退出信号后等待后台任务完成的最长时间, 需小于编排系统的强制终止等待时间
+const shutdownTimeout = 25 * time.Second
+
 // DaemonCmd 定义了 "daemon" 子命令
 var DaemonCmd = &cobra.Command{
 	Use:   "daemon",
 	Short: "sync easy swap order info.", // 命令简短描述：同步 EasySwap 订单信息
 	Long:  "sync easy swap order info.", // 命令详细描述
 	Run: func(cmd *cobra.Command, args []string) {
-		// 使用 WaitGroup 等待所有 goroutine 完成
-		wg := &sync.WaitGroup{}
-		wg.Add(1)
-
-		// 创建一个根 Context
-		ctx := context.Background()
-		// 创建一个带有取消功能的 Context，用于优雅退出
-		ctx, cancel := context.WithCancel(ctx)
+		// 创建一个带有取消功能的根 Context，服务内的数据库、RPC 调用均使用该 Context
+		// 退出时先停止后台循环并等待正在处理的任务完成，再取消该 Context
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
 
-		// rpc退出信号通知chan，用于接收服务启动或运行过程中的错误
-		onSyncExit := make(chan error, 1)
-
-		// 启动一个 goroutine 来运行主服务逻辑
-		go func() {
-			defer wg.Done() // goroutine 结束时减少 WaitGroup 计数
-
-			// 1. 读取和解析配置文件 (config.toml)
-			cfg, err := config.UnmarshalCmdConfig()
-			if err != nil {
-				xzap.WithContext(ctx).Error("Failed to unmarshal config", zap.Error(err))
-				onSyncExit <- err // 发送错误信号
-				return
-			}
+		// 信号通知chan，用于接收系统信号 (带缓冲，避免信号丢失)
+		onSignal := make(chan os.Signal, 1)
+		// 监听 SIGINT (Ctrl+C) 和 SIGTERM (kill) 信号，实现优雅退出
+		signal.Notify(onSignal, syscall.SIGINT, syscall.SIGTERM)
 
-			// 2. 初始化日志模块
-			_, err = xzap.SetUp(*cfg.Log)
-			if err != nil {
-				xzap.WithContext(ctx).Error("Failed to set up logger", zap.Error(err))
-				onSyncExit <- err
-				return
-			}
+		s, shutdownTrace, err := startDaemon(ctx)
+		if err != nil {
+			xzap.WithContext(ctx).Error("Exit by error", zap.Error(err))
+			return
+		}
+		defer shutdownTrace(context.Background())
 
-			// 打印服务启动日志
-			xzap.WithContext(ctx).Info("sync server start", zap.Any("config", cfg))
+		sig := <-onSignal // 收到系统信号
+		xzap.WithContext(ctx).Info("Exit by signal", zap.String("signal", sig.String()))
 
-			// 3. 初始化服务 (Service)
-			// 这里会创建数据库连接、Redis 连接、链客户端等
-			s, err := service.New(ctx, cfg)
-			if err != nil {
-				xzap.WithContext(ctx).Error("Failed to create sync server", zap.Error(err))
-				onSyncExit <- err
-				return
-			}
+		// 停止后台循环，等待当前批次事件、订单过期更新等任务处理完成
+		stopCtx, stopCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer stopCancel()
+		if err := s.Stop(stopCtx); err != nil {
+			xzap.WithContext(ctx).Error("Failed to stop sync server gracefully", zap.Error(err))
+			return
+		}
+		xzap.WithContext(ctx).Info("sync server stopped")
+	},
+}
 
-			// 4. 启动服务
-			// 开始同步区块事件
-			if err := s.Start(); err != nil {
-				xzap.WithContext(ctx).Error("Failed to start sync server", zap.Error(err))
-				onSyncExit <- err
-				return
+// startDaemon 读取配置，初始化日志、链路追踪并启动服务及监控 HTTP 服务
+func startDaemon(ctx context.Context) (*service.Service, xtrace.ShutdownFunc, error) {
+	// 1. 读取和解析配置文件 (config.toml)
+	cfg, err := config.UnmarshalCmdConfig()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed on unmarshal config")
+	}
+
+	// 2. 初始化日志模块
+	if _, err := xzap.SetUp(*cfg.Log); err != nil {
+		return nil, nil, errors.Wrap(err, "failed on set up logger")
+	}
+
+	// 初始化链路追踪，未配置 exporter 时不开启
+	if cfg.Trace != nil && cfg.Trace.ServiceName == "" {
+		cfg.Trace.ServiceName = cfg.Log.ServiceName
+	}
+	shutdownTrace, err := xtrace.SetUp(cfg.Trace)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed on set up tracing")
+	}
+
+	// 打印服务启动日志
+	xzap.WithContext(ctx).Info("sync server start", zap.Any("config", cfg))
+
+	// 3. 初始化服务 (Service)
+	// 这里会创建数据库连接、Redis 连接、链客户端等
+	s, err := service.New(ctx, cfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed on create sync server")
+	}
+
+	// 4. 启动服务
+	// 开始同步区块事件
+	if err := s.Start(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed on start sync server")
+	}
+
+	// 5. 配置了指标端口时，启动 HTTP 服务暴露 Prometheus 指标
+	if cfg.Monitor.MetricsPort > 0 {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metrics.Handler())
+			if err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", cfg.Monitor.MetricsPort), mux); err != nil {
+				xzap.WithContext(ctx).Error("Failed to serve metrics", zap.Error(err))
 			}
+		}()
+	}
 
-			// 5. 如果配置开启了 Pprof，启动 HTTP 服务进行性能监控
-			if cfg.Monitor.PprofEnable {
-				http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", cfg.Monitor.PprofPort), nil)
+	// 6. 配置了健康检查端口时，启动 HTTP 服务暴露存活、就绪检查及同步进度
+	if cfg.Monitor.HealthPort > 0 {
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/healthz", health.LivenessHandler())
+			mux.Handle("/readyz", s.HealthChecker().ReadinessHandler())
+			mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json; charset=utf-8")
+				_ = json.NewEncoder(w).Encode(s.SyncStatus())
+			})
+			if err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", cfg.Monitor.HealthPort), mux); err != nil {
+				xzap.WithContext(ctx).Error("Failed to serve health check", zap.Error(err))
 			}
 		}()
+	}
 
-		// 信号通知chan，用于接收系统信号
-		onSignal := make(chan os.Signal)
-		// 监听 SIGINT (Ctrl+C) 和 SIGTERM (kill) 信号，实现优雅退出
-		signal.Notify(onSignal, syscall.SIGINT, syscall.SIGTERM)
-
-		select {
-		case sig := <-onSignal: // 收到系统信号
-			switch sig {
-			case syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM:
-				cancel() // 取消 Context，通知所有子 goroutine 退出
-				xzap.WithContext(ctx).Info("Exit by signal", zap.String("signal", sig.String()))
+	// 7. 如果配置开启了 Pprof，启动 HTTP 服务进行性能监控
+	if cfg.Monitor.PprofEnable {
+		go func() {
+			if err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", cfg.Monitor.PprofPort), nil); err != nil {
+				xzap.WithContext(ctx).Error("Failed to serve pprof", zap.Error(err))
 			}
-		case err := <-onSyncExit: // 收到服务内部错误
-			cancel() // 取消 Context
-			xzap.WithContext(ctx).Error("Exit by error", zap.Error(err))
-		}
-
-		// 等待所有 goroutine 退出
-		wg.Wait()
-	},
+		}()
+	}
+	return s, shutdownTrace, nil
 }
 
 func init() {
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/pkg/errors"
+
+	"github.com/ProjectsTask/EasySwapSync/service"
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+	"github.com/ProjectsTask/EasySwapSync/service/orderbookindexer"
+)
+
+// orderbookCmdContext 命令行工具的 Context, 收到 SIGINT/SIGTERM 时取消
+func orderbookCmdContext() (context.Context, context.CancelFunc) {
+	return signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+}
+
+// newOrderbookIndexer 初始化命令行工具使用的订单簿索引器, 不启动后台循环
+func newOrderbookIndexer(ctx context.Context) (*orderbookindexer.Service, error) {
+	cfg, err := config.UnmarshalCmdConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on unmarshal config")
+	}
+	if _, err := xzap.SetUp(*cfg.Log); err != nil {
+		return nil, errors.Wrap(err, "failed on set up logger")
+	}
+
+	s, err := service.New(ctx, cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on create sync server")
+	}
+	indexer := s.OrderbookIndexer()
+	if indexer == nil {
+		return nil, errors.Errorf("unsupported chain id: %d", cfg.ChainCfg.ID)
+	}
+	return indexer, nil
+}
+
+// printReplayResult 输出事件处理结果, dry-run 时列出全部事件, 否则仅列出处理失败的事件
+func printReplayResult(out io.Writer, result *orderbookindexer.ReplayResult, dryRun bool) error {
+	if dryRun {
+		fmt.Fprintln(out, "DRY RUN: nothing is written")
+	}
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "BLOCK\tLOG\tTX\tEVENT\tORDER_IDS\tERROR")
+	for _, event := range result.Events {
+		if !dryRun && event.Error == "" {
+			continue
+		}
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\t%s\n", event.BlockNumber, event.LogIndex, event.TxHash,
+			event.Event, strings.Join(event.OrderIDs, ","), event.Error)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if wiped := result.Wiped; wiped != nil {
+		verb := "wiped"
+		if dryRun {
+			verb = "would wipe"
+		}
+		fmt.Fprintf(out, "reindex blocks [%d, %d]: %s %d activities, %d fills, %d orders made in range, reset %d orders made before range\n",
+			wiped.FromBlock, wiped.ToBlock, verb, wiped.Activities, wiped.Fills, wiped.OrdersDeleted, wiped.OrdersReset)
+	}
+
+	var events []string
+	for name := range result.Counts {
+		events = append(events, name)
+	}
+	sort.Strings(events)
+	var counts []string
+	for _, name := range events {
+		counts = append(counts, fmt.Sprintf("%s=%d", name, result.Counts[name]))
+	}
+	fmt.Fprintf(out, "events: %s, failed: %d\n", strings.Join(counts, " "), result.Failed)
+
+	if result.Failed > 0 {
+		return errors.Errorf("%d events failed", result.Failed)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ProjectsTask/EasySwapSync/service/orderbookindexer"
+)
+
+var reindexFlags struct {
+	from    uint64
+	workers int
+	dryRun  bool
+}
+
+// ReindexCmd 清理指定区块之后的派生数据并重新处理事件, 需先停止 daemon
+var ReindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "wipe and rebuild orderbook derived rows from a block.",
+	Long: `wipe orderbook activities and orders derived from blocks [--from, last indexed block) and handle those events again.
+stop the daemon before running it; the live checkpoint is left unchanged.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := orderbookCmdContext()
+		defer cancel()
+
+		indexer, err := newOrderbookIndexer(ctx)
+		if err != nil {
+			return err
+		}
+		result, err := indexer.Reindex(ctx, reindexFlags.from, reindexFlags.workers, reindexFlags.dryRun)
+		if err != nil {
+			return err
+		}
+		return printReplayResult(cmd.OutOrStdout(), result, reindexFlags.dryRun)
+	},
+}
+
+func init() {
+	flags := ReindexCmd.Flags()
+	flags.Uint64Var(&reindexFlags.from, "from", 0, "first block to rebuild")
+	flags.IntVar(&reindexFlags.workers, "workers", orderbookindexer.DefaultBackfillWorkers, "number of concurrent log requests")
+	flags.BoolVar(&reindexFlags.dryRun, "dry-run", false, "only report the rows that would be wiped and the events that would be processed")
+	_ = ReindexCmd.MarkFlagRequired("from")
+	rootCmd.AddCommand(ReindexCmd)
+}
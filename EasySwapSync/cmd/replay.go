@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var replayFlags struct {
+	tx     string
+	dryRun bool
+}
+
+// ReplayCmd 重新处理单笔交易中的订单簿事件
+var ReplayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "reprocess the orderbook events of one transaction.",
+	Long:  "reprocess the orderbook events emitted by transaction --tx, in log order.",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		hash := common.FromHex(replayFlags.tx)
+		if len(hash) != common.HashLength {
+			return errors.Errorf("invalid transaction hash: %s", replayFlags.tx)
+		}
+
+		ctx, cancel := orderbookCmdContext()
+		defer cancel()
+
+		indexer, err := newOrderbookIndexer(ctx)
+		if err != nil {
+			return err
+		}
+		logs, err := indexer.TxLogs(ctx, replayFlags.tx)
+		if err != nil {
+			return err
+		}
+		return printReplayResult(cmd.OutOrStdout(), indexer.Replay(logs, replayFlags.dryRun), replayFlags.dryRun)
+	},
+}
+
+func init() {
+	flags := ReplayCmd.Flags()
+	flags.StringVar(&replayFlags.tx, "tx", "", "transaction hash")
+	flags.BoolVar(&replayFlags.dryRun, "dry-run", false, "only list the events that would be processed")
+	_ = ReplayCmd.MarkFlagRequired("tx")
+	rootCmd.AddCommand(ReplayCmd)
+}
@@ -0,0 +1,8 @@
+alter table ob_item_sepolia
+    add rarity_score      decimal(20, 4) default 0 not null comment '统计稀有度分数' after views,
+    add rarity_rank       bigint         default 0 not null comment '统计稀有度排名' after rarity_score,
+    add trait_count_score decimal(20, 4) default 0 not null comment '属性个数稀有度分数' after rarity_rank,
+    add trait_count_rank  bigint         default 0 not null comment '属性个数稀有度排名' after trait_count_score;
+
+create index index_collection_rarity_rank
+    on ob_item_sepolia (collection_address, rarity_rank);
@@ -0,0 +1,21 @@
+alter table ob_order_sepolia
+    add trait       varchar(128) default '' not null comment '属性出价的属性名称' after order_type,
+    add trait_value varchar(512) default '' not null comment '属性出价的属性值' after trait;
+
+create index index_collection_type_trait
+    on ob_order_sepolia (collection_address, order_type, trait, trait_value);
+
+create table ob_trait_floor_price_sepolia
+(
+    id                 bigint auto_increment comment '主键'
+        primary key,
+    collection_address varchar(42)           not null,
+    trait              varchar(128)          not null comment '属性名称',
+    trait_value        varchar(512)          not null comment '属性值',
+    price              decimal(30) default 0 not null comment '拥有该属性的item中最低的listing价格, 0表示无挂单',
+    create_time        bigint                null comment '创建时间',
+    update_time        bigint                null comment '更新时间',
+    constraint index_collection_trait_value
+        unique (collection_address, trait, trait_value)
+)
+    collate = utf8mb4_general_ci;
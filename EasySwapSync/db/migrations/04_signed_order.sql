@@ -0,0 +1,2 @@
+alter table ob_order_sepolia
+    add signature varchar(132) default '' not null comment '链下签名订单的EIP-712签名, 链上订单为空' after salt;
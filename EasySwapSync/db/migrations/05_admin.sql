@@ -0,0 +1,29 @@
+alter table ob_user
+    add role varchar(16) default '' not null comment '用户角色(空:普通用户 admin:管理员)' after is_signed;
+
+alter table ob_collection_sepolia
+    add status tinyint default 0 not null comment '展示状态(0:正常 1:隐藏 2:封禁)' after floor_price_status;
+
+alter table ob_item_sepolia
+    add status tinyint default 0 not null comment '展示状态(0:正常 1:隐藏 2:封禁)' after trait_count_rank;
+
+create table ob_admin_audit_log
+(
+    id            bigint auto_increment comment '主键'
+        primary key,
+    admin_address varchar(66)             not null comment '操作的管理员地址',
+    action        varchar(64)             not null comment '操作类型',
+    chain         varchar(32)  default '' not null comment '链名称, 用户相关操作为空',
+    target_type   varchar(16)             not null comment '操作对象类型(collection/item/user)',
+    target        varchar(256)            not null comment '操作对象',
+    detail        text                    null comment '操作参数(json)',
+    ip            varchar(64)  default '' not null comment '请求来源 IP',
+    create_time   bigint                  null comment '创建时间'
+)
+    collate = utf8mb4_general_ci;
+
+create index index_admin_address
+    on ob_admin_audit_log (admin_address);
+
+create index index_target
+    on ob_admin_audit_log (target_type, target);
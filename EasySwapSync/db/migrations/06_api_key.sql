@@ -0,0 +1,16 @@
+create table ob_api_key
+(
+    id          bigint auto_increment comment '主键'
+        primary key,
+    name        varchar(128)            not null comment '合作方名称',
+    owner       varchar(66)  default '' not null comment '合作方联系地址',
+    key_prefix  varchar(16)             not null comment 'key 前缀, 用于展示和识别',
+    key_hash    varchar(64)             not null comment 'key 的 sha256 摘要(hex)',
+    rate_limit  int          default 0  not null comment '每个限流窗口的请求配额, 0 表示使用默认配额',
+    status      tinyint      default 1  not null comment '状态(1:有效 2:已撤销)',
+    create_time bigint                  null comment '创建时间',
+    update_time bigint                  null comment '更新时间',
+    constraint index_key_hash
+        unique (key_hash)
+)
+    collate = utf8mb4_general_ci;
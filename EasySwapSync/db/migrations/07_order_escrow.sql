@@ -0,0 +1,4 @@
+alter table ob_order_sepolia
+    add escrow_eth decimal(30) default 0 not null comment '买单在 Vault 中锁定的 ETH (wei)' after signature,
+    add escrow_nft tinyint default 0 not null comment '卖单 NFT 是否托管在 Vault 中' after escrow_eth,
+    add index index_maker_escrow (maker, escrow_nft, escrow_eth);
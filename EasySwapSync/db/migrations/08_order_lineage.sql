@@ -0,0 +1,6 @@
+alter table ob_order_sepolia
+    add prev_order_id varchar(66) default '' not null comment 'editOrders 修改前的订单id, 非修改生成的订单为空' after order_id,
+    add index index_prev_order_id (prev_order_id);
+
+alter table ob_activity_sepolia
+    add prev_price decimal(30) default 0 not null comment '修改订单前的价格, 仅修改订单活动' after buy_price;
@@ -0,0 +1,28 @@
+create table ob_fill_sepolia
+(
+    id                 bigint auto_increment comment '主键'
+        primary key,
+    order_id           varchar(66)           not null comment '成交的订单id',
+    counter_order_id   varchar(66)           not null comment '对手方订单id',
+    order_type         tinyint               not null comment '成交订单的类型',
+    collection_address varchar(42)           not null comment '成交nft的合约地址',
+    token_id           varchar(128)          not null comment '成交nft的token id',
+    amount             bigint      default 1 not null comment '成交数量',
+    price              decimal(30) default 0 not null comment '成交价格',
+    maker              varchar(42)           null comment '成交订单的maker',
+    tx_hash            varchar(66)           not null comment '交易事务hash',
+    log_index          bigint                not null comment 'LogMatch 在区块中的日志序号',
+    block_number       bigint      default 0 not null comment '区块号',
+    event_time         bigint                null comment '成交时间',
+    create_time        bigint                null comment '创建时间',
+    update_time        bigint                null comment '更新时间',
+    constraint index_tx_log_order
+        unique (tx_hash, log_index, order_id)
+)
+    collate = utf8mb4_general_ci;
+
+create index index_order_id
+    on ob_fill_sepolia (order_id);
+
+create index index_block_number
+    on ob_fill_sepolia (block_number);
@@ -0,0 +1,84 @@
+create table ob_notification
+(
+    id                 bigint auto_increment comment '主键'
+        primary key,
+    user_address       varchar(42)              not null comment '接收用户地址',
+    chain_id           bigint       default 0   not null comment '链id',
+    kind               varchar(32)              not null comment '通知类型',
+    event_key          varchar(191)             not null comment '事件唯一标识, 用于去重',
+    collection_address varchar(42)  default ''  not null comment '集合地址',
+    token_id           varchar(128) default ''  not null comment 'token id',
+    order_id           varchar(66)  default ''  not null comment '相关订单id',
+    price              decimal(30)  default 0   not null comment '成交价/出价/地板价',
+    payload            text                     null comment '附加信息(json)',
+    is_read            tinyint      default 0   not null comment '是否已读',
+    create_time        bigint                   null comment '创建时间',
+    update_time        bigint                   null comment '更新时间',
+    constraint index_event_key
+        unique (event_key)
+)
+    collate = utf8mb4_general_ci;
+
+create index index_user_read
+    on ob_notification (user_address, is_read, id);
+
+create table ob_notification_preference
+(
+    id              bigint auto_increment comment '主键'
+        primary key,
+    user_address    varchar(42)              not null comment '用户地址',
+    muted_kinds     varchar(255) default ''  not null comment '不接收的通知类型, 逗号分隔',
+    webhook_enabled tinyint      default 0   not null comment '是否投递webhook',
+    webhook_url     varchar(512) default ''  not null comment 'webhook地址',
+    webhook_secret  varchar(128) default ''  not null comment 'webhook签名密钥',
+    email_enabled   tinyint      default 0   not null comment '是否投递邮件',
+    email           varchar(255) default ''  not null comment '邮箱地址',
+    create_time     bigint                   null comment '创建时间',
+    update_time     bigint                   null comment '更新时间',
+    constraint index_user_address
+        unique (user_address)
+)
+    collate = utf8mb4_general_ci;
+
+create table ob_notification_floor_alert
+(
+    id                 bigint auto_increment comment '主键'
+        primary key,
+    user_address       varchar(42)            not null comment '用户地址',
+    chain_id           bigint                 not null comment '链id',
+    collection_address varchar(42)            not null comment '集合地址',
+    threshold          decimal(30) default 0  not null comment '地板价阈值',
+    triggered          tinyint     default 0  not null comment '已通知且地板价仍低于阈值',
+    create_time        bigint                 null comment '创建时间',
+    update_time        bigint                 null comment '更新时间',
+    constraint index_user_chain_collection
+        unique (user_address, chain_id, collection_address)
+)
+    collate = utf8mb4_general_ci;
+
+create index index_chain_collection
+    on ob_notification_floor_alert (chain_id, collection_address);
+
+create table ob_notification_delivery
+(
+    id                bigint auto_increment comment '主键'
+        primary key,
+    notification_id   bigint                   not null comment '通知id',
+    user_address      varchar(42)              not null comment '用户地址',
+    channel           varchar(16)              not null comment '投递渠道(webhook, email)',
+    status            tinyint      default 1   not null comment '状态(1:待投递 2:成功 3:失败)',
+    attempts          int          default 0   not null comment '已尝试次数',
+    next_attempt_time bigint       default 0   not null comment '下次尝试时间(秒)',
+    last_error        varchar(512) default ''  not null comment '最近一次失败原因',
+    create_time       bigint                   null comment '创建时间',
+    update_time       bigint                   null comment '更新时间',
+    constraint index_notification_channel
+        unique (notification_id, channel)
+)
+    collate = utf8mb4_general_ci;
+
+create index index_status_next_attempt
+    on ob_notification_delivery (status, next_attempt_time);
+
+create index index_user_address
+    on ob_notification_delivery (user_address, id);
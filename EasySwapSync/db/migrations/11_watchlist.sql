@@ -0,0 +1,31 @@
+create table ob_user_favorite_item
+(
+    id                 bigint auto_increment comment '主键'
+        primary key,
+    user_address       varchar(42)  not null comment '用户地址',
+    chain_id           bigint       not null comment '链id',
+    collection_address varchar(42)  not null comment '集合地址',
+    token_id           varchar(128) not null comment 'token id',
+    create_time        bigint       null comment '创建时间',
+    update_time        bigint       null comment '更新时间',
+    constraint index_user_item
+        unique (user_address, chain_id, collection_address, token_id)
+)
+    collate = utf8mb4_general_ci;
+
+create index index_item
+    on ob_user_favorite_item (chain_id, collection_address, token_id);
+
+create table ob_user_watch_collection
+(
+    id                 bigint auto_increment comment '主键'
+        primary key,
+    user_address       varchar(42) not null comment '用户地址',
+    chain_id           bigint      not null comment '链id',
+    collection_address varchar(42) not null comment '集合地址',
+    create_time        bigint      null comment '创建时间',
+    update_time        bigint      null comment '更新时间',
+    constraint index_user_collection
+        unique (user_address, chain_id, collection_address)
+)
+    collate = utf8mb4_general_ci;
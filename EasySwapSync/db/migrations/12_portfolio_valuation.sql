@@ -0,0 +1,5 @@
+create index index_collection_time
+    on ob_collection_floor_price_sepolia (collection_address, event_time);
+
+create index index_maker_time
+    on ob_fill_sepolia (maker, event_time);
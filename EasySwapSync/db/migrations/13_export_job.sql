@@ -0,0 +1,40 @@
+create table ob_export_job
+(
+    id             bigint auto_increment comment '主键'
+        primary key,
+    user_address   varchar(42)              not null comment '创建任务的用户地址',
+    kind           varchar(32)              not null comment '导出数据类型',
+    format         varchar(16)              not null comment '文件格式(csv/ndjson)',
+    params         text                     null comment '导出过滤条件(json)',
+    status         tinyint      default 1   not null comment '状态(1:等待 2:执行中 3:完成 4:失败 5:已过期)',
+    `rows`         bigint       default 0   not null comment '已导出行数',
+    file_name      varchar(255) default ''  not null comment '导出文件名',
+    file_size      bigint       default 0   not null comment '文件大小(字节)',
+    download_token varchar(64)  default ''  not null comment '下载凭证',
+    expire_time    bigint       default 0   not null comment '文件过期时间(秒)',
+    last_error     varchar(512) default ''  not null comment '失败原因',
+    create_time    bigint                   null comment '创建时间',
+    update_time    bigint                   null comment '更新时间'
+)
+    collate = utf8mb4_general_ci;
+
+create index index_user_id
+    on ob_export_job (user_address, id);
+
+create index index_status
+    on ob_export_job (status, update_time);
+
+create index index_download_token
+    on ob_export_job (download_token);
+
+create index index_collection_time
+    on ob_activity_sepolia (collection_address, event_time);
+
+create index index_event_time
+    on ob_activity_sepolia (event_time);
+
+create index index_maker
+    on ob_activity_sepolia (maker);
+
+create index index_taker
+    on ob_activity_sepolia (taker);
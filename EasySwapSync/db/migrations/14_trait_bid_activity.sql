@@ -0,0 +1,4 @@
+-- 属性意向出价为链下订单, 没有链上交易, 删除此前以订单 ID 作为 tx_hash 写入的活动
+delete
+from ob_activity_sepolia
+where activity_type in (18, 19);
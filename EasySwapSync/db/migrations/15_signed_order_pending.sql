@@ -0,0 +1,11 @@
+-- 尚未上链的链下签名订单改为 Pending 状态(6), 上链(LogMake)后由 sync 转为 Active
+update ob_order_sepolia
+set order_status = 6
+where signature != ''
+  and order_status in (0, 1);
+
+-- 链下签名订单不再记录活动, 删除此前以订单 ID 作为 tx_hash 写入的活动
+delete a
+from ob_activity_sepolia a
+         join ob_order_sepolia o on a.tx_hash = o.order_id
+where o.signature != '';
@@ -0,0 +1,13 @@
+-- is_allowed 改为默认允许访问, 仅管理员封禁的用户为 0
+alter table ob_user
+    modify is_allowed tinyint(1) default 1 not null comment '是否允许用户访问(0:被管理员封禁)';
+
+-- 此前自动注册的用户默认为 0, 未被管理员设置过的用户改为允许访问
+update ob_user u
+set u.is_allowed = 1
+where u.is_allowed = 0
+  and not exists (select 1
+                  from ob_admin_audit_log l
+                  where l.target_type = 'user'
+                    and l.target = u.address
+                    and l.action = 'user_allowed');
@@ -0,0 +1,16 @@
+-- 合约每次撮合只为买单 filledAmount 加 1, 此前买单成交记录误按卖单数量记录
+update ob_fill_sepolia
+set amount = 1
+where order_type != 1
+  and amount != 1;
+
+-- 按修正后的成交记录重新推导买单剩余数量, 被误判为完全成交的买单恢复为有效并按剩余数量恢复托管的 ETH
+update ob_order_sepolia o
+    join (select order_id, sum(amount) as filled
+          from ob_fill_sepolia
+          where order_type != 1
+          group by order_id) f on f.order_id = o.order_id
+set o.quantity_remaining = greatest(o.size - f.filled, 0),
+    o.order_status       = if(o.size - f.filled <= 0, 4, if(o.order_status = 4, 0, o.order_status)),
+    o.escrow_eth         = if(o.size - f.filled <= 0 or o.signature != '', 0, o.price * (o.size - f.filled))
+where o.order_type != 1;
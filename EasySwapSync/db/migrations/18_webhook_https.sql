@@ -0,0 +1,5 @@
+-- webhook 仅投递到 https 地址, 关闭已保存的非 https webhook, 用户需重新设置
+update ob_notification_preference
+set webhook_enabled = 0
+where webhook_enabled = 1
+  and webhook_url not like 'https://%';
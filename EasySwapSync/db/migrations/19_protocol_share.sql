@@ -0,0 +1,21 @@
+create table ob_protocol_share_sepolia
+(
+    id           bigint auto_increment comment '主键'
+        primary key,
+    share        bigint           not null comment '协议手续费率(万分位)',
+    tx_hash      varchar(66)      not null comment '交易事务hash',
+    log_index    bigint           not null comment 'LogUpdatedProtocolShare 在区块中的日志序号',
+    block_number bigint default 0 not null comment '区块号',
+    event_time   bigint           null comment '生效时间',
+    create_time  bigint           null comment '创建时间',
+    update_time  bigint           null comment '更新时间',
+    constraint index_tx_log
+        unique (tx_hash, log_index)
+)
+    collate = utf8mb4_general_ci;
+
+create index index_event_time
+    on ob_protocol_share_sepolia (event_time);
+
+create index index_block_number
+    on ob_protocol_share_sepolia (block_number);
@@ -0,0 +1,18 @@
+-- 导出文件保存到共享数据库, 各实例均可提供下载; 记录领取次数, 超过上限的任务不再重试
+alter table ob_export_job
+    add attempts int default 0 not null comment '已领取执行的次数' after last_error;
+
+create table ob_export_file_chunk
+(
+    id          bigint auto_increment comment '主键'
+        primary key,
+    job_id      bigint     not null comment '导出任务id',
+    attempt     int        not null comment '生成该分块的执行次数',
+    seq         int        not null comment '分块序号',
+    data        mediumblob not null comment '分块内容',
+    create_time bigint     null comment '创建时间',
+    update_time bigint     null comment '更新时间',
+    constraint index_job_attempt_seq
+        unique (job_id, attempt, seq)
+)
+    collate = utf8mb4_general_ci;
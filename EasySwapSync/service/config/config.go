@@ -6,19 +6,25 @@ import (
 	"github.com/spf13/viper"
 
 	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/notify"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
+	"github.com/ProjectsTask/EasySwapBase/xtrace"
 )
 
 // Config 定义了应用程序的全局配置结构
 type Config struct {
-	Monitor     *Monitor         `toml:"monitor" mapstructure:"monitor" json:"monitor"`                // 监控相关配置
-	Log         *logging.LogConf `toml:"log" mapstructure:"log" json:"log"`                            // 日志配置
-	Kv          *KvConf          `toml:"kv" mapstructure:"kv" json:"kv"`                               // KV存储配置 (Redis)
-	DB          *gdb.Config      `toml:"db" mapstructure:"db" json:"db"`                               // 数据库配置 (MySQL)
-	AnkrCfg     AnkrCfg          `toml:"ankr_cfg" mapstructure:"ankr_cfg" json:"ankr_cfg"`             // Ankr RPC 节点配置
-	ChainCfg    ChainCfg         `toml:"chain_cfg" mapstructure:"chain_cfg" json:"chain_cfg"`          // 链信息配置
-	ContractCfg ContractCfg      `toml:"contract_cfg" mapstructure:"contract_cfg" json:"contract_cfg"` // 合约地址配置
-	ProjectCfg  ProjectCfg       `toml:"project_cfg" mapstructure:"project_cfg" json:"project_cfg"`    // 项目名称配置
+	Monitor          *Monitor             `toml:"monitor" mapstructure:"monitor" json:"monitor"`                               // 监控相关配置
+	Log              *logging.LogConf     `toml:"log" mapstructure:"log" json:"log"`                                           // 日志配置
+	Kv               *KvConf              `toml:"kv" mapstructure:"kv" json:"kv"`                                              // KV存储配置 (Redis)
+	DB               *gdb.Config          `toml:"db" mapstructure:"db" json:"db"`                                              // 数据库配置 (MySQL)
+	AnkrCfg          AnkrCfg              `toml:"ankr_cfg" mapstructure:"ankr_cfg" json:"ankr_cfg"`                            // Ankr RPC 节点配置
+	ChainCfg         ChainCfg             `toml:"chain_cfg" mapstructure:"chain_cfg" json:"chain_cfg"`                         // 链信息配置
+	ContractCfg      ContractCfg          `toml:"contract_cfg" mapstructure:"contract_cfg" json:"contract_cfg"`                // 合约地址配置
+	ProjectCfg       ProjectCfg           `toml:"project_cfg" mapstructure:"project_cfg" json:"project_cfg"`                   // 项目名称配置
+	Trace            *xtrace.Config       `toml:"trace" mapstructure:"trace" json:"trace"`                                     // OpenTelemetry 链路追踪配置
+	Reconcile        *ReconcileCfg        `toml:"reconcile" mapstructure:"reconcile" json:"reconcile"`                         // 链上订单对账配置
+	ListingValidator *ListingValidatorCfg `toml:"listing_validator" mapstructure:"listing_validator" json:"listing_validator"` // 挂单有效性校验配置
+	Notification     *NotificationCfg     `toml:"notification" mapstructure:"notification" json:"notification"`                // 用户通知配置
 }
 
 // ChainCfg 定义链的基本信息
@@ -29,15 +35,48 @@ type ChainCfg struct {
 
 // ContractCfg 定义相关的合约地址
 type ContractCfg struct {
-	EthAddress  string `toml:"eth_address" mapstructure:"eth_address" json:"eth_address"`    // ETH 地址（通常指 WETH 或原生代币包装地址）
-	WethAddress string `toml:"weth_address" mapstructure:"weth_address" json:"weth_address"` // WETH 地址
-	DexAddress  string `toml:"dex_address" mapstructure:"dex_address" json:"dex_address"`    // EasySwapOrderBook 合约地址
+	EthAddress   string `toml:"eth_address" mapstructure:"eth_address" json:"eth_address"`       // ETH 地址（通常指 WETH 或原生代币包装地址）
+	WethAddress  string `toml:"weth_address" mapstructure:"weth_address" json:"weth_address"`    // WETH 地址
+	DexAddress   string `toml:"dex_address" mapstructure:"dex_address" json:"dex_address"`       // EasySwapOrderBook 合约地址
+	VaultAddress string `toml:"vault_address" mapstructure:"vault_address" json:"vault_address"` // EasySwapVault 合约地址, 为空时对账不校验托管资产
+}
+
+// ReconcileCfg 定义链上订单对账配置
+// 每个周期按 id 顺序检查一批活跃订单, 扫完一轮后从头开始并比对集合地板价
+type ReconcileCfg struct {
+	Enable    bool  `toml:"enable" mapstructure:"enable" json:"enable"`             // 是否开启对账
+	Interval  int64 `toml:"interval" mapstructure:"interval" json:"interval"`       // 对账周期 (秒)
+	BatchSize int   `toml:"batch_size" mapstructure:"batch_size" json:"batch_size"` // 每个周期检查的订单数
+}
+
+// ListingValidatorCfg 定义挂单有效性校验配置
+// 每个周期检查各集合价格最低的若干挂单的 NFT 所有权及对 Vault 的授权
+type ListingValidatorCfg struct {
+	Enable        bool  `toml:"enable" mapstructure:"enable" json:"enable"`                         // 是否开启校验
+	Interval      int64 `toml:"interval" mapstructure:"interval" json:"interval"`                   // 校验周期 (秒)
+	PerCollection int   `toml:"per_collection" mapstructure:"per_collection" json:"per_collection"` // 每个集合检查价格最低的挂单数
+	BatchSize     int   `toml:"batch_size" mapstructure:"batch_size" json:"batch_size"`             // 每次批量 RPC 请求包含的挂单数
+}
+
+// NotificationCfg 定义用户通知配置
+// 索引器在成交、出价时生成通知, 后台任务扫描即将过期/已过期挂单及地板价提醒, 并投递 webhook / 邮件
+type NotificationCfg struct {
+	Enable         bool               `toml:"enable" mapstructure:"enable" json:"enable"`                            // 是否开启通知
+	Interval       int64              `toml:"interval" mapstructure:"interval" json:"interval"`                      // 投递周期 (秒)
+	ScanInterval   int64              `toml:"scan_interval" mapstructure:"scan_interval" json:"scan_interval"`       // 挂单过期及地板价扫描周期 (秒)
+	ExpiryNotice   int64              `toml:"expiry_notice" mapstructure:"expiry_notice" json:"expiry_notice"`       // 挂单过期前多久提醒 (秒)
+	BatchSize      int                `toml:"batch_size" mapstructure:"batch_size" json:"batch_size"`                // 每次投递的记录数
+	MaxAttempts    int                `toml:"max_attempts" mapstructure:"max_attempts" json:"max_attempts"`          // 最大投递尝试次数
+	WebhookTimeout int64              `toml:"webhook_timeout" mapstructure:"webhook_timeout" json:"webhook_timeout"` // webhook 请求超时 (秒)
+	SMTP           *notify.SMTPConfig `toml:"smtp" mapstructure:"smtp" json:"smtp"`                                  // 邮件服务器, 为空时不投递邮件
 }
 
 // Monitor 定义监控配置
 type Monitor struct {
 	PprofEnable bool  `toml:"pprof_enable" mapstructure:"pprof_enable" json:"pprof_enable"` // 是否开启 Pprof
 	PprofPort   int64 `toml:"pprof_port" mapstructure:"pprof_port" json:"pprof_port"`       // Pprof 监听端口
+	MetricsPort int64 `toml:"metrics_port" mapstructure:"metrics_port" json:"metrics_port"` // Prometheus 指标监听端口, 为 0 时不开启
+	HealthPort  int64 `toml:"health_port" mapstructure:"health_port" json:"health_port"`    // 健康检查 (/healthz, /readyz, /status) 监听端口, 为 0 时不开启
 }
 
 // AnkrCfg 定义 Ankr RPC 节点的配置
@@ -0,0 +1,310 @@
+package listingvalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/chain/chainclient"
+	"github.com/ProjectsTask/EasySwapBase/chain/nftchainservice"
+	"github.com/ProjectsTask/EasySwapBase/health"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/ordermanager"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+)
+
+const (
+	DefaultInterval      = 120 // 默认校验周期 (秒)
+	DefaultPerCollection = 20  // 默认每个集合检查的挂单数
+	DefaultBatchSize     = 50  // 默认每次批量 RPC 请求包含的挂单数
+)
+
+// Service 挂单有效性校验服务
+// 按集合优先检查价格最低的挂单: 链下签名挂单要求 Maker 持有 NFT 并对 Vault 授权, 链上挂单要求 NFT 仍托管在 Vault 中.
+// 不满足时将挂单置为无效 (OrderStatusInactive), 重新满足时恢复为活跃, 并推送 UpdateCollection 事件刷新地板价
+type Service struct {
+	ctx         context.Context
+	db          *gorm.DB
+	kv          *xkv.Store
+	chainClient chainclient.ChainClient
+	chain       string
+	vault       common.Address
+
+	interval      time.Duration
+	perCollection int
+	batchSize     int
+
+	heartbeat  *health.Heartbeat
+	supervisor *supervisor.Supervisor
+}
+
+// listing 待校验的挂单
+type listing struct {
+	ID                int64  `gorm:"column:id"`
+	OrderID           string `gorm:"column:order_id"`
+	CollectionAddress string `gorm:"column:collection_address"`
+	TokenId           string `gorm:"column:token_id"`
+	Maker             string `gorm:"column:maker"`
+	OrderStatus       int    `gorm:"column:order_status"`
+	Signature         string `gorm:"column:signature"`
+}
+
+// Result 一轮校验结果
+type Result struct {
+	Checked     int
+	Invalidated int
+	Restored    int
+	Collections []string // 挂单状态发生变化的集合
+}
+
+// New 初始化挂单有效性校验服务
+func New(ctx context.Context, cfg *config.Config, db *gorm.DB, kv *xkv.Store, chainClient chainclient.ChainClient, chain string) *Service {
+	s := &Service{
+		ctx:           ctx,
+		db:            db,
+		kv:            kv,
+		chainClient:   chainClient,
+		chain:         chain,
+		vault:         common.HexToAddress(cfg.ContractCfg.VaultAddress),
+		interval:      DefaultInterval * time.Second,
+		perCollection: DefaultPerCollection,
+		batchSize:     DefaultBatchSize,
+		supervisor:    supervisor.New(ctx),
+	}
+	if c := cfg.ListingValidator; c != nil {
+		if c.Interval > 0 {
+			s.interval = time.Duration(c.Interval) * time.Second
+		}
+		if c.PerCollection > 0 {
+			s.perCollection = c.PerCollection
+		}
+		if c.BatchSize > 0 {
+			s.batchSize = c.BatchSize
+		}
+	}
+	return s
+}
+
+// Start 启动后台校验任务, 循环异常退出后由 supervisor 退避重启
+func (s *Service) Start() {
+	s.heartbeat = health.NewHeartbeat("listing_validator", 3*s.interval)
+	s.supervisor.Go("listing_validator", s.ValidateLoop)
+}
+
+// Stop 停止后台校验任务, 等待当前批次处理完成, ctx 到期时返回错误
+func (s *Service) Stop(ctx context.Context) error {
+	return s.supervisor.Shutdown(ctx)
+}
+
+// ValidateLoop 挂单有效性校验循环
+func (s *Service) ValidateLoop(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.heartbeat.Beat()
+		select {
+		case <-ctx.Done():
+			xzap.WithContext(s.ctx).Info("ValidateLoop stopped due to context cancellation")
+			return nil
+		case <-ticker.C:
+		}
+
+		result, err := s.Validate(s.ctx)
+		if err != nil {
+			xzap.WithContext(s.ctx).Error("failed on validate listings", zap.Error(err))
+			continue
+		}
+		xzap.WithContext(s.ctx).Info("validate listings finished",
+			zap.Int("checked", result.Checked),
+			zap.Int("invalidated", result.Invalidated),
+			zap.Int("restored", result.Restored))
+	}
+}
+
+// Validate 校验各集合价格最低的挂单, 更新挂单状态并推送地板价更新事件
+func (s *Service) Validate(ctx context.Context) (*Result, error) {
+	listings, err := s.queryListings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcClient, err := s.rpcClient()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	changed := make(map[string]bool)
+	for start := 0; start < len(listings); start += s.batchSize {
+		end := start + s.batchSize
+		if end > len(listings) {
+			end = len(listings)
+		}
+		batch := listings[start:end]
+
+		refs := make([]nftchainservice.Listing, len(batch))
+		for i, l := range batch {
+			tokenId, _ := new(big.Int).SetString(l.TokenId, 10)
+			refs[i] = nftchainservice.Listing{
+				Collection: common.HexToAddress(l.CollectionAddress),
+				TokenId:    tokenId,
+				Maker:      common.HexToAddress(l.Maker),
+			}
+		}
+		states, err := nftchainservice.BatchListingStates(ctx, rpcClient, s.vault, refs)
+		if err != nil {
+			return result, err
+		}
+
+		for i := range batch {
+			if states[i].Err != nil {
+				xzap.WithContext(s.ctx).Warn("failed on get listing state",
+					zap.String("order_id", batch[i].OrderID), zap.Error(states[i].Err))
+				continue
+			}
+			result.Checked++
+			status, err := s.apply(ctx, &batch[i], &states[i])
+			if err != nil {
+				return result, err
+			}
+			switch status {
+			case multi.OrderStatusInactive:
+				result.Invalidated++
+			case multi.OrderStatusActive:
+				result.Restored++
+			default:
+				continue
+			}
+			changed[strings.ToLower(batch[i].CollectionAddress)] = true
+		}
+	}
+
+	for collection := range changed {
+		result.Collections = append(result.Collections, collection)
+		s.pushFloorUpdate(ctx, collection)
+	}
+	return result, nil
+}
+
+// queryListings 查询各集合价格最低的未过期挂单, 包含已被置为无效的挂单以便恢复
+func (s *Service) queryListings(ctx context.Context) ([]listing, error) {
+	sql := fmt.Sprintf(`SELECT id, order_id, collection_address, token_id, maker, order_status, signature FROM (
+    SELECT id, order_id, collection_address, token_id, maker, order_status, signature,
+           ROW_NUMBER() OVER (PARTITION BY collection_address ORDER BY price ASC, id ASC) AS rn
+    FROM %s
+    WHERE marketplace_id = ? and order_type = ? and order_status in (?) and expire_time > ?
+) t WHERE t.rn <= ?`, multi.OrderTableName(s.chain))
+
+	var listings []listing
+	if err := s.db.WithContext(ctx).Raw(sql,
+		multi.MarketOrderBook,
+		multi.ListingOrder,
+		[]int{multi.OrderStatusActive, multi.OrderStatusInactive},
+		time.Now().Unix(),
+		s.perCollection,
+	).Scan(&listings).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query listings")
+	}
+	return listings, nil
+}
+
+// valid 判断挂单是否可成交
+// 链上挂单在 makeOrder 时 NFT 已转入 Vault 托管, 只需校验 NFT 仍在 Vault 中; 链下签名挂单成交时才从 Maker 转出, 需持有并授权
+func (s *Service) valid(l *listing, state *nftchainservice.ListingState) bool {
+	if l.Signature == "" {
+		return s.vault == (common.Address{}) || state.Owner == s.vault
+	}
+	return state.Valid(common.HexToAddress(l.Maker), s.vault)
+}
+
+// apply 按校验结果更新挂单状态, 返回变化后的状态, 未变化时返回 -1
+// 链下签名挂单的 NFT 已转给他人时同步更新 item 的持有人, 使地板价及 item 查询中的 maker = owner 过滤生效
+func (s *Service) apply(ctx context.Context, l *listing, state *nftchainservice.ListingState) (int, error) {
+	if l.Signature != "" && state.Owner != (common.Address{}) && state.Owner != common.HexToAddress(l.Maker) {
+		if err := s.db.WithContext(ctx).Table(multi.ItemTableName(s.chain)).
+			Where("collection_address = ? and token_id = ?", strings.ToLower(l.CollectionAddress), l.TokenId).
+			Update("owner", strings.ToLower(state.Owner.String())).Error; err != nil {
+			return -1, errors.Wrap(err, "failed on update item owner")
+		}
+	}
+
+	status := multi.OrderStatusInactive
+	if s.valid(l, state) {
+		status = multi.OrderStatusActive
+	}
+	if status == l.OrderStatus {
+		return -1, nil
+	}
+
+	result := s.db.WithContext(ctx).Table(multi.OrderTableName(s.chain)).
+		Where("id = ? and order_status = ?", l.ID, l.OrderStatus).
+		Update("order_status", status)
+	if result.Error != nil {
+		return -1, errors.Wrapf(result.Error, "failed on update listing status, order_id: %s", l.OrderID)
+	}
+	if result.RowsAffected == 0 {
+		// 期间已成交、取消或过期
+		return -1, nil
+	}
+
+	label := "restored"
+	if status == multi.OrderStatusInactive {
+		label = "invalidated"
+	}
+	metrics.ListingValidityChanges.WithLabelValues(s.chain, label).Inc()
+	xzap.WithContext(s.ctx).Info("listing validity changed",
+		zap.String("order_id", l.OrderID),
+		zap.String("collection_address", l.CollectionAddress),
+		zap.String("token_id", l.TokenId),
+		zap.String("owner", state.Owner.String()),
+		zap.String("status", label))
+	return status, nil
+}
+
+// pushFloorUpdate 推送 UpdateCollection 事件, 订单管理器在地板价变化时重新加载集合挂单
+func (s *Service) pushFloorUpdate(ctx context.Context, collection string) {
+	var floor decimal.Decimal
+	if err := s.db.WithContext(ctx).Table(multi.OrderTableName(s.chain)).
+		Select("coalesce(min(price), 0)").
+		Where("collection_address = ? and order_type = ? and order_status = ? and expire_time > ?",
+			collection, multi.ListingOrder, multi.OrderStatusActive, time.Now().Unix()).
+		Scan(&floor).Error; err != nil {
+		xzap.WithContext(s.ctx).Error("failed on query collection floor price",
+			zap.String("collection_addr", collection), zap.Error(err))
+		return
+	}
+
+	if err := ordermanager.AddUpdatePriceEvent(s.kv, &ordermanager.TradeEvent{
+		CollectionAddr: collection,
+		EventType:      ordermanager.UpdateCollection,
+		Price:          floor,
+	}, s.chain); err != nil {
+		xzap.WithContext(s.ctx).Error("failed on add update price event",
+			zap.Error(err),
+			zap.String("type", "update_collection"),
+			zap.String("collection_addr", collection))
+	}
+}
+
+func (s *Service) rpcClient() (*rpc.Client, error) {
+	client, ok := s.chainClient.Client().(*ethclient.Client)
+	if !ok {
+		return nil, errors.New("unsupported chain client")
+	}
+	return client.Client(), nil
+}
@@ -0,0 +1,35 @@
+package listingvalidator
+
+import (
+	"testing"
+
+	"github.com/ProjectsTask/EasySwapBase/chain/nftchainservice"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestValid(t *testing.T) {
+	maker := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	vault := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	s := &Service{vault: vault}
+
+	// 链上挂单: NFT 托管在 Vault 中
+	onChain := &listing{Maker: maker.String()}
+	if !s.valid(onChain, &nftchainservice.ListingState{Owner: vault}) {
+		t.Fatal("expected escrowed listing to be valid")
+	}
+	if s.valid(onChain, &nftchainservice.ListingState{Owner: maker}) {
+		t.Fatal("expected listing withdrawn from vault to be invalid")
+	}
+
+	// 链下签名挂单: Maker 持有并授权 Vault
+	signed := &listing{Maker: maker.String(), Signature: "0x01"}
+	if !s.valid(signed, &nftchainservice.ListingState{Owner: maker, ApprovedForAll: true}) {
+		t.Fatal("expected approved signed listing to be valid")
+	}
+	if s.valid(signed, &nftchainservice.ListingState{Owner: maker}) {
+		t.Fatal("expected signed listing without approval to be invalid")
+	}
+	if s.valid(signed, &nftchainservice.ListingState{Owner: common.HexToAddress("0x01"), ApprovedForAll: true}) {
+		t.Fatal("expected transferred signed listing to be invalid")
+	}
+}
@@ -0,0 +1,296 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/health"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/notify"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+)
+
+const (
+	DefaultInterval       = 10   // 默认投递周期 (秒)
+	DefaultScanInterval   = 60   // 默认挂单过期及地板价扫描周期 (秒)
+	DefaultExpiryNotice   = 3600 // 默认挂单过期前提醒时间 (秒)
+	DefaultWebhookTimeout = 10   // 默认 webhook 请求超时 (秒)
+
+	// expiredWindow 只通知该时间内过期的挂单, 避免首次开启时为历史挂单批量生成通知
+	expiredWindow = 24 * time.Hour
+	scanBatchSize = 500
+)
+
+// Service 用户通知后台服务
+// 投递待处理的 webhook / 邮件通知; 扫描即将过期与已过期的挂单, 以及地板价低于用户阈值的集合并生成通知
+type Service struct {
+	ctx     context.Context
+	db      *gorm.DB
+	chain   string
+	chainId int64
+
+	interval      time.Duration
+	scanInterval  time.Duration
+	expiryNotice  time.Duration
+	scanBatchSize int
+	dispatcher    *notify.Dispatcher
+
+	dispatchHeartbeat *health.Heartbeat
+	scanHeartbeat     *health.Heartbeat
+	supervisor        *supervisor.Supervisor
+}
+
+// New 初始化用户通知服务, 配置了 SMTP 服务器时才投递邮件
+func New(ctx context.Context, cfg *config.Config, db *gorm.DB, chain string, chainId int64) *Service {
+	s := &Service{
+		ctx:           ctx,
+		db:            db,
+		chain:         chain,
+		chainId:       chainId,
+		interval:      DefaultInterval * time.Second,
+		scanInterval:  DefaultScanInterval * time.Second,
+		expiryNotice:  DefaultExpiryNotice * time.Second,
+		scanBatchSize: scanBatchSize,
+		supervisor:    supervisor.New(ctx),
+	}
+	webhookTimeout := DefaultWebhookTimeout * time.Second
+	var batchSize, maxAttempts int
+	var smtp *notify.SMTPConfig
+	if c := cfg.Notification; c != nil {
+		if c.Interval > 0 {
+			s.interval = time.Duration(c.Interval) * time.Second
+		}
+		if c.ScanInterval > 0 {
+			s.scanInterval = time.Duration(c.ScanInterval) * time.Second
+		}
+		if c.ExpiryNotice > 0 {
+			s.expiryNotice = time.Duration(c.ExpiryNotice) * time.Second
+		}
+		if c.WebhookTimeout > 0 {
+			webhookTimeout = time.Duration(c.WebhookTimeout) * time.Second
+		}
+		batchSize, maxAttempts, smtp = c.BatchSize, c.MaxAttempts, c.SMTP
+	}
+
+	channels := []notify.Channel{notify.NewWebhookChannel(webhookTimeout)}
+	if smtp != nil && smtp.Host != "" {
+		channels = append(channels, notify.NewEmailChannel(*smtp))
+	}
+	s.dispatcher = notify.NewDispatcher(db, batchSize, maxAttempts, channels...)
+	return s
+}
+
+// Start 启动投递及扫描任务, 循环异常退出后由 supervisor 退避重启
+func (s *Service) Start() {
+	s.dispatchHeartbeat = health.NewHeartbeat("notification_dispatch", 3*s.interval)
+	s.scanHeartbeat = health.NewHeartbeat("notification_scan", 3*s.scanInterval)
+	s.supervisor.Go("notification_dispatch", s.DispatchLoop)
+	s.supervisor.Go("notification_scan", s.ScanLoop)
+}
+
+// Stop 停止投递及扫描任务, 等待当前批次处理完成, ctx 到期时返回错误
+func (s *Service) Stop(ctx context.Context) error {
+	return s.supervisor.Shutdown(ctx)
+}
+
+// DispatchLoop 通知投递循环, 一批处理满时立即处理下一批
+func (s *Service) DispatchLoop(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.dispatchHeartbeat.Beat()
+		select {
+		case <-ctx.Done():
+			xzap.WithContext(s.ctx).Info("DispatchLoop stopped due to context cancellation")
+			return nil
+		case <-ticker.C:
+		}
+
+		for ctx.Err() == nil {
+			n, err := s.dispatcher.DispatchOnce(s.ctx)
+			if err != nil {
+				xzap.WithContext(s.ctx).Error("failed on dispatch notifications", zap.Error(err))
+				break
+			}
+			if n == 0 {
+				break
+			}
+		}
+	}
+}
+
+// ScanLoop 挂单过期及地板价提醒扫描循环
+func (s *Service) ScanLoop(ctx context.Context) error {
+	ticker := time.NewTicker(s.scanInterval)
+	defer ticker.Stop()
+
+	for {
+		s.scanHeartbeat.Beat()
+		select {
+		case <-ctx.Done():
+			xzap.WithContext(s.ctx).Info("ScanLoop stopped due to context cancellation")
+			return nil
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		if err := s.ScanListings(s.ctx, now); err != nil {
+			xzap.WithContext(s.ctx).Error("failed on scan expiring listings", zap.Error(err))
+		}
+		if err := s.ScanFloorAlerts(s.ctx, now); err != nil {
+			xzap.WithContext(s.ctx).Error("failed on scan floor alerts", zap.Error(err))
+		}
+	}
+}
+
+// ScanListings 为即将过期及刚过期的挂单生成通知, 同一挂单每种通知只生成一次
+func (s *Service) ScanListings(ctx context.Context, now time.Time) error {
+	if err := s.scanListings(ctx, base.NotificationListingExpiring, []int{multi.OrderStatusActive},
+		now.Unix(), now.Add(s.expiryNotice).Unix()); err != nil {
+		return err
+	}
+	return s.scanListings(ctx, base.NotificationListingExpired, []int{multi.OrderStatusActive, multi.OrderStatusExpired},
+		now.Add(-expiredWindow).Unix(), now.Unix())
+}
+
+// scanListings 分页为过期时间在 [from, to) 内的挂单生成 kind 类通知
+func (s *Service) scanListings(ctx context.Context, kind string, statuses []int, from, to int64) error {
+	return s.pageListings(ctx, kind, statuses, from, to, func(order *multi.Order) {
+		s.publish(ctx, listingNotification(kind, order))
+	})
+}
+
+// listingCursor 挂单分页游标, 按 (expire_time, id) 排序
+type listingCursor struct {
+	ExpireTime int64
+	ID         int64
+}
+
+// pageListings 按 (expire_time, id) 翻页查询过期时间在 [from, to) 内仍有剩余数量、尚未生成 kind 类通知的挂单
+// 已生成通知的挂单在查询中排除, 未能生成通知 (如用户已屏蔽) 的挂单由游标跳过, 不会阻塞后续挂单
+func (s *Service) pageListings(ctx context.Context, kind string, statuses []int, from, to int64, fn func(order *multi.Order)) error {
+	var cursor listingCursor
+	for ctx.Err() == nil {
+		orders, err := s.queryListings(ctx, kind, statuses, from, to, cursor)
+		if err != nil {
+			return err
+		}
+		for i := range orders {
+			fn(&orders[i])
+		}
+		if len(orders) < s.scanBatchSize {
+			return nil
+		}
+		last := orders[len(orders)-1]
+		cursor = listingCursor{ExpireTime: last.ExpireTime, ID: last.ID}
+	}
+	return nil
+}
+
+// queryListings 查询游标之后的一页挂单
+func (s *Service) queryListings(ctx context.Context, kind string, statuses []int, from, to int64, cursor listingCursor) ([]multi.Order, error) {
+	var orders []multi.Order
+	if err := s.db.WithContext(ctx).Table(multi.OrderTableName(s.chain)+" as o").
+		Select("o.id, o.order_id, o.maker, o.collection_address, o.token_id, o.price, o.expire_time").
+		Where("o.order_type = ? and o.order_status in (?) and o.quantity_remaining > 0 and o.expire_time >= ? and o.expire_time < ?",
+			multi.ListingOrder, statuses, from, to).
+		Where("o.expire_time > ? or (o.expire_time = ? and o.id > ?)", cursor.ExpireTime, cursor.ExpireTime, cursor.ID).
+		Where(fmt.Sprintf("not exists (select 1 from %s n where n.event_key = concat(?, ':', o.order_id))", base.NotificationTableName()), kind).
+		Order("o.expire_time asc, o.id asc").
+		Limit(s.scanBatchSize).
+		Find(&orders).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on query listings")
+	}
+	return orders, nil
+}
+
+func listingNotification(kind string, order *multi.Order) *base.Notification {
+	return &base.Notification{
+		UserAddress:       order.Maker,
+		Kind:              kind,
+		EventKey:          fmt.Sprintf("%s:%s", kind, order.OrderID),
+		CollectionAddress: order.CollectionAddress,
+		TokenId:           order.TokenId,
+		OrderId:           order.OrderID,
+		Price:             order.Price,
+		Payload:           fmt.Sprintf(`{"expire_time":%d}`, order.ExpireTime),
+	}
+}
+
+// floorAlert 地板价提醒及集合当前地板价
+type floorAlert struct {
+	base.NotificationFloorAlert
+	FloorPrice decimal.Decimal `gorm:"column:floor_price"`
+}
+
+// ScanFloorAlerts 地板价低于阈值时通知并标记已触发, 回到阈值以上后重置以便再次提醒
+func (s *Service) ScanFloorAlerts(ctx context.Context, now time.Time) error {
+	var lastID int64
+	for {
+		var alerts []floorAlert
+		if err := s.db.WithContext(ctx).Table(base.NotificationFloorAlertTableName()+" as fa").
+			Select("fa.*, co.floor_price").
+			Joins(fmt.Sprintf("join %s as co on co.address = fa.collection_address", multi.CollectionTableName(s.chain))).
+			Where("fa.chain_id = ? and fa.id > ?", s.chainId, lastID).
+			Order("fa.id asc").
+			Limit(s.scanBatchSize).
+			Find(&alerts).Error; err != nil {
+			return errors.Wrap(err, "failed on query floor alerts")
+		}
+
+		for i := range alerts {
+			alert := &alerts[i]
+			triggered, changed := floorTriggered(alert.FloorPrice, alert.Threshold, alert.Triggered)
+			if !changed {
+				continue
+			}
+			if triggered {
+				s.publish(ctx, &base.Notification{
+					UserAddress:       alert.UserAddress,
+					Kind:              base.NotificationFloorDrop,
+					EventKey:          fmt.Sprintf("%s:%d:%d", base.NotificationFloorDrop, alert.Id, now.Unix()),
+					CollectionAddress: alert.CollectionAddress,
+					Price:             alert.FloorPrice,
+					Payload:           fmt.Sprintf(`{"threshold":"%s"}`, alert.Threshold.String()),
+				})
+			}
+			if err := s.db.WithContext(ctx).Table(base.NotificationFloorAlertTableName()).
+				Where("id = ?", alert.Id).
+				Update("triggered", triggered).Error; err != nil {
+				return errors.Wrap(err, "failed on update floor alert")
+			}
+		}
+
+		if len(alerts) < s.scanBatchSize {
+			return nil
+		}
+		lastID = alerts[len(alerts)-1].Id
+	}
+}
+
+// floorTriggered 根据当前地板价计算提醒是否处于触发状态, 并返回状态是否变化; 地板价为 0 (无挂单) 时保持不变
+func floorTriggered(floor, threshold decimal.Decimal, triggered bool) (bool, bool) {
+	if floor.Sign() <= 0 {
+		return triggered, false
+	}
+	below := floor.LessThan(threshold)
+	return below, below != triggered
+}
+
+func (s *Service) publish(ctx context.Context, n *base.Notification) {
+	n.ChainId = s.chainId
+	if _, err := notify.Publish(ctx, s.db, n); err != nil {
+		xzap.WithContext(s.ctx).Error("failed on publish notification",
+			zap.Error(err), zap.String("kind", n.Kind), zap.String("event_key", n.EventKey))
+	}
+}
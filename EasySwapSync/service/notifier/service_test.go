@@ -0,0 +1,95 @@
+package notifier
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+)
+
+func TestNew(t *testing.T) {
+	s := New(context.Background(), &config.Config{}, nil, "sepolia", 11155111)
+	if s.interval != DefaultInterval*time.Second || s.scanInterval != DefaultScanInterval*time.Second ||
+		s.expiryNotice != DefaultExpiryNotice*time.Second {
+		t.Fatalf("unexpected defaults: %s, %s, %s", s.interval, s.scanInterval, s.expiryNotice)
+	}
+
+	s = New(context.Background(), &config.Config{Notification: &config.NotificationCfg{
+		Enable: true, Interval: 5, ScanInterval: 30, ExpiryNotice: 600,
+	}}, nil, "sepolia", 11155111)
+	if s.interval != 5*time.Second || s.scanInterval != 30*time.Second || s.expiryNotice != 10*time.Minute {
+		t.Fatalf("unexpected config: %s, %s, %s", s.interval, s.scanInterval, s.expiryNotice)
+	}
+}
+
+func TestFloorTriggered(t *testing.T) {
+	threshold := decimal.NewFromInt(100)
+	cases := []struct {
+		floor     int64
+		triggered bool
+		want      bool
+		changed   bool
+	}{
+		{floor: 90, triggered: false, want: true, changed: true},
+		{floor: 90, triggered: true, want: true, changed: false},
+		{floor: 100, triggered: true, want: false, changed: true},
+		{floor: 120, triggered: false, want: false, changed: false},
+		{floor: 0, triggered: false, want: false, changed: false},
+		{floor: 0, triggered: true, want: true, changed: false},
+	}
+	for _, c := range cases {
+		got, changed := floorTriggered(decimal.NewFromInt(c.floor), threshold, c.triggered)
+		if got != c.want || changed != c.changed {
+			t.Fatalf("floor %d triggered %v: got %v, %v", c.floor, c.triggered, got, changed)
+		}
+	}
+}
+
+func TestListingNotification(t *testing.T) {
+	n := listingNotification(base.NotificationListingExpiring, &multi.Order{OrderID: "0x01", Maker: "0xabc", ExpireTime: 1700000000})
+	if n.EventKey != "listing_expiring:0x01" || n.UserAddress != "0xabc" || n.Payload != `{"expire_time":1700000000}` {
+		t.Fatalf("unexpected notification %+v", n)
+	}
+}
+
+func TestPageListings(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	s := New(context.Background(), &config.Config{}, db, "sepolia", 11155111)
+	s.scanBatchSize = 2
+
+	columns := []string{"id", "order_id", "maker", "expire_time"}
+	query := regexp.QuoteMeta("FROM ob_order_sepolia as o WHERE")
+	// 第一页从头开始, 已生成通知的挂单在查询中排除
+	mock.ExpectQuery(query+".*not exists \\(select 1 from ob_notification n where n.event_key = concat\\(\\?, ':', o.order_id\\)\\)").
+		WithArgs(multi.ListingOrder, multi.OrderStatusActive, 100, 200, 0, 0, 0, base.NotificationListingExpiring).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(3, "0x3", "0xa", 150).AddRow(5, "0x5", "0xa", 160))
+	// 第二页从上一页最后一条之后继续
+	mock.ExpectQuery(query).
+		WithArgs(multi.ListingOrder, multi.OrderStatusActive, 100, 200, 160, 160, 5, base.NotificationListingExpiring).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(4, "0x4", "0xb", 170))
+
+	var seen []string
+	err = s.pageListings(context.Background(), base.NotificationListingExpiring, []int{multi.OrderStatusActive}, 100, 200,
+		func(order *multi.Order) { seen = append(seen, order.OrderID) })
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0x3", "0x5", "0x4"}, seen)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
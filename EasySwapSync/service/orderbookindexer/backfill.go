@@ -0,0 +1,414 @@
+package orderbookindexer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ProjectsTask/EasySwapBase/chain/types"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultBackfillWorkers = 4
+	reindexBatchSize       = 500 // 按订单 ID 批量删除/重置时每批数量
+)
+
+// LogEvent 订单簿合约事件摘要, 用于 dry-run 输出
+type LogEvent struct {
+	Event       string   `json:"event"`
+	BlockNumber uint64   `json:"block_number"`
+	TxHash      string   `json:"tx_hash"`
+	LogIndex    uint     `json:"log_index"`
+	OrderIDs    []string `json:"order_ids"`
+	Error       string   `json:"error,omitempty"` // 非 dry-run 时处理失败的原因
+}
+
+// ReplayResult 事件重放结果
+type ReplayResult struct {
+	Events []LogEvent      `json:"events"`
+	Counts map[string]int  `json:"counts"` // 事件名 -> 数量
+	Failed int             `json:"failed"`
+	Wiped  *ReindexCleanup `json:"wiped,omitempty"` // 仅 reindex
+}
+
+// ReindexCleanup reindex 清理的派生数据
+type ReindexCleanup struct {
+	FromBlock      uint64 `json:"from_block"`
+	ToBlock        uint64 `json:"to_block"`
+	Activities     int64  `json:"activities"`      // 删除的活动记录
+	Fills          int64  `json:"fills"`           // 删除的成交记录
+	ProtocolShares int64  `json:"protocol_shares"` // 删除的协议手续费率变更记录
+	OrdersDeleted  int64  `json:"orders_deleted"`  // 删除的区间内挂出的订单
+	OrdersReset    int64  `json:"orders_reset"`    // 区间前挂出、区间内成交/取消, 重置为有效状态的订单
+	ordersToDelete []string
+	ordersToReset  []string
+}
+
+// FetchLogs 并发拉取 [from, to] 区间内订单簿合约日志, 按区块号及日志序号排序返回
+// 区间按 SyncBlockPeriod 切分, 最多 workers 个请求同时进行
+func (s *Service) FetchLogs(ctx context.Context, from, to uint64, workers int) ([]ethereumTypes.Log, error) {
+	if from > to {
+		return nil, errors.Errorf("invalid block range [%d, %d]", from, to)
+	}
+	if workers <= 0 {
+		workers = DefaultBackfillWorkers
+	}
+
+	type chunk struct{ from, to uint64 }
+	var chunks []chunk
+	for start := from; start <= to; start += SyncBlockPeriod + 1 {
+		end := start + SyncBlockPeriod
+		if end > to {
+			end = to
+		}
+		chunks = append(chunks, chunk{from: start, to: end})
+	}
+
+	results := make([][]ethereumTypes.Log, len(chunks))
+	errs := make([]error, len(chunks))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, c := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, c chunk) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			logs, err := s.chainClient.FilterLogs(ctx, types.FilterQuery{
+				FromBlock: new(big.Int).SetUint64(c.from),
+				ToBlock:   new(big.Int).SetUint64(c.to),
+				Addresses: []string{s.cfg.ContractCfg.DexAddress},
+			})
+			if err != nil {
+				errs[i] = errors.Wrapf(err, "failed on get log, block range [%d, %d]", c.from, c.to)
+				return
+			}
+			for _, log := range logs {
+				results[i] = append(results[i], log.(ethereumTypes.Log))
+			}
+		}(i, c)
+	}
+	wg.Wait()
+
+	var logs []ethereumTypes.Log
+	for i := range chunks {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		logs = append(logs, results[i]...)
+	}
+	sortLogs(logs)
+	return logs, nil
+}
+
+// TxLogs 获取交易回执中订单簿合约的日志
+func (s *Service) TxLogs(ctx context.Context, txHash string) ([]ethereumTypes.Log, error) {
+	client, ok := s.chainClient.Client().(*ethclient.Client)
+	if !ok {
+		return nil, errors.New("chain client does not support transaction receipt")
+	}
+	receipt, err := client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed on get transaction receipt")
+	}
+
+	dexAddress := common.HexToAddress(s.cfg.ContractCfg.DexAddress)
+	var logs []ethereumTypes.Log
+	for _, log := range receipt.Logs {
+		if log.Address == dexAddress {
+			logs = append(logs, *log)
+		}
+	}
+	return logs, nil
+}
+
+// Replay 按顺序处理日志, 不更新 ob_indexed_status 中的同步进度
+// 日志拉取可以并发, 但同一订单的挂单、成交、取消事件存在先后依赖, 因此处理必须串行
+// dryRun 时只解析事件, 不写入任何数据; 重放期间不发送依赖当前订单簿状态的被超越通知
+func (s *Service) Replay(logs []ethereumTypes.Log, dryRun bool) *ReplayResult {
+	result := &ReplayResult{Counts: make(map[string]int)}
+	s.replaying = true
+	defer func() { s.replaying = false }()
+	for _, log := range logs {
+		event, ok := s.describeLog(log)
+		if !ok {
+			continue
+		}
+		if !dryRun {
+			if err := s.handleLog(log); err != nil {
+				event.Error = err.Error()
+				result.Failed++
+			}
+		}
+		result.Counts[event.Event]++
+		result.Events = append(result.Events, event)
+	}
+	return result
+}
+
+// Backfill 拉取并处理 [from, to] 区间内的订单簿事件, 不移动实时同步进度
+func (s *Service) Backfill(ctx context.Context, from, to uint64, workers int, dryRun bool) (*ReplayResult, error) {
+	logs, err := s.FetchLogs(ctx, from, to, workers)
+	if err != nil {
+		return nil, err
+	}
+	return s.Replay(logs, dryRun), nil
+}
+
+// Reindex 清理 from 之后 (直到当前同步进度) 的派生数据并重新处理该区间事件
+// 1. 删除区间内的订单簿活动记录
+// 2. 删除区间内挂出的订单, 区间前挂出、区间内成交或取消的订单重置为有效状态
+// 3. 按顺序重新处理区间内的事件
+// 需在 daemon 停止时执行; 区间前已部分成交的多数量出价会按全量重置后再扣减区间内的成交
+func (s *Service) Reindex(ctx context.Context, from uint64, workers int, dryRun bool) (*ReplayResult, error) {
+	to, err := s.syncedBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		return nil, errors.Errorf("from block %d is not indexed yet, last synced block: %d", from, to)
+	}
+
+	logs, err := s.FetchLogs(ctx, from, to, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	cleanup, err := s.planReindex(ctx, from, to, logs)
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun {
+		if err := s.wipeDerivedRows(ctx, cleanup); err != nil {
+			return nil, err
+		}
+	}
+
+	result := s.Replay(logs, dryRun)
+	result.Wiped = cleanup
+	return result, nil
+}
+
+// planReindex 统计 reindex 需要清理的数据
+func (s *Service) planReindex(ctx context.Context, from, to uint64, logs []ethereumTypes.Log) (*ReindexCleanup, error) {
+	cleanup := &ReindexCleanup{FromBlock: from, ToBlock: to}
+
+	made := make(map[string]bool)
+	touched := make(map[string]bool)
+	for _, log := range logs {
+		event, ok := s.describeLog(log)
+		if !ok {
+			continue
+		}
+		if event.Event == eventNames[LogMakeTopic] {
+			for _, id := range event.OrderIDs {
+				made[id] = true
+			}
+			continue
+		}
+		for _, id := range event.OrderIDs {
+			touched[id] = true
+		}
+	}
+	for id := range made {
+		cleanup.ordersToDelete = append(cleanup.ordersToDelete, id)
+	}
+	for id := range touched {
+		if !made[id] {
+			cleanup.ordersToReset = append(cleanup.ordersToReset, id)
+		}
+	}
+	sort.Strings(cleanup.ordersToDelete)
+	sort.Strings(cleanup.ordersToReset)
+
+	var err error
+	if cleanup.OrdersDeleted, err = s.countOrders(ctx, cleanup.ordersToDelete); err != nil {
+		return nil, err
+	}
+	if cleanup.OrdersReset, err = s.countOrders(ctx, cleanup.ordersToReset); err != nil {
+		return nil, err
+	}
+	if err := s.db.WithContext(ctx).Table(multi.ActivityTableName(s.chain)).
+		Where("marketplace_id = ? and block_number >= ? and block_number <= ?", multi.MarketOrderBook, from, to).
+		Count(&cleanup.Activities).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on count activities")
+	}
+	if err := s.db.WithContext(ctx).Table(multi.FillTableName(s.chain)).
+		Where("block_number >= ? and block_number <= ?", from, to).
+		Count(&cleanup.Fills).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on count fills")
+	}
+	if err := s.db.WithContext(ctx).Table(multi.ProtocolShareTableName(s.chain)).
+		Where("block_number >= ? and block_number <= ?", from, to).
+		Count(&cleanup.ProtocolShares).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on count protocol shares")
+	}
+	return cleanup, nil
+}
+
+// syncedBlock 返回事件已处理完成的最后一个区块
+// ob_indexed_status.last_indexed_block 为下一个待同步的区块
+func (s *Service) syncedBlock(ctx context.Context) (uint64, error) {
+	var indexedStatus base.IndexedStatus
+	if err := s.db.WithContext(ctx).Table(base.IndexedStatusTableName()).
+		Where("chain_id = ? and index_type = ?", s.chainId, EventIndexType).
+		First(&indexedStatus).Error; err != nil {
+		return 0, errors.Wrap(err, "failed on get listing index status")
+	}
+	if indexedStatus.LastIndexedBlock <= 0 {
+		return 0, errors.New("orderbook events are not indexed yet")
+	}
+	return uint64(indexedStatus.LastIndexedBlock) - 1, nil
+}
+
+// countOrders 统计订单表中存在的订单数
+func (s *Service) countOrders(ctx context.Context, orderIDs []string) (int64, error) {
+	var total int64
+	for _, ids := range batches(orderIDs, reindexBatchSize) {
+		var count int64
+		if err := s.db.WithContext(ctx).Table(multi.OrderTableName(s.chain)).
+			Where("order_id in (?)", ids).
+			Count(&count).Error; err != nil {
+			return 0, errors.Wrap(err, "failed on count orders")
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// wipeDerivedRows 删除/重置 reindex 区间内的派生数据
+// 在同一事务中执行, 任一步失败时全部回滚, 避免活动、成交与订单状态只清理了一部分
+func (s *Service) wipeDerivedRows(ctx context.Context, cleanup *ReindexCleanup) error {
+	var activities, fills, protocolShares, ordersDeleted, ordersReset int64
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(multi.ActivityTableName(s.chain)).
+			Where("marketplace_id = ? and block_number >= ? and block_number <= ?", multi.MarketOrderBook, cleanup.FromBlock, cleanup.ToBlock).
+			Delete(&multi.Activity{})
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed on delete activities")
+		}
+		activities = result.RowsAffected
+
+		result = tx.Table(multi.FillTableName(s.chain)).
+			Where("block_number >= ? and block_number <= ?", cleanup.FromBlock, cleanup.ToBlock).
+			Delete(&multi.Fill{})
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed on delete fills")
+		}
+		fills = result.RowsAffected
+
+		result = tx.Table(multi.ProtocolShareTableName(s.chain)).
+			Where("block_number >= ? and block_number <= ?", cleanup.FromBlock, cleanup.ToBlock).
+			Delete(&multi.ProtocolShare{})
+		if result.Error != nil {
+			return errors.Wrap(result.Error, "failed on delete protocol shares")
+		}
+		protocolShares = result.RowsAffected
+
+		for _, ids := range batches(cleanup.ordersToDelete, reindexBatchSize) {
+			result := tx.Table(multi.OrderTableName(s.chain)).
+				Where("order_id in (?)", ids).
+				Delete(&multi.Order{})
+			if result.Error != nil {
+				return errors.Wrap(result.Error, "failed on delete orders")
+			}
+			ordersDeleted += result.RowsAffected
+		}
+
+		// 剩余数量及托管资产按区间前的成交记录恢复
+		orderTable, fillTable := multi.OrderTableName(s.chain), multi.FillTableName(s.chain)
+		remaining := fmt.Sprintf("(%s.size - (select coalesce(sum(f.amount), 0) from %s f where f.order_id = %s.order_id))",
+			orderTable, fillTable, orderTable)
+		for _, ids := range batches(cleanup.ordersToReset, reindexBatchSize) {
+			result := tx.Table(orderTable).
+				Where("order_id in (?)", ids).
+				Updates(map[string]interface{}{
+					"order_status":       multi.OrderStatusActive,
+					"quantity_remaining": gorm.Expr(remaining),
+					"taker":              ZeroAddress,
+					"escrow_eth":         gorm.Expr("if(order_type = ?, 0, price * "+remaining+")", multi.ListingOrder),
+					"escrow_nft":         gorm.Expr("order_type = ?", multi.ListingOrder),
+				})
+			if result.Error != nil {
+				return errors.Wrap(result.Error, "failed on reset orders")
+			}
+			ordersReset += result.RowsAffected
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	cleanup.Activities, cleanup.Fills, cleanup.ProtocolShares = activities, fills, protocolShares
+	cleanup.OrdersDeleted, cleanup.OrdersReset = ordersDeleted, ordersReset
+	return nil
+}
+
+// describeLog 解析订单簿事件名及涉及的订单 ID, 非订单簿事件返回 false
+func (s *Service) describeLog(log ethereumTypes.Log) (LogEvent, bool) {
+	if len(log.Topics) == 0 {
+		return LogEvent{}, false
+	}
+	name, ok := eventNames[log.Topics[0].String()]
+	if !ok {
+		return LogEvent{}, false
+	}
+
+	event := LogEvent{
+		Event:       name,
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash.String(),
+		LogIndex:    log.Index,
+	}
+	switch log.Topics[0].String() {
+	case LogMakeTopic: // OrderKey 为 data 中的第一个字段
+		values, err := s.parsedAbi.Unpack("LogMake", log.Data)
+		if err == nil && len(values) > 0 {
+			if key, ok := values[0].([32]byte); ok {
+				event.OrderIDs = []string{HexPrefix + hex.EncodeToString(key[:])}
+			}
+		}
+	case LogCancelTopic:
+		event.OrderIDs = []string{HexPrefix + hex.EncodeToString(log.Topics[1].Bytes())}
+	case LogMatchTopic:
+		event.OrderIDs = []string{
+			HexPrefix + hex.EncodeToString(log.Topics[1].Bytes()),
+			HexPrefix + hex.EncodeToString(log.Topics[2].Bytes()),
+		}
+	}
+	return event, true
+}
+
+// sortLogs 按区块号、日志序号排序
+func sortLogs(logs []ethereumTypes.Log) {
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+}
+
+func batches(ids []string, size int) [][]string {
+	var result [][]string
+	for start := 0; start < len(ids); start += size {
+		end := start + size
+		if end > len(ids) {
+			end = len(ids)
+		}
+		result = append(result, ids[start:end])
+	}
+	return result
+}
@@ -0,0 +1,282 @@
+package orderbookindexer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/chain/chainclient"
+	"github.com/ProjectsTask/EasySwapBase/chain/types"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	logging "github.com/ProjectsTask/EasySwapBase/logger"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+)
+
+func TestMain(m *testing.M) {
+	if _, err := xzap.SetUp(logging.LogConf{ServiceName: "orderbookindexer_test", Mode: "console", Path: os.TempDir(), Level: "error"}); err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestSortLogs(t *testing.T) {
+	logs := []ethereumTypes.Log{
+		{BlockNumber: 12, Index: 1},
+		{BlockNumber: 10, Index: 7},
+		{BlockNumber: 12, Index: 0},
+		{BlockNumber: 10, Index: 2},
+	}
+	sortLogs(logs)
+
+	expected := [][2]uint64{{10, 2}, {10, 7}, {12, 0}, {12, 1}}
+	for i, log := range logs {
+		if log.BlockNumber != expected[i][0] || uint64(log.Index) != expected[i][1] {
+			t.Fatalf("unexpected order at %d: block %d index %d", i, log.BlockNumber, log.Index)
+		}
+	}
+}
+
+func TestBatches(t *testing.T) {
+	ids := []string{"a", "b", "c", "d", "e"}
+	result := batches(ids, 2)
+	if len(result) != 3 || len(result[2]) != 1 || result[2][0] != "e" {
+		t.Fatalf("unexpected batches %v", result)
+	}
+	if len(batches(nil, 2)) != 0 {
+		t.Fatalf("expected no batch for empty ids")
+	}
+}
+
+const (
+	testMadeOrder   = "0xc773ae81bc9a186dc6c5d70a486730a6f734578ae1a0116acd0aaaf69250d265"
+	testCancelOrder = "0x00000000000000000000000000000000000000000000000000000000000000aa"
+	testSellOrder   = "0x00000000000000000000000000000000000000000000000000000000000000bb"
+	testBuyOrder    = "0x00000000000000000000000000000000000000000000000000000000000000cc"
+)
+
+// fakeChainClient 按区块区间返回预置日志, 合约调用按方法返回预置结果并记录查询的区块
+type fakeChainClient struct {
+	chainclient.ChainClient
+	logs    []ethereumTypes.Log
+	outputs map[string][]interface{}
+	blocks  []*big.Int
+}
+
+func (c *fakeChainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	c.blocks = append(c.blocks, blockNumber)
+	method, err := liborder.OrderBook.MethodById(msg.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(c.outputs[method.Name]...)
+}
+
+func (c *fakeChainClient) BlockTimeByNumber(ctx context.Context, number *big.Int) (uint64, error) {
+	return 1700000000 + number.Uint64(), nil
+}
+
+func (c *fakeChainClient) FilterLogs(ctx context.Context, q types.FilterQuery) ([]interface{}, error) {
+	var logs []interface{}
+	for _, log := range c.logs {
+		if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func newMockService(t *testing.T, logs []ethereumTypes.Log) (*Service, sqlmock.Sqlmock) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+
+	cfg := &config.Config{ContractCfg: config.ContractCfg{DexAddress: "0x0000000000000000000000000000000000000001"}}
+	return New(context.Background(), cfg, db, nil, &fakeChainClient{logs: logs}, 11155111, "sepolia", nil), mock
+}
+
+func testLogs(t *testing.T) []ethereumTypes.Log {
+	data, err := hex.DecodeString("c773ae81bc9a186dc6c5d70a486730a6f734578ae1a0116acd0aaaf69250d2650000000000000000000000000000000000000000000000000000000000000000000000000000000000000000e7f1725e7734ce288f8367e1bb143e90bb3f05120000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000002386f26fc10000000000000000000000000000000000000000000000000000000000006558875d0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	maker := common.HexToHash("0x000000000000000000000000f39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	return []ethereumTypes.Log{
+		{
+			Topics:      []common.Hash{common.HexToHash(LogMatchTopic), common.HexToHash(testSellOrder), common.HexToHash(testBuyOrder)},
+			BlockNumber: 120,
+			Index:       3,
+		},
+		{
+			Topics:      []common.Hash{common.HexToHash(LogMakeTopic), {}, {}, maker},
+			Data:        data,
+			BlockNumber: 105,
+			Index:       0,
+		},
+		{
+			Topics:      []common.Hash{common.HexToHash("0x1234")}, // 非订单簿事件
+			BlockNumber: 110,
+		},
+		{
+			Topics:      []common.Hash{common.HexToHash(LogCancelTopic), common.HexToHash(testCancelOrder), maker},
+			BlockNumber: 110,
+			Index:       1,
+		},
+	}
+}
+
+func TestReplayDryRun(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	logs := testLogs(t)
+	sortLogs(logs)
+
+	result := s.Replay(logs, true)
+	require.Len(t, result.Events, 3)
+	assert.Equal(t, []string{testMadeOrder}, result.Events[0].OrderIDs)
+	assert.Equal(t, []string{testCancelOrder}, result.Events[1].OrderIDs)
+	assert.Equal(t, []string{testSellOrder, testBuyOrder}, result.Events[2].OrderIDs)
+	assert.Equal(t, 1, result.Counts[eventNames[LogMakeTopic]])
+	assert.Equal(t, 1, result.Counts[eventNames[LogCancelTopic]])
+	assert.Equal(t, 1, result.Counts[eventNames[LogMatchTopic]])
+	assert.Zero(t, result.Failed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplayRecordsFailure(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE `ob_order_sepolia`").WillReturnError(errors.New("db down"))
+	mock.ExpectRollback()
+
+	result := s.Replay(testLogs(t)[3:], false)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t, 1, result.Failed)
+	assert.Contains(t, result.Events[0].Error, "db down")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestHandleProtocolShareEvent(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	txHash := common.HexToHash("0xaa")
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ob_protocol_share_sepolia`")).
+		WithArgs(int64(250), txHash.String(), int64(2), int64(130), int64(1700000130), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, s.handleLog(ethereumTypes.Log{
+		Topics:      []common.Hash{common.HexToHash(LogUpdatedProtocolShareTopic), common.BigToHash(big.NewInt(250))},
+		TxHash:      txHash,
+		BlockNumber: 130,
+		Index:       2,
+	}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReindexRejectsUnindexedBlock(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	mock.ExpectQuery("SELECT \\* FROM `ob_indexed_status`").
+		WillReturnRows(sqlmock.NewRows([]string{"chain_id", "last_indexed_block"}).AddRow(11155111, 100))
+
+	_, err := s.Reindex(context.Background(), 100, 2, true)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReindexDryRunPlansCleanup(t *testing.T) {
+	s, mock := newMockService(t, testLogs(t))
+	mock.ExpectQuery("SELECT \\* FROM `ob_indexed_status`").
+		WillReturnRows(sqlmock.NewRows([]string{"chain_id", "last_indexed_block"}).AddRow(11155111, 200))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `ob_order_sepolia` WHERE order_id in (?)")).
+		WithArgs(testMadeOrder).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `ob_order_sepolia` WHERE order_id in (?,?,?)")).
+		WithArgs(testCancelOrder, testSellOrder, testBuyOrder).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `ob_activity_sepolia`")).
+		WithArgs(0, 100, 199).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `ob_fill_sepolia`")).
+		WithArgs(100, 199).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `ob_protocol_share_sepolia`")).
+		WithArgs(100, 199).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	result, err := s.Reindex(context.Background(), 100, 2, true)
+	require.NoError(t, err)
+	require.NotNil(t, result.Wiped)
+	assert.Equal(t, uint64(100), result.Wiped.FromBlock)
+	assert.Equal(t, uint64(199), result.Wiped.ToBlock)
+	assert.Equal(t, int64(5), result.Wiped.Activities)
+	assert.Equal(t, int64(2), result.Wiped.Fills)
+	assert.Equal(t, int64(1), result.Wiped.ProtocolShares)
+	assert.Equal(t, int64(1), result.Wiped.OrdersDeleted)
+	assert.Equal(t, int64(2), result.Wiped.OrdersReset)
+	assert.Len(t, result.Events, 3)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWipeDerivedRowsInTransaction(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	cleanup := &ReindexCleanup{
+		FromBlock:      100,
+		ToBlock:        199,
+		ordersToDelete: []string{testMadeOrder},
+		ordersToReset:  []string{testCancelOrder, testSellOrder},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `ob_activity_sepolia`").WithArgs(0, 100, 199).WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec("DELETE FROM `ob_fill_sepolia`").WithArgs(100, 199).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM `ob_protocol_share_sepolia`").WithArgs(100, 199).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM `ob_order_sepolia`").WithArgs(testMadeOrder).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE `ob_order_sepolia`").WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	require.NoError(t, s.wipeDerivedRows(context.Background(), cleanup))
+	assert.Equal(t, int64(5), cleanup.Activities)
+	assert.Equal(t, int64(2), cleanup.Fills)
+	assert.Equal(t, int64(1), cleanup.ProtocolShares)
+	assert.Equal(t, int64(1), cleanup.OrdersDeleted)
+	assert.Equal(t, int64(2), cleanup.OrdersReset)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWipeDerivedRowsRollsBack(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	cleanup := &ReindexCleanup{
+		FromBlock:      100,
+		ToBlock:        199,
+		Activities:     5,
+		ordersToDelete: []string{testMadeOrder},
+		ordersToReset:  []string{testCancelOrder},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM `ob_activity_sepolia`").WillReturnResult(sqlmock.NewResult(0, 5))
+	mock.ExpectExec("DELETE FROM `ob_fill_sepolia`").WillReturnError(errors.New("lock wait timeout"))
+	mock.ExpectRollback()
+
+	err := s.wipeDerivedRows(context.Background(), cleanup)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed on delete fills")
+	assert.Equal(t, int64(5), cleanup.Activities) // 回滚后保留计划中的统计
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
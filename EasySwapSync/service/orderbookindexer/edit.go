@@ -0,0 +1,86 @@
+package orderbookindexer
+
+import (
+	"strings"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// txCancels 当前交易中已取消的订单
+// editOrders 在同一交易中先取消旧订单 (LogCancel) 再创建新订单 (LogMake), 事件按日志顺序逐个处理, 只需保留当前交易的取消记录
+type txCancels struct {
+	txHash common.Hash
+	orders []multi.Order
+}
+
+// recordCancel 记录交易中被取消的订单, 供随后的 LogMake 识别修改订单
+func (s *Service) recordCancel(txHash common.Hash, order multi.Order) {
+	if s.cancels.txHash != txHash {
+		s.cancels = txCancels{txHash: txHash}
+	}
+	s.cancels.orders = append(s.cancels.orders, order)
+}
+
+// takeEditedOrder 查找同一交易中被修改的旧订单: Maker、集合及订单类型相同, 挂单及 Item 出价还需 TokenId 相同
+// 匹配到的旧订单从记录中移除, 避免同一交易内多个新订单关联到同一旧订单
+func (s *Service) takeEditedOrder(txHash common.Hash, order *multi.Order) *multi.Order {
+	if s.cancels.txHash != txHash {
+		return nil
+	}
+	for i, prev := range s.cancels.orders {
+		if !isEdit(&prev, order) {
+			continue
+		}
+		s.cancels.orders = append(s.cancels.orders[:i], s.cancels.orders[i+1:]...)
+		return &prev
+	}
+	return nil
+}
+
+// isEdit 判断新订单是否由旧订单修改而来
+func isEdit(prev, order *multi.Order) bool {
+	if !strings.EqualFold(prev.Maker, order.Maker) ||
+		!strings.EqualFold(prev.CollectionAddress, order.CollectionAddress) ||
+		prev.OrderType != order.OrderType {
+		return false
+	}
+	return order.OrderType == multi.CollectionBidOrder || prev.TokenId == order.TokenId
+}
+
+// cancelActivityType 订单对应的取消活动类型
+func cancelActivityType(orderType int64) int {
+	switch orderType {
+	case multi.ListingOrder:
+		return multi.CancelListing
+	case multi.CollectionBidOrder:
+		return multi.CancelCollectionBid
+	default:
+		return multi.CancelItemBid
+	}
+}
+
+// editActivityType 订单对应的修改活动类型
+func editActivityType(orderType int64) int {
+	switch orderType {
+	case multi.ListingOrder:
+		return multi.EditListing
+	case multi.CollectionBidOrder:
+		return multi.EditCollectionBid
+	default:
+		return multi.EditItemBid
+	}
+}
+
+// deleteCancelActivity 删除被修改订单的取消活动, 由修改活动取代
+func (s *Service) deleteCancelActivity(txHash common.Hash, prev *multi.Order) {
+	if err := s.db.WithContext(s.ctx).Table(multi.ActivityTableName(s.chain)).
+		Where("tx_hash = ? and collection_address = ? and token_id = ? and activity_type = ?",
+			txHash.String(), prev.CollectionAddress, prev.TokenId, cancelActivityType(prev.OrderType)).
+		Delete(&multi.Activity{}).Error; err != nil {
+		xzap.WithContext(s.ctx).Warn("failed on delete edited order cancel activity",
+			zap.Error(err), zap.String("order_id", prev.OrderID))
+	}
+}
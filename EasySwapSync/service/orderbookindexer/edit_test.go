@@ -0,0 +1,43 @@
+package orderbookindexer
+
+import (
+	"testing"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestTakeEditedOrder(t *testing.T) {
+	tx := common.HexToHash("0x01")
+	s := &Service{}
+	s.recordCancel(tx, multi.Order{OrderID: "0xa", Maker: "0xMaker", CollectionAddress: "0xC", TokenId: "1", OrderType: multi.ListingOrder})
+	s.recordCancel(tx, multi.Order{OrderID: "0xb", Maker: "0xMaker", CollectionAddress: "0xC", TokenId: "0", OrderType: multi.CollectionBidOrder})
+
+	tests := []struct {
+		name  string
+		tx    common.Hash
+		order multi.Order
+		want  string
+	}{
+		{"other tx", common.HexToHash("0x02"), multi.Order{Maker: "0xmaker", CollectionAddress: "0xc", TokenId: "1", OrderType: multi.ListingOrder}, ""},
+		{"other token", tx, multi.Order{Maker: "0xmaker", CollectionAddress: "0xc", TokenId: "2", OrderType: multi.ListingOrder}, ""},
+		{"other maker", tx, multi.Order{Maker: "0xother", CollectionAddress: "0xc", TokenId: "1", OrderType: multi.ListingOrder}, ""},
+		{"listing", tx, multi.Order{Maker: "0xmaker", CollectionAddress: "0xc", TokenId: "1", OrderType: multi.ListingOrder}, "0xa"},
+		{"listing taken", tx, multi.Order{Maker: "0xmaker", CollectionAddress: "0xc", TokenId: "1", OrderType: multi.ListingOrder}, ""},
+		{"collection bid", tx, multi.Order{Maker: "0xmaker", CollectionAddress: "0xc", TokenId: "5", OrderType: multi.CollectionBidOrder}, "0xb"},
+	}
+	for _, tt := range tests {
+		var got string
+		if prev := s.takeEditedOrder(tt.tx, &tt.order); prev != nil {
+			got = prev.OrderID
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	s.recordCancel(common.HexToHash("0x03"), multi.Order{OrderID: "0xc"})
+	if len(s.cancels.orders) != 1 {
+		t.Fatalf("cancels of previous tx not reset: %+v", s.cancels)
+	}
+}
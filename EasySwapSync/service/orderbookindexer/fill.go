@@ -0,0 +1,127 @@
+package orderbookindexer
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// bidFillAmount 合约每次撮合将买单 filledAmount 加 1
+const bidFillAmount = 1
+
+// listingFillAmount 合约撮合时将卖单 filledAmount 直接置为卖单的 Nft.Amount (完全成交), ERC721 为 1
+func listingFillAmount(sellOrder *Order) int64 {
+	if sellOrder.Nft.Amount == nil || sellOrder.Nft.Amount.Sign() <= 0 || !sellOrder.Nft.Amount.IsInt64() {
+		return 1
+	}
+	return sellOrder.Nft.Amount.Int64()
+}
+
+// matchFills 构造一次撮合中买卖双方订单的成交记录
+// 成交数量与合约 filledAmount 的变化一致: 卖单完全成交, 买单成交 1 个
+func matchFills(sellOrderID, buyOrderID string, sellOrder, buyOrder *Order) []multi.Fill {
+	return []multi.Fill{
+		newFill(sellOrderID, buyOrderID, sellOrder, listingFillAmount(sellOrder)),
+		newFill(buyOrderID, sellOrderID, buyOrder, bidFillAmount),
+	}
+}
+
+// newFill 构造订单的成交记录
+func newFill(orderID, counterOrderID string, order *Order, amount int64) multi.Fill {
+	orderType := int64(multi.ListingOrder)
+	if order.Side == Bid {
+		orderType = multi.ItemBidOrder
+		if order.SaleKind == FixForCollection {
+			orderType = multi.CollectionBidOrder
+		}
+	}
+	return multi.Fill{
+		OrderID:        orderID,
+		CounterOrderID: counterOrderID,
+		OrderType:      orderType,
+		Amount:         amount,
+		Maker:          order.Maker.String(),
+	}
+}
+
+// traitBidFill 查找买方吃单对应的属性出价, 返回属性出价的成交记录, 不是属性出价成交时返回 nil
+// 属性出价由后端撮合, 出价人以单品买单购买挂单, 买单沿用属性出价的 Maker/集合/盐值
+func (s *Service) traitBidFill(sellOrderID string, buyOrder *Order) (*multi.Fill, error) {
+	if buyOrder.SaleKind != FixForItem {
+		return nil, nil
+	}
+
+	var bids []multi.Order
+	if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
+		Select("order_id").
+		Where("order_type = ? and maker = ? and collection_address = ? and salt = ?", multi.TraitBidOrder,
+			strings.ToLower(buyOrder.Maker.String()), strings.ToLower(buyOrder.Nft.CollectionAddr.String()), int64(buyOrder.Salt)).
+		Limit(1).
+		Find(&bids).Error; err != nil {
+		return nil, errors.Wrap(err, "failed on get trait bid")
+	}
+	if len(bids) == 0 {
+		return nil, nil
+	}
+
+	fill := newFill(bids[0].OrderID, sellOrderID, buyOrder, bidFillAmount)
+	fill.OrderType = multi.TraitBidOrder
+	return &fill, nil
+}
+
+// syncFilledQuantity 按成交记录重新计算订单剩余数量, 完全成交时置为已成交并释放托管资产
+// 买单在 Vault 中锁定的 ETH 随成交按单价扣减, 为 price * 剩余数量; 吃单时直接支付的买单未入库, 直接忽略
+func (s *Service) syncFilledQuantity(orderID string, updates map[string]interface{}) error {
+	var orders []multi.Order
+	if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
+		Select("order_id, order_type, size, price, escrow_eth").
+		Where("order_id = ?", orderID).
+		Limit(1).
+		Find(&orders).Error; err != nil {
+		return errors.Wrapf(err, "failed on get order, order_id: %s", orderID)
+	}
+	if len(orders) == 0 {
+		return nil
+	}
+	order := orders[0]
+
+	filled, err := s.filledAmount(s.ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	remaining := order.Size - filled
+	if remaining < 0 {
+		remaining = 0
+	}
+	updates["quantity_remaining"] = remaining
+	if order.OrderType != multi.ListingOrder && !order.EscrowEth.IsZero() {
+		updates["escrow_eth"] = order.Price.Mul(decimal.NewFromInt(remaining))
+	}
+	if remaining == 0 {
+		updates["order_status"] = multi.OrderStatusFilled
+		updates["escrow_eth"] = decimal.Zero
+		updates["escrow_nft"] = false
+	}
+	if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
+		Where("order_id = ?", orderID).
+		Updates(updates).Error; err != nil {
+		return errors.Wrapf(err, "failed on update order filled quantity, order_id: %s", orderID)
+	}
+	return nil
+}
+
+// filledAmount 按成交记录统计订单已成交数量
+func (s *Service) filledAmount(ctx context.Context, orderID string) (int64, error) {
+	var filled int64
+	if err := s.db.WithContext(ctx).Table(multi.FillTableName(s.chain)).
+		Select("coalesce(sum(amount), 0)").
+		Where("order_id = ?", orderID).
+		Scan(&filled).Error; err != nil {
+		return 0, errors.Wrapf(err, "failed on sum order fills, order_id: %s", orderID)
+	}
+	return filled, nil
+}
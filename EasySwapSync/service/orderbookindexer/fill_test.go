@@ -0,0 +1,114 @@
+package orderbookindexer
+
+import (
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListingFillAmount(t *testing.T) {
+	order := &Order{}
+	if got := listingFillAmount(order); got != 1 {
+		t.Fatalf("nil amount: got %d", got)
+	}
+	order.Nft.Amount = big.NewInt(3)
+	if got := listingFillAmount(order); got != 3 {
+		t.Fatalf("got %d, want 3", got)
+	}
+}
+
+func TestMatchFills(t *testing.T) {
+	sellOrder := &Order{Side: List, SaleKind: FixForItem}
+	sellOrder.Nft.Amount = big.NewInt(3)
+	buyOrder := &Order{Side: Bid, SaleKind: FixForCollection}
+	buyOrder.Nft.Amount = big.NewInt(5)
+
+	fills := matchFills("0xsell", "0xbuy", sellOrder, buyOrder)
+	if len(fills) != 2 {
+		t.Fatalf("got %d fills, want 2", len(fills))
+	}
+	// 卖单按自身数量完全成交
+	if fills[0].OrderID != "0xsell" || fills[0].OrderType != multi.ListingOrder || fills[0].Amount != 3 {
+		t.Errorf("unexpected listing fill %+v", fills[0])
+	}
+	// 合约每次撮合只为买单 filledAmount 加 1, 与卖单数量无关
+	if fills[1].OrderID != "0xbuy" || fills[1].OrderType != multi.CollectionBidOrder || fills[1].Amount != 1 {
+		t.Errorf("unexpected bid fill %+v", fills[1])
+	}
+}
+
+func TestNewFill(t *testing.T) {
+	tests := []struct {
+		side, saleKind uint8
+		want           int64
+	}{
+		{List, FixForItem, multi.ListingOrder},
+		{Bid, FixForItem, multi.ItemBidOrder},
+		{Bid, FixForCollection, multi.CollectionBidOrder},
+	}
+	for _, tt := range tests {
+		fill := newFill("0xa", "0xb", &Order{Side: tt.side, SaleKind: tt.saleKind}, 1)
+		if fill.OrderType != tt.want || fill.OrderID != "0xa" || fill.CounterOrderID != "0xb" {
+			t.Errorf("unexpected fill %+v", fill)
+		}
+	}
+}
+
+func TestTraitBidFill(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	query := regexp.QuoteMeta("SELECT `order_id` FROM `ob_order_sepolia` WHERE order_type = ? and maker = ? and collection_address = ? and salt = ? LIMIT 1")
+	buyOrder := &Order{Side: Bid, SaleKind: FixForItem, Maker: common.HexToAddress("0xF39FD6E51AAD88F6F4CE6AB8827279CFFFB92266"), Salt: 7}
+	buyOrder.Nft.CollectionAddr = common.HexToAddress("0xE7F1725E7734CE288F8367E1BB143E90BB3F0512")
+
+	// 后端撮合属性出价时构造的买单沿用属性出价的 Maker/集合/盐值
+	mock.ExpectQuery(query).
+		WithArgs(multi.TraitBidOrder, "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512", int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"order_id"}).AddRow("0xtrait"))
+	fill, err := s.traitBidFill("0xsell", buyOrder)
+	require.NoError(t, err)
+	require.NotNil(t, fill)
+	assert.Equal(t, "0xtrait", fill.OrderID)
+	assert.Equal(t, "0xsell", fill.CounterOrderID)
+	assert.Equal(t, int64(multi.TraitBidOrder), fill.OrderType)
+	assert.Equal(t, int64(bidFillAmount), fill.Amount)
+
+	// 普通吃单没有对应的属性出价
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows([]string{"order_id"}))
+	fill, err = s.traitBidFill("0xsell", buyOrder)
+	require.NoError(t, err)
+	assert.Nil(t, fill)
+
+	// 集合出价不会来自属性出价的撮合
+	fill, err = s.traitBidFill("0xsell", &Order{Side: Bid, SaleKind: FixForCollection})
+	require.NoError(t, err)
+	assert.Nil(t, fill)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSyncTraitBidFilledQuantity(t *testing.T) {
+	s, mock := newMockService(t, nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT order_id, order_type, size, price, escrow_eth FROM `ob_order_sepolia` WHERE order_id = ? LIMIT 1")).
+		WithArgs("0xtrait").
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "order_type", "size", "price", "escrow_eth"}).
+			AddRow("0xtrait", multi.TraitBidOrder, 2, "100", "0"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT coalesce(sum(amount), 0) FROM `ob_fill_sepolia` WHERE order_id = ?")).
+		WithArgs("0xtrait").
+		WillReturnRows(sqlmock.NewRows([]string{"filled"}).AddRow(2))
+	// 属性出价没有托管, 全部成交后置为已成交
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_order_sepolia` SET `escrow_eth`=?,`escrow_nft`=?,`order_status`=?,`quantity_remaining`=? WHERE order_id = ?")).
+		WithArgs(decimal.Zero, false, multi.OrderStatusFilled, int64(0), "0xtrait").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, s.syncFilledQuantity("0xtrait", map[string]interface{}{}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
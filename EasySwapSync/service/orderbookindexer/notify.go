@@ -0,0 +1,92 @@
+package orderbookindexer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/notify"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// publish 生成用户通知, 未开启通知时忽略; 通知失败不影响事件处理
+func (s *Service) publish(n *base.Notification) {
+	if s.cfg.Notification == nil || !s.cfg.Notification.Enable {
+		return
+	}
+	n.ChainId = s.chainId
+	if _, err := notify.Publish(s.ctx, s.db, n); err != nil {
+		xzap.WithContext(s.ctx).Error("failed on publish notification",
+			zap.Error(err), zap.String("kind", n.Kind), zap.String("event_key", n.EventKey))
+	}
+}
+
+// notifyMatch 成交后通知卖方挂单成交; 卖方接受买方挂出的出价时通知买方出价被接受
+func (s *Service) notifyMatch(log ethereumTypes.Log, sellOrderId, buyOrderId, seller, buyer, collection, tokenId string, price decimal.Decimal, bidAccepted bool) {
+	event := fmt.Sprintf("%s:%d", log.TxHash.String(), log.Index)
+	s.publish(&base.Notification{
+		UserAddress:       seller,
+		Kind:              base.NotificationItemSold,
+		EventKey:          fmt.Sprintf("%s:%s:%s", base.NotificationItemSold, sellOrderId, event),
+		CollectionAddress: collection,
+		TokenId:           tokenId,
+		OrderId:           sellOrderId,
+		Price:             price,
+	})
+	if !bidAccepted {
+		return
+	}
+	s.publish(&base.Notification{
+		UserAddress:       buyer,
+		Kind:              base.NotificationBidAccepted,
+		EventKey:          fmt.Sprintf("%s:%s:%s", base.NotificationBidAccepted, buyOrderId, event),
+		CollectionAddress: collection,
+		TokenId:           tokenId,
+		OrderId:           buyOrderId,
+		Price:             price,
+	})
+}
+
+// bidAccepted 撮合是否由卖方接受出价
+// LogMatch 的 MakeOrder 为调用 matchOrder 一方的订单: 卖方接受出价时为卖单, 买方购买挂单时为买方自己的买单
+func bidAccepted(makeOrder Order) bool {
+	return makeOrder.Side == List
+}
+
+// notifyOutbid 新出价高于同一集合 (集合出价) 或同一 Item (Item 出价) 原最高出价时, 通知原最高出价的 Maker
+// 原最高出价按当前订单簿查询, backfill/reindex 重放历史出价时订单簿已不是出价当时的状态, 不发送通知
+func (s *Service) notifyOutbid(order *multi.Order) {
+	if s.replaying || order.OrderType == multi.ListingOrder || s.cfg.Notification == nil || !s.cfg.Notification.Enable {
+		return
+	}
+
+	db := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
+		Select("order_id, maker, price").
+		Where("collection_address = ? and order_type = ? and order_status = ? and expire_time > ? and quantity_remaining > 0 and order_id != ? and maker != ?",
+			order.CollectionAddress, order.OrderType, multi.OrderStatusActive, time.Now().Unix(), order.OrderID, order.Maker)
+	if order.OrderType == multi.ItemBidOrder {
+		db = db.Where("token_id = ?", order.TokenId)
+	}
+	var prevBest []multi.Order
+	if err := db.Order("price desc").Limit(1).Find(&prevBest).Error; err != nil {
+		xzap.WithContext(s.ctx).Error("failed on query best bid", zap.Error(err), zap.String("order_id", order.OrderID))
+		return
+	}
+	if len(prevBest) == 0 || !order.Price.GreaterThan(prevBest[0].Price) {
+		return
+	}
+
+	s.publish(&base.Notification{
+		UserAddress:       prevBest[0].Maker,
+		Kind:              base.NotificationOutbid,
+		EventKey:          fmt.Sprintf("%s:%s:%s", base.NotificationOutbid, prevBest[0].OrderID, order.OrderID),
+		CollectionAddress: order.CollectionAddress,
+		TokenId:           order.TokenId,
+		OrderId:           prevBest[0].OrderID,
+		Price:             order.Price,
+	})
+}
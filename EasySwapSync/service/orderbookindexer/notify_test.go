@@ -0,0 +1,68 @@
+package orderbookindexer
+
+import (
+	"regexp"
+	"testing"
+
+	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+)
+
+func TestNotifyOutbidSkippedDuringReplay(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	s.cfg.Notification = &config.NotificationCfg{Enable: true}
+	bid := &multi.Order{OrderID: testBuyOrder, OrderType: multi.CollectionBidOrder, Price: decimal.NewFromInt(2)}
+
+	// 重放历史事件时不查询当前最高出价, 也不生成通知
+	s.replaying = true
+	s.notifyOutbid(bid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	s.replaying = false
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT order_id, maker, price FROM `ob_order_sepolia`")).
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "maker", "price"}))
+	s.notifyOutbid(bid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func expectNotification(mock sqlmock.Sqlmock, userAddr string) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `ob_notification_preference` WHERE user_address = ?")).
+		WithArgs(userAddr).
+		WillReturnRows(sqlmock.NewRows([]string{"user_address"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ob_notification`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+}
+
+func TestNotifyMatchDirections(t *testing.T) {
+	cases := []struct {
+		name      string
+		makeOrder Order
+		notified  []string
+	}{
+		// 卖方调用 matchOrder 接受出价: MakeOrder 为卖单, 通知卖方及出价的买方
+		{name: "seller accepts bid", makeOrder: Order{Side: List}, notified: []string{"0xseller", "0xbuyer"}},
+		// 买方调用 matchOrder 购买挂单: MakeOrder 为买方自己的买单, 只通知卖方
+		{name: "buyer takes listing", makeOrder: Order{Side: Bid}, notified: []string{"0xseller"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s, mock := newMockService(t, nil)
+			s.cfg.Notification = &config.NotificationCfg{Enable: true}
+			for _, userAddr := range c.notified {
+				expectNotification(mock, userAddr)
+			}
+
+			s.notifyMatch(ethereumTypes.Log{Index: 3}, testSellOrder, testBuyOrder, "0xSeller", "0xBuyer", "0xa", "1",
+				decimal.NewFromInt(1), bidAccepted(c.makeOrder))
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package orderbookindexer
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/health"
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
+	"github.com/ProjectsTask/EasySwapBase/ordermanager"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+const (
+	DefaultReconcileInterval  = 60  // 默认对账周期 (秒)
+	DefaultReconcileBatchSize = 200 // 默认每个周期检查的订单数
+)
+
+// 对账不一致类型
+const (
+	DiscrepancyCancelled    = "cancelled_on_chain" // 链上已取消, 数据库仍为活跃
+	DiscrepancyFilled       = "filled_on_chain"    // 链上已完全成交, 数据库仍为活跃
+	DiscrepancyQuantity     = "quantity_remaining" // 部分成交后剩余数量与链上不一致
+	DiscrepancyMissing      = "missing_on_chain"   // 订单不在链上订单簿中 (如 LogMake 所在区块被重组), 无法成交, 修复为已取消
+	DiscrepancyVaultBalance = "vault_balance"      // Vault 托管资产与订单剩余数量不符
+	DiscrepancyEscrow       = "escrow"             // 订单记录的托管资产 (escrow_eth/escrow_nft) 与 Vault balanceOf 不一致, 以链上为准修复
+	DiscrepancyFloorPrice   = "floor_price"        // 合约最低挂单价与计算的地板价不一致
+)
+
+// Discrepancy 一条对账不一致记录
+type Discrepancy struct {
+	Kind              string `json:"kind"`
+	OrderID           string `json:"order_id,omitempty"`
+	CollectionAddress string `json:"collection_address"`
+	TokenId           string `json:"token_id,omitempty"`
+	OnChain           string `json:"on_chain"` // 链上值
+	InDB              string `json:"in_db"`    // 数据库值
+	Fixed             bool   `json:"fixed"`
+}
+
+// ReconcileReport 对账报告
+type ReconcileReport struct {
+	Checked       int           `json:"checked"`
+	Discrepancies []Discrepancy `json:"discrepancies"`
+}
+
+func (r *ReconcileReport) merge(other *ReconcileReport) {
+	r.Checked += other.Checked
+	r.Discrepancies = append(r.Discrepancies, other.Discrepancies...)
+}
+
+// reconcileCfg 返回对账周期及批次大小, 未配置时使用默认值
+func (s *Service) reconcileCfg() (time.Duration, int) {
+	interval, batchSize := int64(DefaultReconcileInterval), DefaultReconcileBatchSize
+	if cfg := s.cfg.Reconcile; cfg != nil {
+		if cfg.Interval > 0 {
+			interval = cfg.Interval
+		}
+		if cfg.BatchSize > 0 {
+			batchSize = cfg.BatchSize
+		}
+	}
+	return time.Duration(interval) * time.Second, batchSize
+}
+
+// ReconcileLoop 链上订单对账循环
+// 每个周期按 id 顺序检查一批活跃订单并自动修复, 扫完一轮后从头开始并比对集合地板价
+func (s *Service) ReconcileLoop(ctx context.Context) error {
+	interval, batchSize := s.reconcileCfg()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var cursor int64
+	for {
+		s.reconcileHeartbeat.Beat()
+		select {
+		case <-ctx.Done():
+			xzap.WithContext(s.ctx).Info("ReconcileLoop stopped due to context cancellation")
+			return nil
+		case <-ticker.C:
+		}
+
+		report, lastID, err := s.ReconcileOrders(s.ctx, cursor, batchSize, true)
+		if err != nil {
+			xzap.WithContext(s.ctx).Error("failed on reconcile orders", zap.Error(err), zap.Int64("cursor", cursor))
+			continue
+		}
+		cursor = lastID
+		if report.Checked < batchSize {
+			// 一轮扫描完成, 比对地板价后从头开始
+			cursor = 0
+			floorReport, err := s.ReconcileFloorPrices(s.ctx)
+			if err != nil {
+				xzap.WithContext(s.ctx).Error("failed on reconcile floor prices", zap.Error(err))
+			} else {
+				report.merge(floorReport)
+			}
+		}
+		s.logReport(report)
+	}
+}
+
+// Reconcile 对全部活跃订单及地板价做一轮对账, fix 为 false 时只生成报告
+func (s *Service) Reconcile(ctx context.Context, batchSize int, fix bool) (*ReconcileReport, error) {
+	report := &ReconcileReport{}
+	var cursor int64
+	for {
+		batch, lastID, err := s.ReconcileOrders(ctx, cursor, batchSize, fix)
+		if err != nil {
+			return nil, err
+		}
+		report.merge(batch)
+		if batch.Checked < batchSize {
+			break
+		}
+		cursor = lastID
+	}
+
+	floorReport, err := s.ReconcileFloorPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.merge(floorReport)
+	return report, nil
+}
+
+// ReconcileOrders 检查 id 大于 afterID 的一批链上活跃订单 (含因所有权或授权被置为无效的挂单), 返回报告及本批最后一个订单 id
+// 仅检查链上订单, 链下签名订单及属性出价不在合约中
+// 链上状态按已同步的最后一个区块查询, 避免把尚未同步的事件当作不一致; 先加载订单再读取同步进度, 保证订单记录不超前于该区块
+func (s *Service) ReconcileOrders(ctx context.Context, afterID int64, limit int, fix bool) (*ReconcileReport, int64, error) {
+	var orders []multi.Order
+	if err := s.db.WithContext(ctx).Table(multi.OrderTableName(s.chain)).
+		Where("id > ? and marketplace_id = ? and order_type in (?) and order_status in (?) and signature = ''", afterID, multi.MarketOrderBook,
+			[]int{multi.ListingOrder, multi.CollectionBidOrder, multi.ItemBidOrder},
+			[]int{multi.OrderStatusActive, multi.OrderStatusInactive}).
+		Order("id asc").Limit(limit).
+		Find(&orders).Error; err != nil {
+		return nil, afterID, errors.Wrap(err, "failed on query active orders")
+	}
+
+	report := &ReconcileReport{}
+	lastID := afterID
+	for i := range orders {
+		if ctx.Err() != nil {
+			return report, lastID, nil
+		}
+		block, err := s.syncedBlock(ctx)
+		if err != nil {
+			return report, lastID, err
+		}
+		discrepancies, err := s.reconcileOrder(ctx, &orders[i], block, fix)
+		if err != nil {
+			return report, lastID, errors.Wrapf(err, "failed on reconcile order, order_id: %s", orders[i].OrderID)
+		}
+		report.Checked++
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+		lastID = orders[i].ID
+	}
+	s.recordDiscrepancies(report.Discrepancies)
+	return report, lastID, nil
+}
+
+// compareOrderState 比对订单记录与链上状态, 一致时 kind 为空
+func compareOrderState(order *multi.Order, state *liborder.OrderState) (kind string, onChain string) {
+	filled := state.Filled()
+	switch {
+	case state.Cancelled():
+		return DiscrepancyCancelled, "cancelled"
+	case filled >= order.Size:
+		return DiscrepancyFilled, "filled"
+	case filled == 0 && !state.InBook():
+		return DiscrepancyMissing, "missing"
+	case order.QuantityRemaining != order.Size-filled:
+		return DiscrepancyQuantity, strconv.FormatInt(order.Size-filled, 10)
+	}
+	return "", ""
+}
+
+// reconcileOrder 按区块 block 比对单个订单的 filledAmount 及 Vault 托管资产
+func (s *Service) reconcileOrder(ctx context.Context, order *multi.Order, block uint64, fix bool) ([]Discrepancy, error) {
+	orderKey := common.HexToHash(order.OrderID)
+	state, err := liborder.QueryOrderState(ctx, s.chainClient, common.HexToAddress(s.cfg.ContractCfg.DexAddress), orderKey,
+		new(big.Int).SetUint64(block))
+	if err != nil {
+		return nil, err
+	}
+
+	kind, onChain := compareOrderState(order, state)
+	if kind == "" {
+		return s.reconcileVault(ctx, order, orderKey, block, order.Size-state.Filled(), fix)
+	}
+	discrepancy := Discrepancy{
+		Kind:              kind,
+		OrderID:           order.OrderID,
+		CollectionAddress: order.CollectionAddress,
+		TokenId:           order.TokenId,
+		OnChain:           onChain,
+		InDB:              strconv.FormatInt(order.QuantityRemaining, 10),
+	}
+	if fix {
+		discrepancy.Fixed, err = s.fixAtBlock(ctx, block, func() (bool, error) {
+			switch kind {
+			case DiscrepancyCancelled, DiscrepancyMissing:
+				return true, s.fixOrder(ctx, order, releaseEscrow(map[string]interface{}{"order_status": multi.OrderStatusCancelled}), ordermanager.Cancel)
+			case DiscrepancyFilled:
+				return true, s.fixOrder(ctx, order, releaseEscrow(map[string]interface{}{"order_status": multi.OrderStatusFilled, "quantity_remaining": 0}), ordermanager.Buy)
+			default:
+				return s.fixQuantity(ctx, order, state.Filled())
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return []Discrepancy{discrepancy}, nil
+}
+
+// fixAtBlock 在同步进度仍为 block 时执行修复, 返回是否已修复
+// 持有 indexMu 期间事件同步循环不会写入, 同步进度已前进时放弃本次修复, 由下一轮按新的区块重新比对
+// 独立进程 (reconcile 命令) 无法与 daemon 互斥, 仅依赖同步进度检查
+func (s *Service) fixAtBlock(ctx context.Context, block uint64, fix func() (bool, error)) (bool, error) {
+	s.indexMu.Lock()
+	defer s.indexMu.Unlock()
+
+	current, err := s.syncedBlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	if current != block {
+		return false, nil
+	}
+	return fix()
+}
+
+// fixQuantity 订单剩余数量由成交记录推导, 不直接写入链上值
+// 成交记录与链上 filledAmount 一致时按成交记录重新计算剩余数量; 不一致说明缺失或多出 LogMatch, 需通过 backfill/reindex 重新处理事件
+func (s *Service) fixQuantity(ctx context.Context, order *multi.Order, filled int64) (bool, error) {
+	recorded, err := s.filledAmount(ctx, order.OrderID)
+	if err != nil {
+		return false, err
+	}
+	if recorded != filled {
+		xzap.WithContext(ctx).Warn("order fills diverge from filledAmount on chain, backfill the missing LogMatch events",
+			zap.String("order_id", order.OrderID),
+			zap.Int64("fills", recorded),
+			zap.Int64("filled_amount", filled))
+		return false, nil
+	}
+	if err := s.syncFilledQuantity(order.OrderID, map[string]interface{}{}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// reconcileVault 比对订单在 Vault 中托管的资产
+// Vault 应托管的资产 (买单 price * 剩余数量的 ETH, 卖单对应 NFT) 由合约维护, 不一致时仅报告;
+// 订单记录的托管资产由事件推导, 与 Vault balanceOf 不一致时以链上为准修复
+func (s *Service) reconcileVault(ctx context.Context, order *multi.Order, orderKey common.Hash, block uint64, remaining int64, fix bool) ([]Discrepancy, error) {
+	if s.cfg.ContractCfg.VaultAddress == "" {
+		return nil, nil
+	}
+	balance, err := liborder.QueryVaultBalance(ctx, s.chainClient, common.HexToAddress(s.cfg.ContractCfg.VaultAddress), orderKey,
+		new(big.Int).SetUint64(block))
+	if err != nil {
+		return nil, err
+	}
+
+	var onChain, expected, escrow string
+	var updates map[string]interface{}
+	if order.OrderType == multi.ListingOrder {
+		onChain, expected = balance.TokenId.String(), order.TokenId
+		// 托管的 NFT 为 TokenId 0 时 balanceOf 无法区分, 以 expected 判断
+		escrowNft := onChain == expected
+		escrow = strconv.FormatBool(order.EscrowNft)
+		if escrowNft != order.EscrowNft {
+			updates = map[string]interface{}{"escrow_nft": escrowNft}
+		}
+	} else {
+		onChain, expected = balance.ETHAmount.String(), order.Price.Mul(decimal.NewFromInt(remaining)).String()
+		escrow = order.EscrowEth.String()
+		if escrowEth := decimal.NewFromBigInt(balance.ETHAmount, 0); !escrowEth.Equal(order.EscrowEth) {
+			updates = map[string]interface{}{"escrow_eth": escrowEth}
+		}
+	}
+
+	var discrepancies []Discrepancy
+	if onChain != expected {
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:              DiscrepancyVaultBalance,
+			OrderID:           order.OrderID,
+			CollectionAddress: order.CollectionAddress,
+			TokenId:           order.TokenId,
+			OnChain:           onChain,
+			InDB:              expected,
+		})
+	}
+	if updates != nil {
+		var fixed bool
+		if fix {
+			if fixed, err = s.fixAtBlock(ctx, block, func() (bool, error) {
+				return true, s.fixOrder(ctx, order, updates, 0)
+			}); err != nil {
+				return discrepancies, err
+			}
+		}
+		discrepancies = append(discrepancies, Discrepancy{
+			Kind:              DiscrepancyEscrow,
+			OrderID:           order.OrderID,
+			CollectionAddress: order.CollectionAddress,
+			TokenId:           order.TokenId,
+			OnChain:           onChain,
+			InDB:              escrow,
+			Fixed:             fixed,
+		})
+	}
+	return discrepancies, nil
+}
+
+// releaseEscrow 订单成交或取消后 Vault 已释放托管资产
+func releaseEscrow(updates map[string]interface{}) map[string]interface{} {
+	updates["escrow_eth"] = decimal.Zero
+	updates["escrow_nft"] = false
+	return updates
+}
+
+// fixOrder 以链上状态修正订单, 并推送对应的交易事件以更新地板价队列
+// 修正没有对应的链上交易, 不生成活动记录
+func (s *Service) fixOrder(ctx context.Context, order *multi.Order, updates map[string]interface{}, eventType ordermanager.EventType) error {
+	if err := s.db.WithContext(ctx).Table(multi.OrderTableName(s.chain)).
+		Where("order_id = ? and order_status = ?", order.OrderID, order.OrderStatus).
+		Updates(updates).Error; err != nil {
+		return errors.Wrap(err, "failed on update order")
+	}
+	// 地板价队列只维护卖单
+	if eventType == 0 || order.OrderType != multi.ListingOrder {
+		return nil
+	}
+	if err := ordermanager.AddUpdatePriceEvent(s.kv, &ordermanager.TradeEvent{
+		OrderId:        order.OrderID,
+		CollectionAddr: order.CollectionAddress,
+		TokenID:        order.TokenId,
+		EventType:      eventType,
+		From:           order.Maker,
+	}, s.chain); err != nil {
+		xzap.WithContext(ctx).Error("failed on add update price event",
+			zap.Error(err),
+			zap.String("type", "reconcile"),
+			zap.String("order_id", order.OrderID))
+	}
+	return nil
+}
+
+// ReconcileFloorPrices 比对合约 getBestPrice 与计算的集合地板价
+// 合约不会移除已过期或 Maker 已不持有 NFT 的挂单, 不一致时仅报告
+func (s *Service) ReconcileFloorPrices(ctx context.Context) (*ReconcileReport, error) {
+	floorPrices, err := s.QueryCollectionsFloorPrice()
+	if err != nil {
+		return nil, err
+	}
+	block, err := s.syncedBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ReconcileReport{}
+	for _, floor := range floorPrices {
+		if ctx.Err() != nil {
+			break
+		}
+		bestPrice, err := liborder.QueryBestPrice(ctx, s.chainClient, common.HexToAddress(s.cfg.ContractCfg.DexAddress),
+			common.HexToAddress(floor.CollectionAddress), liborder.SideList, new(big.Int).SetUint64(block))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed on get best price, collection: %s", floor.CollectionAddress)
+		}
+		report.Checked++
+		if decimal.NewFromBigInt(bestPrice, 0).Equal(floor.Price) {
+			continue
+		}
+		report.Discrepancies = append(report.Discrepancies, Discrepancy{
+			Kind:              DiscrepancyFloorPrice,
+			CollectionAddress: floor.CollectionAddress,
+			OnChain:           bestPrice.String(),
+			InDB:              floor.Price.String(),
+		})
+	}
+	s.recordDiscrepancies(report.Discrepancies)
+	return report, nil
+}
+
+func (s *Service) recordDiscrepancies(discrepancies []Discrepancy) {
+	for _, d := range discrepancies {
+		metrics.ReconcileDiscrepancies.WithLabelValues(s.chain, d.Kind, strconv.FormatBool(d.Fixed)).Inc()
+	}
+}
+
+func (s *Service) logReport(report *ReconcileReport) {
+	for _, d := range report.Discrepancies {
+		xzap.WithContext(s.ctx).Warn("reconcile discrepancy",
+			zap.String("kind", d.Kind),
+			zap.String("order_id", d.OrderID),
+			zap.String("collection_address", d.CollectionAddress),
+			zap.String("token_id", d.TokenId),
+			zap.String("on_chain", d.OnChain),
+			zap.String("in_db", d.InDB),
+			zap.Bool("fixed", d.Fixed))
+	}
+	xzap.WithContext(s.ctx).Info("reconcile finished",
+		zap.Int("checked", report.Checked), zap.Int("discrepancies", len(report.Discrepancies)))
+}
+
+// newReconcileHeartbeat 对账循环心跳, 超时时间为三个对账周期
+func (s *Service) newReconcileHeartbeat() *health.Heartbeat {
+	interval, _ := s.reconcileCfg()
+	return health.NewHeartbeat("order_reconcile", 3*interval)
+}
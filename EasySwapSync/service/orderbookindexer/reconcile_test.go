@@ -0,0 +1,186 @@
+package orderbookindexer
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ProjectsTask/EasySwapSync/service/config"
+)
+
+func TestReconcileCfg(t *testing.T) {
+	s := &Service{cfg: &config.Config{}}
+	interval, batchSize := s.reconcileCfg()
+	if interval != DefaultReconcileInterval*time.Second || batchSize != DefaultReconcileBatchSize {
+		t.Fatalf("unexpected defaults: %s, %d", interval, batchSize)
+	}
+
+	s.cfg.Reconcile = &config.ReconcileCfg{Enable: true, Interval: 30, BatchSize: 50}
+	interval, batchSize = s.reconcileCfg()
+	if interval != 30*time.Second || batchSize != 50 {
+		t.Fatalf("unexpected config: %s, %d", interval, batchSize)
+	}
+}
+
+func TestReconcileReportMerge(t *testing.T) {
+	report := &ReconcileReport{Checked: 2, Discrepancies: []Discrepancy{{Kind: DiscrepancyFilled}}}
+	report.merge(&ReconcileReport{Checked: 3, Discrepancies: []Discrepancy{{Kind: DiscrepancyFloorPrice}}})
+	if report.Checked != 5 || len(report.Discrepancies) != 2 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
+
+func TestReleaseEscrow(t *testing.T) {
+	updates := releaseEscrow(map[string]interface{}{"order_status": multi.OrderStatusCancelled})
+	if updates["order_status"] != multi.OrderStatusCancelled || !updates["escrow_eth"].(decimal.Decimal).IsZero() || updates["escrow_nft"] != false {
+		t.Fatalf("unexpected updates %+v", updates)
+	}
+}
+
+func TestCompareOrderState(t *testing.T) {
+	maker := common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	order := &multi.Order{Size: 3, QuantityRemaining: 3}
+	cases := []struct {
+		name      string
+		filled    *big.Int
+		maker     common.Address
+		remaining int64
+		kind      string
+		onChain   string
+	}{
+		{name: "consistent", filled: big.NewInt(0), maker: maker, remaining: 3},
+		{name: "partially filled", filled: big.NewInt(1), maker: maker, remaining: 2},
+		{name: "cancelled", filled: liborder.FilledAmountCancelled, remaining: 3, kind: DiscrepancyCancelled, onChain: "cancelled"},
+		{name: "filled", filled: big.NewInt(3), remaining: 1, kind: DiscrepancyFilled, onChain: "filled"},
+		{name: "missing", filled: big.NewInt(0), remaining: 3, kind: DiscrepancyMissing, onChain: "missing"},
+		{name: "quantity", filled: big.NewInt(2), maker: maker, remaining: 3, kind: DiscrepancyQuantity, onChain: "1"},
+		{name: "overflow", filled: new(big.Int).Lsh(big.NewInt(math.MaxInt64), 1), maker: maker, remaining: 3, kind: DiscrepancyFilled, onChain: "filled"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			order.QuantityRemaining = c.remaining
+			kind, onChain := compareOrderState(order, &liborder.OrderState{FilledAmount: c.filled, Maker: c.maker})
+			assert.Equal(t, c.kind, kind)
+			assert.Equal(t, c.onChain, onChain)
+		})
+	}
+}
+
+func onChainOrder(filled int64) map[string][]interface{} {
+	order := liborder.Order{
+		Maker: common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"),
+		Nft: liborder.Asset{
+			TokenId:    big.NewInt(1),
+			Collection: common.HexToAddress("0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"),
+			Amount:     big.NewInt(3),
+		},
+		Price: big.NewInt(100),
+	}
+	return map[string][]interface{}{
+		liborder.MethodFilledAmount: {big.NewInt(filled)},
+		liborder.MethodOrders:       {order, [32]byte{}},
+	}
+}
+
+func expectActiveOrder(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `ob_order_sepolia` WHERE id > ?")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "order_type", "order_status", "size", "quantity_remaining", "price"}).
+			AddRow(7, testSellOrder, multi.ListingOrder, multi.OrderStatusActive, 3, 3, "100"))
+}
+
+func expectSyncedBlock(mock sqlmock.Sqlmock, lastIndexedBlock int64) {
+	mock.ExpectQuery("SELECT \\* FROM `ob_indexed_status`").
+		WillReturnRows(sqlmock.NewRows([]string{"chain_id", "last_indexed_block"}).AddRow(11155111, lastIndexedBlock))
+}
+
+func TestReconcileOrdersAtSyncedBlock(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	client := s.chainClient.(*fakeChainClient)
+	client.outputs = onChainOrder(1)
+
+	expectActiveOrder(mock)
+	expectSyncedBlock(mock, 200)
+	// 修复前确认同步进度未变化, 剩余数量由成交记录推导
+	expectSyncedBlock(mock, 200)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT coalesce(sum(amount), 0) FROM `ob_fill_sepolia`")).
+		WithArgs(testSellOrder).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT order_id, order_type, size, price, escrow_eth FROM `ob_order_sepolia`")).
+		WillReturnRows(sqlmock.NewRows([]string{"order_id", "order_type", "size", "price", "escrow_eth"}).
+			AddRow(testSellOrder, multi.ListingOrder, 3, "100", "0"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT coalesce(sum(amount), 0) FROM `ob_fill_sepolia`")).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(1))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ob_order_sepolia` SET `quantity_remaining`=? WHERE order_id = ?")).
+		WithArgs(2, testSellOrder).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	report, lastID, err := s.ReconcileOrders(context.Background(), 0, 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), lastID)
+	require.Len(t, report.Discrepancies, 1)
+	assert.Equal(t, DiscrepancyQuantity, report.Discrepancies[0].Kind)
+	assert.Equal(t, "2", report.Discrepancies[0].OnChain)
+	assert.True(t, report.Discrepancies[0].Fixed)
+	// filledAmount 与 orders 均按已同步的最后一个区块查询
+	assert.Equal(t, []*big.Int{big.NewInt(199), big.NewInt(199)}, client.blocks)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReconcileOrdersSkipsTraitBids(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	client := s.chainClient.(*fakeChainClient)
+
+	// 属性出价为链下订单, 订单 ID 不是合约 OrderKey, 不参与对账; 查询条件只包含链上订单类型
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `ob_order_sepolia` WHERE id > ? and marketplace_id = ? and order_type in (?,?,?) and order_status in (?,?) and signature = ''")).
+		WithArgs(0, multi.MarketOrderBook, multi.ListingOrder, multi.CollectionBidOrder, multi.ItemBidOrder,
+			multi.OrderStatusActive, multi.OrderStatusInactive).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "order_type", "order_status", "size", "quantity_remaining", "price"}))
+
+	report, lastID, err := s.ReconcileOrders(context.Background(), 0, 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), lastID)
+	assert.Zero(t, report.Checked)
+	assert.Empty(t, report.Discrepancies)
+	assert.Empty(t, client.blocks)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReconcileSkipsFixWhenIndexerAdvanced(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	s.chainClient.(*fakeChainClient).outputs = onChainOrder(1)
+
+	expectActiveOrder(mock)
+	expectSyncedBlock(mock, 200)
+	expectSyncedBlock(mock, 201)
+
+	report, _, err := s.ReconcileOrders(context.Background(), 0, 10, true)
+	require.NoError(t, err)
+	require.Len(t, report.Discrepancies, 1)
+	assert.False(t, report.Discrepancies[0].Fixed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFixQuantityKeepsDivergentFills(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT coalesce(sum(amount), 0) FROM `ob_fill_sepolia`")).
+		WithArgs(testSellOrder).
+		WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+
+	fixed, err := s.fixQuantity(context.Background(), &multi.Order{OrderID: testSellOrder, Size: 3, QuantityRemaining: 3}, 1)
+	require.NoError(t, err)
+	assert.False(t, fixed)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,22 +6,27 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ProjectsTask/EasySwapBase/chain/chainclient"
 	"github.com/ProjectsTask/EasySwapBase/chain/types"
+	"github.com/ProjectsTask/EasySwapBase/evm/liborder"
+	"github.com/ProjectsTask/EasySwapBase/health"
 	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
 	"github.com/ProjectsTask/EasySwapBase/ordermanager"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/base"
 	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
 	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethereumTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
-	"github.com/zeromicro/go-zero/core/threading"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -35,13 +40,19 @@ const (
 	SleepInterval   = 10 // 轮询出错或无新块时的休眠间隔 (秒)
 	SyncBlockPeriod = 10 // 每次同步的区块数量步长
 
+	// 心跳超时时间, 超过该时长循环未推进则存活检查失败
+	EventSyncHeartbeatMaxAge   = 5 * time.Minute
+	FloorUpkeepHeartbeatMaxAge = 5 * time.Minute
+
 	// 监听的事件 Topic 签名 (Keccak-256 hash)
 	LogMakeTopic   = "0xfc37f2ff950f95913eb7182357ba3c14df60ef354bc7d6ab1ba2815f249fffe6" // LogMake 挂单事件
 	LogCancelTopic = "0x0ac8bb53fac566d7afc05d8b4df11d7690a7b27bdc40b54e4060f9b21fb849bd" // LogCancel 取消订单事件
 	LogMatchTopic  = "0xf629aecab94607bc43ce4aebd564bf6e61c7327226a797b002de724b9944b20e" // LogMatch 撮合成功事件
 
+	LogUpdatedProtocolShareTopic = "0x0b52884d4590055c8791518c34458834f75feed662340ef0b5af2898e7a9be9f" // LogUpdatedProtocolShare 协议手续费率变更事件
+
 	// 合约 ABI 用于解析日志数据
-	contractAbi      = `[{"inputs":[],"name":"CannotFindNextEmptyKey","type":"error"},{"inputs":[],"name":"CannotFindPrevEmptyKey","type":"error"},{"inputs":[{"internalType":"OrderKey","name":"orderKey","type":"bytes32"}],"name":"CannotInsertDuplicateOrder","type":"error"},{"inputs":[],"name":"CannotInsertEmptyKey","type":"error"},{"inputs":[],"name":"CannotInsertExistingKey","type":"error"},{"inputs":[],"name":"CannotRemoveEmptyKey","type":"error"},{"inputs":[],"name":"CannotRemoveMissingKey","type":"error"},{"inputs":[],"name":"EnforcedPause","type":"error"},{"inputs":[],"name":"ExpectedPause","type":"error"},{"inputs":[],"name":"InvalidInitialization","type":"error"},{"inputs":[],"name":"NotInitializing","type":"error"},{"inputs":[{"internalType":"address","name":"owner","type":"address"}],"name":"OwnableInvalidOwner","type":"error"},{"inputs":[{"internalType":"address","name":"account","type":"address"}],"name":"OwnableUnauthorizedAccount","type":"error"},{"inputs":[],"name":"ReentrancyGuardReentrantCall","type":"error"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint256","name":"offset","type":"uint256"},{"indexed":false,"internalType":"bytes","name":"msg","type":"bytes"}],"name":"BatchMatchInnerError","type":"event"},{"anonymous":false,"inputs":[],"name":"EIP712DomainChanged","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint64","name":"version","type":"uint64"}],"name":"Initialized","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"OrderKey","name":"orderKey","type":"bytes32"},{"indexed":true,"internalType":"address","name":"maker","type":"address"}],"name":"LogCancel","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"OrderKey","name":"orderKey","type":"bytes32"},{"indexed":true,"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"indexed":true,"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"indexed":true,"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"indexed":false,"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"indexed":false,"internalType":"Price","name":"price","type":"uint128"},{"indexed":false,"internalType":"uint64","name":"expiry","type":"uint64"},{"indexed":false,"internalType":"uint64","name":"salt","type":"uint64"}],"name":"LogMake","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"OrderKey","name":"makeOrderKey","type":"bytes32"},{"indexed":true,"internalType":"OrderKey","name":"takeOrderKey","type":"bytes32"},{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"indexed":false,"internalType":"structLibOrder.Order","name":"makeOrder","type":"tuple"},{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"indexed":false,"internalType":"structLibOrder.Order","name":"takeOrder","type":"tuple"},{"indexed":false,"internalType":"uint128","name":"fillPrice","type":"uint128"}],"name":"LogMatch","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"OrderKey","name":"orderKey","type":"bytes32"},{"indexed":false,"internalType":"uint64","name":"salt","type":"uint64"}],"name":"LogSkipOrder","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint128","name":"newProtocolShare","type":"uint128"}],"name":"LogUpdatedProtocolShare","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"recipient","type":"address"},{"indexed":false,"internalType":"uint256","name":"amount","type":"uint256"}],"name":"LogWithdrawETH","type":"event"},{"anonymous":false,"inputs":[{"indexed":true,"internalType":"address","name":"previousOwner","type":"address"},{"indexed":true,"internalType":"address","name":"newOwner","type":"address"}],"name":"OwnershipTransferred","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"account","type":"address"}],"name":"Paused","type":"event"},{"anonymous":false,"inputs":[{"indexed":false,"internalType":"address","name":"account","type":"address"}],"name":"Unpaused","type":"event"},{"inputs":[{"internalType":"OrderKey[]","name":"orderKeys","type":"bytes32[]"}],"name":"cancelOrders","outputs":[{"internalType":"bool[]","name":"successes","type":"bool[]"}],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"internalType":"OrderKey","name":"oldOrderKey","type":"bytes32"},{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"newOrder","type":"tuple"}],"internalType":"structLibOrder.EditDetail[]","name":"editDetails","type":"tuple[]"}],"name":"editOrders","outputs":[{"internalType":"OrderKey[]","name":"newOrderKeys","type":"bytes32[]"}],"stateMutability":"payable","type":"function"},{"inputs":[],"name":"eip712Domain","outputs":[{"internalType":"bytes1","name":"fields","type":"bytes1"},{"internalType":"string","name":"name","type":"string"},{"internalType":"string","name":"version","type":"string"},{"internalType":"uint256","name":"chainId","type":"uint256"},{"internalType":"address","name":"verifyingContract","type":"address"},{"internalType":"bytes32","name":"salt","type":"bytes32"},{"internalType":"uint256[]","name":"extensions","type":"uint256[]"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"OrderKey","name":"","type":"bytes32"}],"name":"filledAmount","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"}],"name":"getBestOrder","outputs":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"orderResult","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"collection","type":"address"},{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"}],"name":"getBestPrice","outputs":[{"internalType":"Price","name":"price","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"collection","type":"address"},{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"Price","name":"price","type":"uint128"}],"name":"getNextBestPrice","outputs":[{"internalType":"Price","name":"nextBestPrice","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"uint256","name":"count","type":"uint256"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"OrderKey","name":"firstOrderKey","type":"bytes32"}],"name":"getOrders","outputs":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order[]","name":"resultOrders","type":"tuple[]"},{"internalType":"OrderKey","name":"nextOrderKey","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"uint128","name":"newProtocolShare","type":"uint128"},{"internalType":"address","name":"newVault","type":"address"},{"internalType":"string","name":"EIP712Name","type":"string"},{"internalType":"string","name":"EIP712Version","type":"string"}],"name":"initialize","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order[]","name":"newOrders","type":"tuple[]"}],"name":"makeOrders","outputs":[{"internalType":"OrderKey[]","name":"newOrderKeys","type":"bytes32[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"sellOrder","type":"tuple"},{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"buyOrder","type":"tuple"}],"name":"matchOrder","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"sellOrder","type":"tuple"},{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"buyOrder","type":"tuple"},{"internalType":"uint256","name":"msgValue","type":"uint256"}],"name":"matchOrderWithoutPayback","outputs":[{"internalType":"uint128","name":"costValue","type":"uint128"}],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"sellOrder","type":"tuple"},{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"buyOrder","type":"tuple"}],"internalType":"structLibOrder.MatchDetail[]","name":"matchDetails","type":"tuple[]"}],"name":"matchOrders","outputs":[{"internalType":"bool[]","name":"successes","type":"bool[]"}],"stateMutability":"payable","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"},{"internalType":"enumLibOrder.Side","name":"","type":"uint8"},{"internalType":"Price","name":"","type":"uint128"}],"name":"orderQueues","outputs":[{"internalType":"OrderKey","name":"head","type":"bytes32"},{"internalType":"OrderKey","name":"tail","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"OrderKey","name":"","type":"bytes32"}],"name":"orders","outputs":[{"components":[{"internalType":"enumLibOrder.Side","name":"side","type":"uint8"},{"internalType":"enumLibOrder.SaleKind","name":"saleKind","type":"uint8"},{"internalType":"address","name":"maker","type":"address"},{"components":[{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"address","name":"collection","type":"address"},{"internalType":"uint96","name":"amount","type":"uint96"}],"internalType":"structLibOrder.Asset","name":"nft","type":"tuple"},{"internalType":"Price","name":"price","type":"uint128"},{"internalType":"uint64","name":"expiry","type":"uint64"},{"internalType":"uint64","name":"salt","type":"uint64"}],"internalType":"structLibOrder.Order","name":"order","type":"tuple"},{"internalType":"OrderKey","name":"next","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"pause","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"paused","outputs":[{"internalType":"bool","name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"","type":"address"},{"internalType":"enumLibOrder.Side","name":"","type":"uint8"}],"name":"priceTrees","outputs":[{"internalType":"Price","name":"root","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"protocolShare","outputs":[{"internalType":"uint128","name":"","type":"uint128"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"renounceOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint128","name":"newProtocolShare","type":"uint128"}],"name":"setProtocolShare","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"newVault","type":"address"}],"name":"setVault","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"newOwner","type":"address"}],"name":"transferOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[],"name":"unpause","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"address","name":"recipient","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"}],"name":"withdrawETH","outputs":[],"stateMutability":"nonpayable","type":"function"},{"stateMutability":"payable","type":"receive"}]`
+	contractAbi      = liborder.OrderBookABI
 	FixForCollection = 0 // 集合出价
 	FixForItem       = 1 // 针对特定 Item 出价
 	List             = 0 // 用户的挂单 (Listing)
@@ -51,6 +62,15 @@ const (
 	ZeroAddress = "0x0000000000000000000000000000000000000000"
 )
 
+// eventNames 监听的事件 Topic 对应的事件名, 用于指标标签
+var eventNames = map[string]string{
+	LogMakeTopic:   "LogMake",
+	LogCancelTopic: "LogCancel",
+	LogMatchTopic:  "LogMatch",
+
+	LogUpdatedProtocolShareTopic: "LogUpdatedProtocolShare",
+}
+
 // Order 结构体，用于映射链上事件中的订单结构
 type Order struct {
 	Side     uint8          // 买单 (1) 还是卖单 (0)
@@ -66,6 +86,24 @@ type Order struct {
 	Salt   uint64   // 随机盐值
 }
 
+// key 按 LibOrder.hash 计算订单的 OrderKey
+func (o *Order) key() common.Hash {
+	order := liborder.Order{
+		Side:     o.Side,
+		SaleKind: o.SaleKind,
+		Maker:    o.Maker,
+		Nft: liborder.Asset{
+			TokenId:    o.Nft.TokenId,
+			Collection: o.Nft.CollectionAddr,
+			Amount:     o.Nft.Amount,
+		},
+		Price:  o.Price,
+		Expiry: o.Expiry,
+		Salt:   o.Salt,
+	}
+	return order.Key()
+}
+
 // Service 订单簿索引器服务
 type Service struct {
 	ctx          context.Context
@@ -77,6 +115,26 @@ type Service struct {
 	chainId      int64
 	chain        string
 	parsedAbi    abi.ABI // 解析后的合约 ABI
+
+	chainHeadBlock     atomic.Uint64     // 最近一次获取的链上最新区块
+	lastIndexedBlock   atomic.Uint64     // 已同步的区块高度
+	syncHeartbeat      *health.Heartbeat // 事件同步循环心跳
+	floorHeartbeat     *health.Heartbeat // 地板价维护循环心跳
+	reconcileHeartbeat *health.Heartbeat // 链上对账循环心跳, 未开启对账时为 nil
+	supervisor         *supervisor.Supervisor
+
+	cancels   txCancels  // 当前交易中已取消的订单, 仅在事件处理协程中访问
+	replaying bool       // backfill/reindex 重放历史事件期间为 true, 仅在事件处理协程中访问
+	indexMu   sync.Mutex // 处理一批事件并更新同步进度期间持有, 对账修复时持有以保证数据库状态与同步进度一致
+}
+
+// SyncStatus 订单簿事件同步进度
+type SyncStatus struct {
+	Chain            string `json:"chain"`
+	ChainID          int64  `json:"chain_id"`
+	ChainHeadBlock   uint64 `json:"chain_head_block"`
+	LastIndexedBlock uint64 `json:"last_indexed_block"`
+	LagBlocks        uint64 `json:"lag_blocks"`
 }
 
 // 多链区块延迟配置，防止重组带来的影响
@@ -102,52 +160,67 @@ func New(ctx context.Context, cfg *config.Config, db *gorm.DB, xkv *xkv.Store, c
 		chain:        chain,
 		chainId:      chainId,
 		parsedAbi:    parsedAbi,
+
+		syncHeartbeat:  health.NewHeartbeat("orderbook_event_sync", EventSyncHeartbeatMaxAge),
+		floorHeartbeat: health.NewHeartbeat("collection_floor_upkeep", FloorUpkeepHeartbeatMaxAge),
+		supervisor:     supervisor.New(ctx),
 	}
 }
 
-// Start 启动后台同步任务
+// Start 启动后台同步任务, 循环异常退出后由 supervisor 退避重启
 func (s *Service) Start() {
-	// 启动一个安全的 goroutine 运行订单簿同步循环
-	threading.GoSafe(s.SyncOrderBookEventLoop)
-	// 启动一个安全的 goroutine 运行地板价维护循环
-	threading.GoSafe(s.UpKeepingCollectionFloorChangeLoop)
+	// 运行订单簿同步循环
+	s.supervisor.Go("orderbook_event_sync", s.SyncOrderBookEventLoop)
+	// 运行地板价维护循环
+	s.supervisor.Go("collection_floor_upkeep", s.UpKeepingCollectionFloorChangeLoop)
+	// 运行链上订单对账循环
+	if s.cfg.Reconcile != nil && s.cfg.Reconcile.Enable {
+		s.reconcileHeartbeat = s.newReconcileHeartbeat()
+		s.supervisor.Go("order_reconcile", s.ReconcileLoop)
+	}
+}
+
+// Stop 停止后台同步任务, 等待当前批次处理完成, ctx 到期时返回错误
+func (s *Service) Stop(ctx context.Context) error {
+	return s.supervisor.Shutdown(ctx)
 }
 
 // SyncOrderBookEventLoop 订单簿事件同步循环
 // 负责轮询链上最新的区块，过滤出 EasySwap 合约的相关事件 (Make, Cancel, Match)
-func (s *Service) SyncOrderBookEventLoop() {
+// 同步进度更新失败时返回错误, 重启后从数据库中的同步进度继续
+func (s *Service) SyncOrderBookEventLoop(ctx context.Context) error {
 	var indexedStatus base.IndexedStatus
 	// 1. 从数据库中获取上次同步的状态
 	if err := s.db.WithContext(s.ctx).Table(base.IndexedStatusTableName()).
 		Where("chain_id = ? and index_type = ?", s.chainId, EventIndexType).
 		First(&indexedStatus).Error; err != nil {
-		xzap.WithContext(s.ctx).Error("failed on get listing index status",
-			zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on get listing index status")
 	}
 
 	lastSyncBlock := uint64(indexedStatus.LastIndexedBlock) // 上次已同步的区块高度
 	for {
 		// 检查 context 是否被取消 (优雅退出)
 		select {
-		case <-s.ctx.Done():
+		case <-ctx.Done():
 			xzap.WithContext(s.ctx).Info("SyncOrderBookEventLoop stopped due to context cancellation")
-			return
+			return nil
 		default:
 		}
+		s.syncHeartbeat.Beat()
 
 		// 2. 获取当前链上的最新区块高度
 		currentBlockNum, err := s.chainClient.BlockNumber() // 以轮询的方式获取当前区块高度
 		if err != nil {
 			xzap.WithContext(s.ctx).Error("failed on get current block number", zap.Error(err))
-			time.Sleep(SleepInterval * time.Second) // 出错休眠
+			supervisor.Sleep(ctx, SleepInterval*time.Second) // 出错休眠
 			continue
 		}
+		s.observeLag(currentBlockNum, lastSyncBlock)
 
 		// 3. 检查是否有新区块
 		// 需要减去 MultiChainMaxBlockDifference 以防止区块重组 (Reorg) 导致的不一致
 		if lastSyncBlock > currentBlockNum-MultiChainMaxBlockDifference[s.chain] { // 如果上次同步的区块高度大于当前区块高度，等待一段时间后再次轮询
-			time.Sleep(SleepInterval * time.Second)
+			supervisor.Sleep(ctx, SleepInterval*time.Second)
 			continue
 		}
 
@@ -170,23 +243,28 @@ func (s *Service) SyncOrderBookEventLoop() {
 		logs, err := s.chainClient.FilterLogs(s.ctx, query) //同时获取多个（SyncBlockPeriod）区块的日志
 		if err != nil {
 			xzap.WithContext(s.ctx).Error("failed on get log", zap.Error(err))
-			time.Sleep(SleepInterval * time.Second)
+			supervisor.Sleep(ctx, SleepInterval*time.Second)
 			continue
 		}
 
 		// 7. 遍历并处理日志
+		s.indexMu.Lock()
 		for _, log := range logs { // 遍历日志，根据不同的topic处理不同的事件
 			ethLog := log.(ethereumTypes.Log)
-			// 根据 Topic[0] (事件签名) 分发处理
-			switch ethLog.Topics[0].String() {
-			case LogMakeTopic: // 挂单事件
-				s.handleMakeEvent(ethLog)
-			case LogCancelTopic: // 取消订单事件
-				s.handleCancelEvent(ethLog)
-			case LogMatchTopic: // 撮合成功事件
-				s.handleMatchEvent(ethLog)
-			default:
-				// 忽略其他事件
+			eventName, ok := eventNames[ethLog.Topics[0].String()]
+			if !ok { // 忽略其他事件
+				continue
+			}
+
+			err := s.handleLog(ethLog)
+			metrics.LogsProcessed.WithLabelValues(s.chain, eventName).Inc()
+			if err != nil {
+				metrics.HandlerErrors.WithLabelValues(s.chain, eventName).Inc()
+				xzap.WithContext(s.ctx).Error("failed on handle orderbook event",
+					zap.String("event", eventName),
+					zap.String("tx_hash", ethLog.TxHash.String()),
+					zap.Uint("log_index", ethLog.Index),
+					zap.Error(err))
 			}
 		}
 
@@ -195,11 +273,16 @@ func (s *Service) SyncOrderBookEventLoop() {
 		// 8. 更新数据库中的同步状态
 		if err := s.db.WithContext(s.ctx).Table(base.IndexedStatusTableName()).
 			Where("chain_id = ? and index_type = ?", s.chainId, EventIndexType).
-			Update("last_indexed_block", lastSyncBlock).Error; err != nil {
-			xzap.WithContext(s.ctx).Error("failed on update orderbook event sync block number",
-				zap.Error(err))
-			return
+			Updates(map[string]interface{}{
+				"last_indexed_block": lastSyncBlock,
+				"last_indexed_time":  time.Now().Unix(),
+			}).Error; err != nil {
+			s.indexMu.Unlock()
+			return errors.Wrap(err, "failed on update orderbook event sync block number")
 		}
+		s.indexMu.Unlock()
+
+		s.observeLag(currentBlockNum, lastSyncBlock)
 
 		xzap.WithContext(s.ctx).Info("sync orderbook event ...",
 			zap.Uint64("start_block", startBlock),
@@ -207,9 +290,54 @@ func (s *Service) SyncOrderBookEventLoop() {
 	}
 }
 
+// observeLag 更新链上最新区块与同步进度指标
+func (s *Service) observeLag(currentBlock, lastSyncBlock uint64) {
+	s.chainHeadBlock.Store(currentBlock)
+	s.lastIndexedBlock.Store(lastSyncBlock)
+	metrics.ChainHeadBlock.WithLabelValues(s.chain).Set(float64(currentBlock))
+	metrics.LastIndexedBlock.WithLabelValues(s.chain).Set(float64(lastSyncBlock))
+	var lag float64
+	if currentBlock > lastSyncBlock {
+		lag = float64(currentBlock - lastSyncBlock)
+	}
+	metrics.IndexerLagBlocks.WithLabelValues(s.chain).Set(lag)
+}
+
+// Status 返回当前同步进度
+func (s *Service) Status() SyncStatus {
+	status := SyncStatus{
+		Chain:            s.chain,
+		ChainID:          s.chainId,
+		ChainHeadBlock:   s.chainHeadBlock.Load(),
+		LastIndexedBlock: s.lastIndexedBlock.Load(),
+	}
+	if status.ChainHeadBlock > status.LastIndexedBlock {
+		status.LagBlocks = status.ChainHeadBlock - status.LastIndexedBlock
+	}
+	return status
+}
+
+// handleLog 根据 Topic[0] (事件签名) 分发处理, 非订单簿事件直接忽略
+func (s *Service) handleLog(log ethereumTypes.Log) error {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	switch log.Topics[0].String() {
+	case LogMakeTopic: // 挂单事件
+		return s.handleMakeEvent(log)
+	case LogCancelTopic: // 取消订单事件
+		return s.handleCancelEvent(log)
+	case LogMatchTopic: // 撮合成功事件
+		return s.handleMatchEvent(log)
+	case LogUpdatedProtocolShareTopic: // 协议手续费率变更事件
+		return s.handleProtocolShareEvent(log)
+	}
+	return nil
+}
+
 // handleMakeEvent 处理挂单 (Make Order) 事件
 // 当用户在 EasySwap 创建新订单时触发
-func (s *Service) handleMakeEvent(log ethereumTypes.Log) {
+func (s *Service) handleMakeEvent(log ethereumTypes.Log) error {
 	// 定义事件数据结构，与合约中的 LogMake 事件参数对应
 	var event struct {
 		OrderKey [32]byte
@@ -226,8 +354,7 @@ func (s *Service) handleMakeEvent(log ethereumTypes.Log) {
 	// 1. 解析日志数据 (Data 字段)
 	err := s.parsedAbi.UnpackIntoInterface(&event, "LogMake", log.Data) // 通过ABI解析日志数据
 	if err != nil {
-		xzap.WithContext(s.ctx).Error("Error unpacking LogMake event:", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on unpack LogMake event")
 	}
 
 	// 2. 解析 Topics 中的索引字段 (Indexed fields)
@@ -236,6 +363,13 @@ func (s *Service) handleMakeEvent(log ethereumTypes.Log) {
 	saleKind := uint8(new(big.Int).SetBytes(log.Topics[2].Bytes()).Uint64()) // 销售类型
 	maker := common.BytesToAddress(log.Topics[3].Bytes())                    // 挂单人地址
 
+	// 校验事件中的 OrderKey 与订单字段一致, 避免错误数据写入订单表
+	makeOrder := Order{Side: side, SaleKind: saleKind, Maker: maker, Nft: event.Nft,
+		Price: event.Price, Expiry: event.Expiry, Salt: event.Salt}
+	if makeOrder.key() != common.Hash(event.OrderKey) {
+		return errors.Errorf("order key mismatch in LogMake event, order_key: %s", HexPrefix+hex.EncodeToString(event.OrderKey[:]))
+	}
+
 	// 3. 确定订单类型 (Listing / Bid)
 	var orderType int64
 	if side == Bid { // 买单 (Offer)
@@ -266,20 +400,35 @@ func (s *Service) handleMakeEvent(log ethereumTypes.Log) {
 		OrderType:         orderType,
 		Salt:              int64(event.Salt),
 	}
+	// makeOrder 时买单的 ETH (price * amount) 及卖单的 NFT 转入 Vault 托管
+	if side == Bid {
+		newOrder.EscrowEth = newOrder.Price.Mul(decimal.NewFromInt(newOrder.Size))
+	} else {
+		newOrder.EscrowNft = true
+	}
+	// 同一交易中先取消了同一资产的订单, 视为 editOrders 修改订单
+	prevOrder := s.takeEditedOrder(log.TxHash, &newOrder)
+	if prevOrder != nil {
+		newOrder.PrevOrderID = prevOrder.OrderID
+	}
 
 	// 5. 将订单保存到数据库
-	if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).Clauses(clause.OnConflict{
+	result := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).Clauses(clause.OnConflict{
 		DoNothing: true, // 如果订单已存在则忽略
-	}).Create(&newOrder).Error; err != nil { // 将订单信息存入数据库
+	}).Create(&newOrder) // 将订单信息存入数据库
+	if result.Error != nil {
 		xzap.WithContext(s.ctx).Error("failed on create order",
-			zap.Error(err))
+			zap.Error(result.Error))
+	} else if result.RowsAffected == 0 {
+		// 订单已存在: 若为 backend 接收的链下签名订单, 则以链上订单为准进行对账
+		s.reconcileSignedOrder(&newOrder)
 	}
+	s.notifyOutbid(&newOrder)
 
 	// 获取区块时间
 	blockTime, err := s.chainClient.BlockTimeByNumber(s.ctx, big.NewInt(int64(log.BlockNumber)))
 	if err != nil {
-		xzap.WithContext(s.ctx).Error("failed to get block time", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on get block time")
 	}
 
 	// 6. 确定活动类型 (Activity Type)
@@ -308,6 +457,12 @@ func (s *Service) handleMakeEvent(log ethereumTypes.Log) {
 		TxHash:            log.TxHash.String(),
 		EventTime:         int64(blockTime),
 	}
+	// 修改订单只记录一条修改活动, 替代旧订单的取消活动及新订单的挂单/出价活动
+	if prevOrder != nil {
+		s.deleteCancelActivity(log.TxHash, prevOrder)
+		newActivity.ActivityType = editActivityType(orderType)
+		newActivity.PrevPrice = prevOrder.Price
+	}
 	if err := s.db.WithContext(s.ctx).Table(multi.ActivityTableName(s.chain)).Clauses(clause.OnConflict{
 		DoNothing: true,
 	}).Create(&newActivity).Error; err != nil {
@@ -328,11 +483,45 @@ func (s *Service) handleMakeEvent(log ethereumTypes.Log) {
 			zap.Error(err),
 			zap.String("order_id", newOrder.OrderID))
 	}
+	return nil
+}
+
+// reconcileSignedOrder 链下签名订单上链后的对账
+// 同一 OrderKey 的链下订单被 makeOrders 提交上链时, 以链上数据覆盖并清空签名, 由 Pending 转为 Active 的链上订单
+// 仅处理仍带签名的订单, 重复处理同一 LogMake 不会改变已成交/已取消的链上订单
+func (s *Service) reconcileSignedOrder(order *multi.Order) {
+	result := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
+		Where("order_id = ? and signature != ''", order.OrderID).
+		Updates(map[string]interface{}{
+			"order_status":       multi.OrderStatusActive,
+			"event_time":         order.EventTime,
+			"quantity_remaining": order.QuantityRemaining,
+			"size":               order.Size,
+			"signature":          "",
+			"escrow_eth":         order.EscrowEth,
+			"escrow_nft":         order.EscrowNft,
+		})
+	if result.Error != nil {
+		xzap.WithContext(s.ctx).Error("failed on reconcile signed order",
+			zap.Error(result.Error), zap.String("order_id", order.OrderID))
+		return
+	}
+	if result.RowsAffected == 0 {
+		return
+	}
+
+	// 早期的链下订单活动以订单 ID 作为 tx_hash, 上链后由链上活动替代
+	if err := s.db.WithContext(s.ctx).Table(multi.ActivityTableName(s.chain)).
+		Where("tx_hash = ?", order.OrderID).
+		Delete(&multi.Activity{}).Error; err != nil {
+		xzap.WithContext(s.ctx).Warn("failed on delete signed order activity",
+			zap.Error(err), zap.String("order_id", order.OrderID))
+	}
 }
 
 // handleMatchEvent 处理撮合 (Match Order) 事件
 // 当买卖单匹配成交时触发
-func (s *Service) handleMatchEvent(log ethereumTypes.Log) {
+func (s *Service) handleMatchEvent(log ethereumTypes.Log) error {
 	// 定义事件数据结构 (仅包含非索引字段)
 	var event struct {
 		MakeOrder Order
@@ -343,140 +532,86 @@ func (s *Service) handleMatchEvent(log ethereumTypes.Log) {
 	// 1. 解析日志数据
 	err := s.parsedAbi.UnpackIntoInterface(&event, "LogMatch", log.Data)
 	if err != nil {
-		xzap.WithContext(s.ctx).Error("Error unpacking LogMatch event:", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on unpack LogMatch event")
 	}
 
 	// 2. 从 Topics 中获取订单 ID (索引字段)
 	makeOrderId := HexPrefix + hex.EncodeToString(log.Topics[1].Bytes()) // 挂单 ID
 	takeOrderId := HexPrefix + hex.EncodeToString(log.Topics[2].Bytes()) // 吃单 ID
+	if event.MakeOrder.key() != log.Topics[1] || event.TakeOrder.key() != log.Topics[2] {
+		return errors.Errorf("order key mismatch in LogMatch event, make_order_id: %s, take_order_id: %s", makeOrderId, takeOrderId)
+	}
 
 	var owner string      // 新的 NFT 所有者 (买家)
 	var collection string // 集合地址
 	var tokenId string    // Token ID
 	var from string       // 卖方
 	var to string         // 买方
-	var sellOrderId string
-	var buyOrder multi.Order
+	var sellOrderId, buyOrderId string
+	var sellOrder, buyOrder Order
 
-	// 3. 根据挂单方向处理逻辑
+	// 3. 根据挂单方向确定买卖双方
 	if event.MakeOrder.Side == Bid {
 		// A. 挂单是买单 (Bid) -> 这意味着是由卖方 (Seller) 主动吃单 (Take)
-		owner = strings.ToLower(event.MakeOrder.Maker.String())
-		collection = event.TakeOrder.Nft.CollectionAddr.String()
-		tokenId = event.TakeOrder.Nft.TokenId.String()
-		from = event.TakeOrder.Maker.String() // Taker 是卖方
-		to = event.MakeOrder.Maker.String()   // Maker 是买方
-		sellOrderId = takeOrderId
-
-		// 3.1 更新卖方订单状态 (吃单者)
-		// 将卖单状态更新为 已成交 (Filled)
-		if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-			Where("order_id = ?", takeOrderId).
-			Updates(map[string]interface{}{
-				"order_status":       multi.OrderStatusFilled,
-				"quantity_remaining": 0,
-				"taker":              to,
-			}).Error; err != nil {
-			xzap.WithContext(s.ctx).Error("failed on update order status",
-				zap.String("order_id", takeOrderId))
-			return
-		}
-
-		// 3.2 更新买方订单状态 (挂单者)
-		// 查询买单信息
-		if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-			Where("order_id = ?", makeOrderId).
-			First(&buyOrder).Error; err != nil {
-			xzap.WithContext(s.ctx).Error("failed on get buy order",
-				zap.Error(err))
-			return
-		}
-
-		// 扣减买单剩余数量
-		if buyOrder.QuantityRemaining > 1 {
-			// 如果还有剩余数量，只更新 quantity_remaining
-			if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-				Where("order_id = ?", makeOrderId).
-				Update("quantity_remaining", buyOrder.QuantityRemaining-1).Error; err != nil {
-				xzap.WithContext(s.ctx).Error("failed on update order quantity_remaining",
-					zap.String("order_id", makeOrderId))
-				return
-			}
-		} else {
-			// 如果没有剩余数量，更新状态为 Filled
-			if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-				Where("order_id = ?", makeOrderId).
-				Updates(map[string]interface{}{
-					"order_status":       multi.OrderStatusFilled,
-					"quantity_remaining": 0,
-				}).Error; err != nil {
-				xzap.WithContext(s.ctx).Error("failed on update order status",
-					zap.String("order_id", makeOrderId))
-				return
-			}
-		}
+		sellOrder, buyOrder = event.TakeOrder, event.MakeOrder
+		sellOrderId, buyOrderId = takeOrderId, makeOrderId
 	} else {
 		// B. 挂单是卖单 (Listing) -> 这意味着是由买方 (Buyer) 主动吃单 (Take)
-		owner = strings.ToLower(event.TakeOrder.Maker.String())
-		collection = event.MakeOrder.Nft.CollectionAddr.String()
-		tokenId = event.MakeOrder.Nft.TokenId.String()
-		from = event.MakeOrder.Maker.String() // Maker 是卖方
-		to = event.TakeOrder.Maker.String()   // Taker 是买方
-		sellOrderId = makeOrderId             // 挂单是卖单
-
-		// 3.3 更新卖方订单状态 (挂单者)
-		// 更新卖单为 已成交
-		if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-			Where("order_id = ?", makeOrderId).
-			Updates(map[string]interface{}{
-				"order_status":       multi.OrderStatusFilled,
-				"quantity_remaining": 0,
-				"taker":              to, // 设置 Taker 为买方
-			}).Error; err != nil {
-			xzap.WithContext(s.ctx).Error("failed on update order status",
-				zap.String("order_id", makeOrderId))
-			return
-		}
-
-		// 3.4 更新买方订单状态 (吃单者)
-		// 查询买单信息 (如果存在)
-		if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-			Where("order_id = ?", takeOrderId).
-			First(&buyOrder).Error; err != nil {
-			xzap.WithContext(s.ctx).Error("failed on get buy order",
-				zap.Error(err))
-			return
-		}
-
-		// 扣减买单剩余数量 (通常 Taker 也是立即成交，但可能有逻辑允许部分成交)
-		if buyOrder.QuantityRemaining > 1 {
-			if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-				Where("order_id = ?", takeOrderId).
-				Update("quantity_remaining", buyOrder.QuantityRemaining-1).Error; err != nil {
-				xzap.WithContext(s.ctx).Error("failed on update order quantity_remaining",
-					zap.String("order_id", takeOrderId))
-				return
-			}
-		} else {
-			if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
-				Where("order_id = ?", takeOrderId).
-				Updates(map[string]interface{}{
-					"order_status":       multi.OrderStatusFilled,
-					"quantity_remaining": 0,
-				}).Error; err != nil {
-				xzap.WithContext(s.ctx).Error("failed on update order status",
-					zap.String("order_id", takeOrderId))
-				return
-			}
-		}
+		sellOrder, buyOrder = event.MakeOrder, event.TakeOrder
+		sellOrderId, buyOrderId = makeOrderId, takeOrderId
 	}
+	owner = strings.ToLower(buyOrder.Maker.String())
+	collection = sellOrder.Nft.CollectionAddr.String()
+	tokenId = sellOrder.Nft.TokenId.String()
+	from = sellOrder.Maker.String()
+	to = buyOrder.Maker.String()
 
 	// 4. 获取区块时间
 	blockTime, err := s.chainClient.BlockTimeByNumber(s.ctx, big.NewInt(int64(log.BlockNumber)))
 	if err != nil {
-		xzap.WithContext(s.ctx).Error("failed to get block time", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on get block time")
+	}
+
+	// 4.1 记录买卖双方订单的成交, 重复处理同一 LogMatch 不会重复记录
+	fills := matchFills(sellOrderId, buyOrderId, &sellOrder, &buyOrder)
+	// 买方吃单时, 买单可能来自属性出价的撮合, 同时记录属性出价的成交
+	var traitBidID string
+	if event.MakeOrder.Side == Bid {
+		traitFill, err := s.traitBidFill(sellOrderId, &buyOrder)
+		if err != nil {
+			return err
+		}
+		if traitFill != nil {
+			traitBidID = traitFill.OrderID
+			fills = append(fills, *traitFill)
+		}
+	}
+	for i := range fills {
+		fills[i].CollectionAddress = collection
+		fills[i].TokenId = tokenId
+		fills[i].Price = decimal.NewFromBigInt(event.FillPrice, 0)
+		fills[i].TxHash = log.TxHash.String()
+		fills[i].LogIndex = int64(log.Index)
+		fills[i].BlockNumber = int64(log.BlockNumber)
+		fills[i].EventTime = int64(blockTime)
+	}
+	if err := s.db.WithContext(s.ctx).Table(multi.FillTableName(s.chain)).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(&fills).Error; err != nil {
+		return errors.Wrap(err, "failed on create fills")
+	}
+
+	// 4.2 由成交记录推导买卖双方订单的剩余数量
+	if err := s.syncFilledQuantity(sellOrderId, map[string]interface{}{"taker": to}); err != nil {
+		return err
+	}
+	if err := s.syncFilledQuantity(buyOrderId, map[string]interface{}{}); err != nil {
+		return err
+	}
+	if traitBidID != "" {
+		if err := s.syncFilledQuantity(traitBidID, map[string]interface{}{}); err != nil {
+			return err
+		}
 	}
 
 	// 5. 构造并保存 成交活动 (Sale Activity)
@@ -505,11 +640,13 @@ func (s *Service) handleMatchEvent(log ethereumTypes.Log) {
 	if err := s.db.WithContext(s.ctx).Table(multi.ItemTableName(s.chain)).
 		Where("collection_address = ? and token_id = ?", strings.ToLower(collection), tokenId).
 		Update("owner", owner).Error; err != nil {
-		xzap.WithContext(s.ctx).Error("failed to update item owner",
-			zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on update item owner")
 	}
 
+	// 6.1 通知卖方挂单成交, 卖方接受出价时通知买方出价被接受
+	s.notifyMatch(log, sellOrderId, buyOrderId, from, to, collection, tokenId,
+		decimal.NewFromBigInt(event.FillPrice, 0), bidAccepted(event.MakeOrder))
+
 	// 7. 触发价格更新 (Price Update)
 	// 将交易信息存入价格更新队列，用于更新地板价、成交量等
 	if err := ordermanager.AddUpdatePriceEvent(s.kv, &ordermanager.TradeEvent{
@@ -525,10 +662,37 @@ func (s *Service) handleMatchEvent(log ethereumTypes.Log) {
 			zap.String("type", "sale"),
 			zap.String("order_id", sellOrderId))
 	}
+	return nil
+}
+
+// handleProtocolShareEvent 处理协议手续费率变更事件, 记录费率历史用于按成交时的费率计算手续费
+// 合约 initialize 设置初始费率时同样会触发该事件
+func (s *Service) handleProtocolShareEvent(log ethereumTypes.Log) error {
+	if len(log.Topics) < 2 {
+		return errors.New("invalid LogUpdatedProtocolShare event")
+	}
+	blockTime, err := s.chainClient.BlockTimeByNumber(s.ctx, big.NewInt(int64(log.BlockNumber)))
+	if err != nil {
+		return errors.Wrap(err, "failed on get block time")
+	}
+
+	share := multi.ProtocolShare{
+		Share:       new(big.Int).SetBytes(log.Topics[1].Bytes()).Int64(),
+		TxHash:      log.TxHash.String(),
+		LogIndex:    int64(log.Index),
+		BlockNumber: int64(log.BlockNumber),
+		EventTime:   int64(blockTime),
+	}
+	if err := s.db.WithContext(s.ctx).Table(multi.ProtocolShareTableName(s.chain)).Clauses(clause.OnConflict{
+		DoNothing: true,
+	}).Create(&share).Error; err != nil {
+		return errors.Wrap(err, "failed on create protocol share")
+	}
+	return nil
 }
 
 // handleCancelEvent 处理订单取消 (Cancel Order) 事件
-func (s *Service) handleCancelEvent(log ethereumTypes.Log) {
+func (s *Service) handleCancelEvent(log ethereumTypes.Log) error {
 	// 1. 从 Topics 中解析订单 ID
 	orderId := HexPrefix + hex.EncodeToString(log.Topics[1].Bytes())
 	//maker := common.BytesToAddress(log.Topics[2].Bytes()) // Maker 地址 (未使用)
@@ -536,10 +700,12 @@ func (s *Service) handleCancelEvent(log ethereumTypes.Log) {
 	// 2. 更新数据库中订单状态为 Cancelled
 	if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
 		Where("order_id = ?", orderId).
-		Update("order_status", multi.OrderStatusCancelled).Error; err != nil {
-		xzap.WithContext(s.ctx).Error("failed on update order status",
-			zap.String("order_id", orderId))
-		return
+		Updates(map[string]interface{}{
+			"order_status": multi.OrderStatusCancelled,
+			"escrow_eth":   decimal.Zero,
+			"escrow_nft":   false,
+		}).Error; err != nil {
+		return errors.Wrapf(err, "failed on update order status, order_id: %s", orderId)
 	}
 
 	// 3. 获取被取消的订单详情
@@ -547,27 +713,20 @@ func (s *Service) handleCancelEvent(log ethereumTypes.Log) {
 	if err := s.db.WithContext(s.ctx).Table(multi.OrderTableName(s.chain)).
 		Where("order_id = ?", orderId).
 		First(&cancelOrder).Error; err != nil {
-		xzap.WithContext(s.ctx).Error("failed on get cancel order",
-			zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on get cancel order")
 	}
 
 	// 获取区块时间
 	blockTime, err := s.chainClient.BlockTimeByNumber(s.ctx, big.NewInt(int64(log.BlockNumber)))
 	if err != nil {
-		xzap.WithContext(s.ctx).Error("failed to get block time", zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on get block time")
 	}
 
+	// 记录取消的订单, 同一交易中随后创建的同一资产订单视为修改订单
+	s.recordCancel(log.TxHash, cancelOrder)
+
 	// 4. 确定取消活动类型 (Cancel Listing / Cancel Bid)
-	var activityType int
-	if cancelOrder.OrderType == multi.ListingOrder {
-		activityType = multi.CancelListing
-	} else if cancelOrder.OrderType == multi.CollectionBidOrder {
-		activityType = multi.CancelCollectionBid
-	} else {
-		activityType = multi.CancelItemBid
-	}
+	activityType := cancelActivityType(cancelOrder.OrderType)
 
 	// 5. 构造并保存取消活动 (Cancel Activity)
 	newActivity := multi.Activity{
@@ -602,11 +761,12 @@ func (s *Service) handleCancelEvent(log ethereumTypes.Log) {
 			zap.String("type", "cancel"),
 			zap.String("order_id", cancelOrder.OrderID))
 	}
+	return nil
 }
 
 // UpKeepingCollectionFloorChangeLoop 维护集合地板价变化的循环
 // 定期统计并更新各个 Collection 的地板价 (Floor Price)
-func (s *Service) UpKeepingCollectionFloorChangeLoop() {
+func (s *Service) UpKeepingCollectionFloorChangeLoop(ctx context.Context) error {
 	// 定义定时器
 	timer := time.NewTicker(comm.DaySeconds * time.Second) // 每天清理一次过期数据
 	defer timer.Stop()
@@ -619,16 +779,15 @@ func (s *Service) UpKeepingCollectionFloorChangeLoop() {
 		Select("last_indexed_time").
 		Where("chain_id = ? and index_type = ?", s.chainId, comm.CollectionFloorChangeIndexType).
 		First(&indexedStatus).Error; err != nil {
-		xzap.WithContext(s.ctx).Error("failed on get collection floor change index status",
-			zap.Error(err))
-		return
+		return errors.Wrap(err, "failed on get collection floor change index status")
 	}
 
 	for {
+		s.floorHeartbeat.Beat()
 		select {
-		case <-s.ctx.Done():
+		case <-ctx.Done():
 			xzap.WithContext(s.ctx).Info("UpKeepingCollectionFloorChangeLoop stopped due to context cancellation")
-			return
+			return nil
 		case <-timer.C:
 			// 每天触发：清理数据库中过期的地板价记录
 			if err := s.deleteExpireCollectionFloorChangeFromDatabase(); err != nil {
@@ -653,7 +812,6 @@ func (s *Service) UpKeepingCollectionFloorChangeLoop() {
 					continue
 				}
 			}
-		default:
 		}
 	}
 }
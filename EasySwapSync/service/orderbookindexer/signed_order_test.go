@@ -0,0 +1,46 @@
+package orderbookindexer
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	signedOrderUpdate         = regexp.QuoteMeta("UPDATE `ob_order_sepolia` SET `escrow_eth`=?,`escrow_nft`=?,`event_time`=?,`order_status`=?,`quantity_remaining`=?,`signature`=?,`size`=? WHERE order_id = ? and signature != ''")
+	signedOrderActivityDelete = regexp.QuoteMeta("DELETE FROM `ob_activity_sepolia` WHERE tx_hash = ?")
+)
+
+func TestReconcileSignedOrderActivates(t *testing.T) {
+	s, mock := newMockService(t, nil)
+	order := &multi.Order{OrderID: "0x01", EventTime: 100, QuantityRemaining: 1, Size: 1, EscrowEth: decimal.Zero, EscrowNft: true}
+
+	// LogMake 将链下签名订单转为 Active 并清空签名, 同时删除链下订单活动
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderUpdate).
+		WithArgs(decimal.Zero, true, int64(100), multi.OrderStatusActive, int64(1), "", int64(1), "0x01").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderActivityDelete).WithArgs("0x01").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	s.reconcileSignedOrder(order)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReconcileSignedOrderNotSigned(t *testing.T) {
+	s, mock := newMockService(t, nil)
+
+	// 非链下签名订单不更新时不删除活动
+	mock.ExpectBegin()
+	mock.ExpectExec(signedOrderUpdate).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	s.reconcileSignedOrder(&multi.Order{OrderID: "0x02"})
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,239 @@
+package rarityindexer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ProjectsTask/EasySwapBase/logger/xzap"
+	"github.com/ProjectsTask/EasySwapBase/rarity"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb"
+	"github.com/ProjectsTask/EasySwapBase/stores/gdb/orderbookmodel/multi"
+	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
+	"github.com/ProjectsTask/EasySwapBase/supervisor"
+	"github.com/pkg/errors"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"github.com/ProjectsTask/EasySwapSync/service/comm"
+)
+
+const SleepInterval = 5 // 队列为空时的休眠间隔 (秒)
+
+// Service 稀有度计算服务
+// 消费稀有度重算队列, 按集合重新计算并持久化每个 item 的稀有度分数及排名
+type Service struct {
+	ctx     context.Context
+	db      *gorm.DB
+	kv      *xkv.Store
+	chain   string
+	project string
+
+	supervisor *supervisor.Supervisor
+}
+
+// New 初始化稀有度计算服务
+func New(ctx context.Context, db *gorm.DB, kv *xkv.Store, chain string, project string) *Service {
+	return &Service{
+		ctx:     ctx,
+		db:      db,
+		kv:      kv,
+		chain:   chain,
+		project: project,
+
+		supervisor: supervisor.New(ctx),
+	}
+}
+
+// Start 启动后台计算任务, 循环异常退出后由 supervisor 退避重启
+func (s *Service) Start() {
+	s.supervisor.Go("rarity_refresh", s.RarityRefreshLoop)
+}
+
+// Stop 停止后台计算任务, 等待当前集合计算完成, ctx 到期时返回错误
+func (s *Service) Stop(ctx context.Context) error {
+	return s.supervisor.Shutdown(ctx)
+}
+
+// RarityRefreshLoop 稀有度重算循环
+// 1. 启动时将存在未排名 item 的集合加入队列 (历史数据补算)
+// 2. 持续从队列中取出集合并重新计算稀有度
+func (s *Service) RarityRefreshLoop(ctx context.Context) error {
+	if err := s.enqueueUnrankedCollections(); err != nil {
+		xzap.WithContext(s.ctx).Error("failed on enqueue unranked collections", zap.Error(err))
+	}
+
+	key := rarity.GenRarityRefreshCacheKey(s.chain)
+	var lastPromote time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			xzap.WithContext(s.ctx).Info("RarityRefreshLoop stopped due to context cancellation")
+			return nil
+		default:
+		}
+
+		if time.Since(lastPromote) >= SleepInterval*time.Second {
+			if err := s.promotePendingRefreshes(); err != nil {
+				xzap.WithContext(s.ctx).Warn("failed on promote pending rarity refreshes", zap.Error(err))
+			}
+			lastPromote = time.Now()
+		}
+
+		collectionAddr, err := s.kv.Spop(key)
+		if err != nil || collectionAddr == "" {
+			if err != nil && err != redis.Nil {
+				xzap.WithContext(s.ctx).Warn("failed on get collection from rarity refresh queue", zap.Error(err))
+			}
+			supervisor.Sleep(ctx, SleepInterval*time.Second)
+			continue
+		}
+
+		if err := s.RefreshCollectionRarity(collectionAddr); err != nil {
+			xzap.WithContext(s.ctx).Error("failed on refresh collection rarity",
+				zap.String("collection_addr", collectionAddr), zap.Error(err))
+			continue
+		}
+		xzap.WithContext(s.ctx).Info("refresh collection rarity", zap.String("collection_addr", collectionAddr))
+	}
+}
+
+// promotePendingRefreshes 将元数据已刷新完成 (item_external 更新时间晚于请求时间) 或等待超时的 Item 所在集合加入重算队列
+func (s *Service) promotePendingRefreshes() error {
+	items, err := rarity.ListPendingMetadataRefresh(s.kv, s.chain)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UnixMilli()
+	for _, item := range items {
+		if !metadataRefreshTimedOut(item, now) {
+			var updateTimes []int64
+			if err := s.db.WithContext(s.ctx).Table(gdb.GetMultiProjectItemExternalTableName(s.project, s.chain)).
+				Where("collection_address = ? and token_id = ?", item.CollectionAddr, item.TokenId).
+				Limit(1).
+				Pluck("update_time", &updateTimes).Error; err != nil {
+				return errors.Wrap(err, "failed on query item external update time")
+			}
+			if len(updateTimes) == 0 || updateTimes[0] < item.RequestTime {
+				continue
+			}
+		}
+
+		if err := rarity.AddCollectionToRefreshQueue(s.kv, s.chain, item.CollectionAddr); err != nil {
+			return err
+		}
+		if err := rarity.RemovePendingMetadataRefresh(s.kv, s.chain, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// metadataRefreshTimedOut 元数据刷新是否已等待超时
+func metadataRefreshTimedOut(item rarity.PendingItem, now int64) bool {
+	return now-item.RequestTime >= rarity.MetadataRefreshTimeout*1000
+}
+
+// enqueueUnrankedCollections 将存在有属性但未排名 item 的集合加入重算队列
+func (s *Service) enqueueUnrankedCollections() error {
+	var collections []string
+	if err := s.db.WithContext(s.ctx).Table(fmt.Sprintf("%s as ci", gdb.GetMultiProjectItemTableName(s.project, s.chain))).
+		Distinct("ci.collection_address").
+		Joins(fmt.Sprintf("join %s cit on cit.collection_address = ci.collection_address and cit.token_id = ci.token_id",
+			gdb.GetMultiProjectItemTraitTableName(s.project, s.chain))).
+		Where("ci.rarity_rank = 0 or ci.rarity_rank is null").
+		Pluck("ci.collection_address", &collections).Error; err != nil {
+		return errors.Wrap(err, "failed on query unranked collections")
+	}
+
+	for _, collection := range collections {
+		if err := rarity.AddCollectionToRefreshQueue(s.kv, s.chain, collection); err != nil {
+			return errors.Wrap(err, "failed on add collection to rarity refresh queue")
+		}
+	}
+	return nil
+}
+
+// RefreshCollectionRarity 重新计算指定集合的稀有度
+// 1. 加载集合内全部 item 及其属性 (无属性的 item 同样参与统计)
+// 2. 计算统计稀有度与属性个数稀有度
+// 3. 分批写回 item 表
+func (s *Service) RefreshCollectionRarity(collectionAddr string) error {
+	var tokenIds []string
+	if err := s.db.WithContext(s.ctx).Table(gdb.GetMultiProjectItemTableName(s.project, s.chain)).
+		Where("collection_address = ?", collectionAddr).
+		Pluck("token_id", &tokenIds).Error; err != nil {
+		return errors.Wrap(err, "failed on query collection items")
+	}
+	if len(tokenIds) == 0 {
+		return nil
+	}
+
+	var traits []multi.ItemTrait
+	if err := s.db.WithContext(s.ctx).Table(gdb.GetMultiProjectItemTraitTableName(s.project, s.chain)).
+		Select("token_id, trait, trait_value").
+		Where("collection_address = ?", collectionAddr).
+		Scan(&traits).Error; err != nil {
+		return errors.Wrap(err, "failed on query collection traits")
+	}
+
+	items := make(map[string][]rarity.Trait, len(tokenIds))
+	for _, tokenId := range tokenIds {
+		items[tokenId] = nil
+	}
+	for _, t := range traits {
+		if _, ok := items[t.TokenId]; !ok { // 忽略不存在于 item 表中的属性
+			continue
+		}
+		items[t.TokenId] = append(items[t.TokenId], rarity.Trait{Trait: t.Trait, TraitValue: t.TraitValue})
+	}
+
+	return s.persistCollectionRarity(collectionAddr, rarity.Calculate(items))
+}
+
+// persistCollectionRarity 分批更新 item 的稀有度字段
+func (s *Service) persistCollectionRarity(collectionAddr string, results []rarity.Result) error {
+	for i := 0; i < len(results); i += comm.DBBatchSizeLimit {
+		end := i + comm.DBBatchSizeLimit
+		if end > len(results) {
+			end = len(results)
+		}
+
+		var scoreCases, rankCases, traitCountScoreCases, traitCountRankCases []string
+		var scoreArgs, rankArgs, traitCountScoreArgs, traitCountRankArgs []interface{}
+		var tokenIds []string
+		for _, r := range results[i:end] {
+			scoreCases = append(scoreCases, "WHEN ? THEN ?")
+			scoreArgs = append(scoreArgs, r.TokenId, r.RarityScore)
+			rankCases = append(rankCases, "WHEN ? THEN ?")
+			rankArgs = append(rankArgs, r.TokenId, r.RarityRank)
+			traitCountScoreCases = append(traitCountScoreCases, "WHEN ? THEN ?")
+			traitCountScoreArgs = append(traitCountScoreArgs, r.TokenId, r.TraitCountScore)
+			traitCountRankCases = append(traitCountRankCases, "WHEN ? THEN ?")
+			traitCountRankArgs = append(traitCountRankArgs, r.TokenId, r.TraitCountRank)
+			tokenIds = append(tokenIds, r.TokenId)
+		}
+
+		stmt := fmt.Sprintf(`UPDATE %s SET rarity_score = CASE token_id %s END, rarity_rank = CASE token_id %s END,
+		trait_count_score = CASE token_id %s END, trait_count_rank = CASE token_id %s END, update_time = ?
+		WHERE collection_address = ? and token_id in (?)`,
+			gdb.GetMultiProjectItemTableName(s.project, s.chain),
+			strings.Join(scoreCases, " "), strings.Join(rankCases, " "),
+			strings.Join(traitCountScoreCases, " "), strings.Join(traitCountRankCases, " "))
+
+		var args []interface{}
+		args = append(args, scoreArgs...)
+		args = append(args, rankArgs...)
+		args = append(args, traitCountScoreArgs...)
+		args = append(args, traitCountRankArgs...)
+		args = append(args, time.Now().UnixMilli(), collectionAddr, tokenIds)
+
+		if err := s.db.WithContext(s.ctx).Exec(stmt, args...).Error; err != nil {
+			return errors.Wrap(err, "failed on persist collection rarity")
+		}
+	}
+	return nil
+}
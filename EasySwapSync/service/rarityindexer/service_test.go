@@ -0,0 +1,15 @@
+package rarityindexer
+
+import (
+	"testing"
+
+	"github.com/ProjectsTask/EasySwapBase/rarity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetadataRefreshTimedOut(t *testing.T) {
+	item := rarity.PendingItem{CollectionAddr: "0xabc", TokenId: "1", RequestTime: 1_000_000}
+	assert.False(t, metadataRefreshTimedOut(item, item.RequestTime))
+	assert.False(t, metadataRefreshTimedOut(item, item.RequestTime+rarity.MetadataRefreshTimeout*1000-1))
+	assert.True(t, metadataRefreshTimedOut(item, item.RequestTime+rarity.MetadataRefreshTimeout*1000))
+}
@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	stdErrors "errors"
 	"fmt"
 	"sync"
 
 	"github.com/ProjectsTask/EasySwapBase/chain"
 	"github.com/ProjectsTask/EasySwapBase/chain/chainclient"
+	"github.com/ProjectsTask/EasySwapBase/health"
+	"github.com/ProjectsTask/EasySwapBase/metrics"
 	"github.com/ProjectsTask/EasySwapBase/ordermanager"
+	"github.com/ProjectsTask/EasySwapBase/rarity"
 	"github.com/ProjectsTask/EasySwapBase/stores/xkv"
 	"github.com/pkg/errors"
 	"github.com/zeromicro/go-zero/core/stores/cache"
@@ -20,6 +24,9 @@ import (
 	"github.com/ProjectsTask/EasySwapSync/model"
 	"github.com/ProjectsTask/EasySwapSync/service/collectionfilter"
 	"github.com/ProjectsTask/EasySwapSync/service/config"
+	"github.com/ProjectsTask/EasySwapSync/service/listingvalidator"
+	"github.com/ProjectsTask/EasySwapSync/service/notifier"
+	"github.com/ProjectsTask/EasySwapSync/service/rarityindexer"
 )
 
 // Service 结构体定义了后台服务的核心组件
@@ -32,6 +39,10 @@ type Service struct {
 	collectionFilter *collectionfilter.Filter   // 集合过滤器，用于管理允许的 NFT 集合
 	orderbookIndexer *orderbookindexer.Service  // 订单簿索引器，核心业务逻辑，负责同步链上事件
 	orderManager     *ordermanager.OrderManager // 订单管理器，负责订单的验证和管理
+	rarityIndexer    *rarityindexer.Service     // 稀有度计算服务，负责集合导入或刷新后重新计算 item 稀有度
+	listingValidator *listingvalidator.Service  // 挂单有效性校验服务, 未开启时为 nil
+	notifier         *notifier.Service          // 用户通知服务, 未开启时为 nil
+	chainClient      chainclient.ChainClient    // 链客户端，用于就绪检查
 }
 
 // New 初始化一个新的 Service 实例
@@ -62,17 +73,30 @@ func New(ctx context.Context, cfg *config.Config) (*Service, error) {
 	// 4. 初始化订单管理器
 	orderManager := ordermanager.New(ctx, db, kvStore, cfg.ChainCfg.Name, cfg.ProjectCfg.Name)
 
+	// 5. 初始化稀有度计算服务
+	rarityIndexer := rarityindexer.New(ctx, db, kvStore, cfg.ChainCfg.Name, cfg.ProjectCfg.Name)
+
 	var orderbookSyncer *orderbookindexer.Service
 	var chainClient chainclient.ChainClient
 	fmt.Println("chainClient url:" + cfg.AnkrCfg.HttpsUrl + cfg.AnkrCfg.ApiKey)
 
-	// 5. 初始化链客户端 (EVM client)
+	// 6. 初始化链客户端 (EVM client)
 	chainClient, err = chainclient.New(int(cfg.ChainCfg.ID), cfg.AnkrCfg.HttpsUrl+cfg.AnkrCfg.ApiKey)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed on create evm client")
 	}
+	chainClient = chainclient.Instrument(chainClient, cfg.ChainCfg.Name)
+
+	// 注册待处理队列长度指标
+	if err := metrics.RegisterQueues(kvStore,
+		metrics.Queue{Name: "orders", Chain: cfg.ChainCfg.Name, Key: ordermanager.GenOrdersCacheKey(cfg.ChainCfg.Name), Type: metrics.ListQueue},
+		metrics.Queue{Name: "trade_events", Chain: cfg.ChainCfg.Name, Key: ordermanager.GenTradeEventsCacheKey(cfg.ChainCfg.Name), Type: metrics.ListQueue},
+		metrics.Queue{Name: "rarity_refresh", Chain: cfg.ChainCfg.Name, Key: rarity.GenRarityRefreshCacheKey(cfg.ChainCfg.Name), Type: metrics.SetQueue},
+	); err != nil {
+		return nil, errors.Wrap(err, "failed on register queue metrics")
+	}
 
-	// 6. 根据链 ID 初始化对应的 OrderBookIndexer
+	// 7. 根据链 ID 初始化对应的 OrderBookIndexer
 	switch cfg.ChainCfg.ID {
 	case chain.EthChainID, chain.OptimismChainID, chain.SepoliaChainID:
 		// 初始化订单簿索引服务，传入数据库、KV存储、链客户端等依赖
@@ -82,6 +106,18 @@ func New(ctx context.Context, cfg *config.Config) (*Service, error) {
 		return nil, errors.Wrap(err, "failed on create trade info server")
 	}
 
+	// 8. 初始化挂单有效性校验服务
+	var listingValidator *listingvalidator.Service
+	if cfg.ListingValidator != nil && cfg.ListingValidator.Enable {
+		listingValidator = listingvalidator.New(ctx, cfg, db, kvStore, chainClient, cfg.ChainCfg.Name)
+	}
+
+	// 9. 初始化用户通知服务
+	var notifierService *notifier.Service
+	if cfg.Notification != nil && cfg.Notification.Enable {
+		notifierService = notifier.New(ctx, cfg, db, cfg.ChainCfg.Name, cfg.ChainCfg.ID)
+	}
+
 	// 构造 Service 对象
 	manager := Service{
 		ctx:              ctx,
@@ -91,6 +127,10 @@ func New(ctx context.Context, cfg *config.Config) (*Service, error) {
 		collectionFilter: collectionFilter,
 		orderbookIndexer: orderbookSyncer,
 		orderManager:     orderManager,
+		rarityIndexer:    rarityIndexer,
+		listingValidator: listingValidator,
+		notifier:         notifierService,
+		chainClient:      chainClient,
 		wg:               &sync.WaitGroup{},
 	}
 	return &manager, nil
@@ -110,7 +150,62 @@ func (s *Service) Start() error {
 
 	// 3. 启动订单管理器
 	s.orderManager.Start()
+
+	// 4. 启动稀有度计算服务
+	s.rarityIndexer.Start()
+
+	// 5. 启动挂单有效性校验服务
+	if s.listingValidator != nil {
+		s.listingValidator.Start()
+	}
+
+	// 6. 启动用户通知服务
+	if s.notifier != nil {
+		s.notifier.Start()
+	}
 	return nil
 }
 
-通过 EasySwapSync 下执行 go run main.go daemon 代码  请列出这个同步项目 各个流程调用到的各个方法 并解释流程含义
+// Stop 优雅停止后台服务, ctx 为最长等待时间
+// 先停止链上事件同步、挂单校验与通知, 不再产生新的订单与交易事件, 再停止订单管理器与稀有度计算, 等待正在处理的任务完成
+func (s *Service) Stop(ctx context.Context) error {
+	var errs []error
+	if err := s.orderbookIndexer.Stop(ctx); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed on stop orderbook indexer"))
+	}
+	if s.listingValidator != nil {
+		if err := s.listingValidator.Stop(ctx); err != nil {
+			errs = append(errs, errors.Wrap(err, "failed on stop listing validator"))
+		}
+	}
+	if s.notifier != nil {
+		if err := s.notifier.Stop(ctx); err != nil {
+			errs = append(errs, errors.Wrap(err, "failed on stop notifier"))
+		}
+	}
+	if err := s.orderManager.Stop(ctx); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed on stop order manager"))
+	}
+	if err := s.rarityIndexer.Stop(ctx); err != nil {
+		errs = append(errs, errors.Wrap(err, "failed on stop rarity indexer"))
+	}
+	return stdErrors.Join(errs...)
+}
+
+// HealthChecker 返回依赖就绪检查器 (MySQL、Redis、链节点 RPC)
+func (s *Service) HealthChecker() *health.Checker {
+	return health.NewChecker(0).
+		Add("mysql", health.DBCheck(s.db)).
+		Add("redis", health.RedisCheck(s.kvStore.Redis)).
+		Add("rpc", health.ChainCheck(s.chainClient))
+}
+
+// SyncStatus 返回订单簿事件同步进度
+func (s *Service) SyncStatus() orderbookindexer.SyncStatus {
+	return s.orderbookIndexer.Status()
+}
+
+// OrderbookIndexer 返回订单簿索引器, 供命令行工具直接处理指定区间或交易的事件
+func (s *Service) OrderbookIndexer() *orderbookindexer.Service {
+	return s.orderbookIndexer
+}